@@ -7,13 +7,23 @@ import (
 )
 
 var ConfigFields = struct {
-	GRPC    string
-	PortTLS string
-	Dir     string
+	GRPC            string
+	PortTLS         string
+	Dir             string
+	TracingService  string
+	TracingEndpoint string
+	MetricsAddr     string
+	HealthInterval  string
+	ShutdownTimeout string
 }{
-	GRPC:    "grpc",
-	PortTLS: "port_tls",
-	Dir:     "dir",
+	GRPC:            "grpc",
+	PortTLS:         "port_tls",
+	Dir:             "dir",
+	TracingService:  "tracing.service",
+	TracingEndpoint: "tracing.endpoint",
+	MetricsAddr:     "metrics.addr",
+	HealthInterval:  "health.interval",
+	ShutdownTimeout: "shutdown.timeout",
 }
 
 func ExportConfig() error {
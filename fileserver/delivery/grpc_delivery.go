@@ -1,16 +1,31 @@
 package delivery
 
 import (
+	"context"
+	"errors"
 	"github.com/2020_1_no_homomorphism/fileserver/proto/filetransfer"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/metadata"
 	"io"
 	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
+// uploadSize is labelled by the directory of the file, e.g. avatars, so the number of series stays bounded
+var uploadSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "upload_size_bytes",
+	Help:    "Size of uploaded files",
+	Buckets: prometheus.ExponentialBuckets(16*1024, 4, 8),
+}, []string{"dir"})
+
 type FileTransferDelivery struct{}
 
 func NewFileTransferDelivery() *FileTransferDelivery {
+	prometheus.MustRegister(uploadSize)
+
 	return &FileTransferDelivery{}
 }
 
@@ -44,5 +59,29 @@ func (uc *FileTransferDelivery) Upload(inStream filetransfer.UploadService_Uploa
 		log.Println("Error while saving file: ", err)
 		return err
 	}
+	uploadSize.WithLabelValues(filepath.Base(filepath.Dir(fileName))).Observe(float64(len(data)))
 	return nil
 }
+
+func (uc *FileTransferDelivery) Delete(ctx context.Context, in *filetransfer.FileName) (*filetransfer.UploadStatus, error) {
+	fileName := filepath.Clean("/" + in.Name)
+	if fileName == "/" || strings.Contains(in.Name, "..") {
+		return &filetransfer.UploadStatus{
+			Message: "wrong file name",
+			Code:    filetransfer.UploadStatusCode_Failed,
+		}, errors.New("wrong file name: " + in.Name)
+	}
+
+	err := os.Remove("resources" + fileName)
+	if err != nil && !os.IsNotExist(err) {
+		log.Println("Error while deleting file: ", err)
+		return &filetransfer.UploadStatus{
+			Message: err.Error(),
+			Code:    filetransfer.UploadStatusCode_Failed,
+		}, err
+	}
+	return &filetransfer.UploadStatus{
+		Message: "OK",
+		Code:    filetransfer.UploadStatusCode_Ok,
+	}, nil
+}
@@ -1,13 +1,25 @@
 package main
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/fileserver/config"
 	"github.com/2020_1_no_homomorphism/fileserver/delivery"
+	fsHealth "github.com/2020_1_no_homomorphism/fileserver/health"
 	"github.com/2020_1_no_homomorphism/fileserver/proto/filetransfer"
+	"github.com/2020_1_no_homomorphism/fileserver/tracing"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"log"
 	"net/http"
@@ -22,25 +34,95 @@ func main() {
 		log.Fatalf("can't export config %v", err)
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background(),
+		viper.GetString(config.ConfigFields.TracingService),
+		viper.GetString(config.ConfigFields.TracingEndpoint),
+	)
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	lis, err := net.Listen("tcp", viper.GetString(config.ConfigFields.GRPC))
 	if err != nil {
 		log.Fatalln("cant listen port", err)
 	}
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), grpc_prometheus.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), grpc_prometheus.StreamServerInterceptor),
+	)
 
 	filetransfer.RegisterUploadServiceServer(server, delivery.NewFileTransferDelivery())
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	grpc_prometheus.Register(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go fsHealth.Watch(ctx, healthServer,
+		time.Duration(viper.GetInt64(config.ConfigFields.HealthInterval))*time.Second,
+		fsHealth.Dir(viper.GetString(config.ConfigFields.Dir)),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", fsHealth.Liveness)
+	mux.Handle("/readyz", fsHealth.Readiness(healthServer))
+	metricsServer := &http.Server{
+		Addr:    viper.GetString(config.ConfigFields.MetricsAddr),
+		Handler: mux,
+	}
+	fileServer := &http.Server{
+		Addr:    viper.GetString(config.ConfigFields.PortTLS),
+		Handler: http.FileServer(http.Dir(viper.GetString(config.ConfigFields.Dir))),
+	}
 
-	log.Println("starting grpc server at :8084")
+	serveErr := make(chan error, 3)
 	go func() {
-		if err := server.Serve(lis); err != nil {
-			log.Fatalf("failed to start grpc server: %v", err)
-		}
+		log.Println("starting metrics at ", metricsServer.Addr)
+		serveErr <- metricsServer.ListenAndServe()
+	}()
+	go func() {
+		log.Println("starting grpc server at ", lis.Addr())
+		serveErr <- server.Serve(lis)
+	}()
+	go func() {
+		log.Println("Starts server at ", fileServer.Addr)
+		serveErr <- fileServer.ListenAndServe()
 	}()
 
-	log.Println("Starts server at ", viper.GetString(config.ConfigFields.PortTLS))
-	err = http.ListenAndServe(viper.GetString(config.ConfigFields.PortTLS), http.FileServer(http.Dir(viper.GetString(config.ConfigFields.Dir))))
-	if err != nil {
-		log.Println(err)
-		return
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		log.Printf("server stopped: %v", err)
+	case sig := <-stop:
+		log.Printf("got %v, shutting down", sig)
+	}
+
+	// NOT_SERVING is sent to the watching clients, then in-flight uploads and downloads are drained
+	cancel()
+	healthServer.Shutdown()
+	timeout := time.Duration(viper.GetInt64(config.ConfigFields.ShutdownTimeout)) * time.Second
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), timeout)
+	defer cancelShutdown()
+	if err := fileServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("downloads didn't drain in time: %v", err)
+	}
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		log.Println("uploads didn't drain in time")
+		server.Stop()
+	}
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		metricsServer.Close()
 	}
 }
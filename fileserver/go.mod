@@ -3,9 +3,14 @@ module github.com/2020_1_no_homomorphism/fileserver
 go 1.14
 
 require (
-	github.com/golang/protobuf v1.4.0
+	github.com/golang/protobuf v1.5.2
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/joho/godotenv v1.3.0
+	github.com/prometheus/client_golang v1.5.1
 	github.com/spf13/viper v1.6.3
-	golang.org/x/net v0.0.0-20200425230154-ff2c4b7c35a0 // indirect
-	google.golang.org/grpc v1.29.1
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.24.0
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	google.golang.org/grpc v1.40.0
 )
@@ -138,10 +138,50 @@ func (m *UploadStatus) GetCode() UploadStatusCode {
 	return UploadStatusCode_Unknown
 }
 
+type FileName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileName) Reset()         { *m = FileName{} }
+func (m *FileName) String() string { return proto.CompactTextString(m) }
+func (*FileName) ProtoMessage()    {}
+func (*FileName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d5b4bd112d6203, []int{2}
+}
+
+func (m *FileName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileName.Unmarshal(m, b)
+}
+func (m *FileName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileName.Marshal(b, m, deterministic)
+}
+func (m *FileName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileName.Merge(m, src)
+}
+func (m *FileName) XXX_Size() int {
+	return xxx_messageInfo_FileName.Size(m)
+}
+func (m *FileName) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileName proto.InternalMessageInfo
+
+func (m *FileName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("filetransfer.UploadStatusCode", UploadStatusCode_name, UploadStatusCode_value)
 	proto.RegisterType((*Chunk)(nil), "filetransfer.Chunk")
 	proto.RegisterType((*UploadStatus)(nil), "filetransfer.UploadStatus")
+	proto.RegisterType((*FileName)(nil), "filetransfer.FileName")
 }
 
 func init() {
@@ -149,21 +189,23 @@ func init() {
 }
 
 var fileDescriptor_85d5b4bd112d6203 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0xcb, 0xcc, 0x49,
-	0x2d, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x41, 0x16, 0x53, 0x52, 0xe4, 0x62, 0x75, 0xce, 0x28, 0xcd, 0xcb, 0x16, 0x92, 0xe0, 0x62, 0x77,
-	0xce, 0xcf, 0x2b, 0x49, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x82, 0x71, 0x95,
-	0x62, 0xb8, 0x78, 0x42, 0x0b, 0x72, 0xf2, 0x13, 0x53, 0x82, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x41,
-	0x2a, 0x7d, 0x53, 0x8b, 0x8b, 0x13, 0xd3, 0x53, 0xc1, 0x2a, 0x39, 0x83, 0x60, 0x5c, 0x21, 0x23,
-	0x2e, 0x16, 0xe7, 0xfc, 0x94, 0x54, 0x09, 0x26, 0xa0, 0x30, 0x9f, 0x91, 0x9c, 0x1e, 0x8a, 0xed,
-	0xc8, 0x66, 0x80, 0x54, 0x05, 0x81, 0xd5, 0x6a, 0x19, 0x73, 0x09, 0xa0, 0xcb, 0x08, 0x71, 0x73,
-	0xb1, 0x87, 0xe6, 0x65, 0xe7, 0xe5, 0x97, 0xe7, 0x09, 0x30, 0x08, 0xb1, 0x71, 0x31, 0xf9, 0x67,
-	0x0b, 0x30, 0x0a, 0x71, 0x71, 0xb1, 0xb9, 0x25, 0x02, 0x0d, 0x4c, 0x11, 0x60, 0x32, 0xf2, 0xe3,
-	0xe2, 0x85, 0x6a, 0x4a, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x15, 0xb2, 0xe5, 0x62, 0x83, 0x08, 0x08,
-	0x09, 0xa3, 0xda, 0x0a, 0xf6, 0x9c, 0x94, 0x14, 0x6e, 0xa7, 0x28, 0x31, 0x68, 0x30, 0x26, 0xb1,
-	0x81, 0x83, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x8b, 0x91, 0xe4, 0x30, 0x01, 0x00,
-	0x00,
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4f, 0xc3, 0x30,
+	0x10, 0x85, 0xeb, 0xa8, 0xb8, 0x70, 0x04, 0x14, 0x1d, 0x12, 0x8a, 0x3a, 0x54, 0x25, 0x53, 0xc4,
+	0xd0, 0x21, 0x9d, 0x61, 0x09, 0xea, 0x06, 0x48, 0x41, 0xdd, 0x58, 0x0c, 0xb9, 0x82, 0x15, 0x63,
+	0x57, 0xb6, 0x0b, 0xff, 0x82, 0xdf, 0x8c, 0x62, 0x1a, 0x29, 0x41, 0xa2, 0x93, 0x7d, 0x4f, 0xdf,
+	0x7b, 0x7e, 0x3e, 0xc0, 0x8d, 0x54, 0xe4, 0xad, 0xd0, 0x6e, 0x43, 0x76, 0xb1, 0xb5, 0xc6, 0x1b,
+	0x8c, 0xfb, 0x5a, 0x76, 0x05, 0x47, 0xe5, 0xfb, 0x4e, 0x37, 0x98, 0xc2, 0xa4, 0x34, 0xda, 0x93,
+	0xf6, 0x29, 0x9b, 0xb3, 0x3c, 0xae, 0xba, 0x31, 0x7b, 0x86, 0x78, 0xbd, 0x55, 0x46, 0xd4, 0x4f,
+	0x5e, 0xf8, 0x9d, 0x6b, 0xc9, 0x7b, 0x72, 0x4e, 0xbc, 0x51, 0x20, 0x4f, 0xaa, 0x6e, 0xc4, 0x02,
+	0xc6, 0xa5, 0xa9, 0x29, 0x8d, 0xe6, 0x2c, 0x3f, 0x2f, 0x66, 0x8b, 0xc1, 0xeb, 0xfd, 0x8c, 0x96,
+	0xaa, 0x02, 0x9b, 0xcd, 0xe0, 0x78, 0x25, 0x15, 0x3d, 0x88, 0x0f, 0x42, 0x84, 0x71, 0x7b, 0xee,
+	0x63, 0xc3, 0xfd, 0x7a, 0x09, 0xc9, 0x5f, 0x27, 0x9e, 0xc2, 0x64, 0xad, 0x1b, 0x6d, 0xbe, 0x74,
+	0x32, 0x42, 0x0e, 0xd1, 0x63, 0x93, 0x30, 0x04, 0xe0, 0x2b, 0x21, 0x15, 0xd5, 0x49, 0x54, 0x7c,
+	0x33, 0x38, 0xdb, 0xbb, 0xc8, 0x7e, 0xca, 0x57, 0xc2, 0x1b, 0xe0, 0xbf, 0x02, 0x5e, 0x0c, 0x6b,
+	0x85, 0xdf, 0x4f, 0xa7, 0xff, 0x77, 0xcd, 0x46, 0x39, 0xc3, 0x5b, 0xe0, 0x77, 0xa4, 0xc8, 0x13,
+	0x5e, 0x0e, 0xc9, 0xae, 0xfb, 0xe1, 0x84, 0x17, 0x1e, 0x76, 0xbf, 0xfc, 0x19, 0x00, 0xad, 0x4e,
+	0xce, 0x92, 0x91, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,6 +221,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type UploadServiceClient interface {
 	Upload(ctx context.Context, opts ...grpc.CallOption) (UploadService_UploadClient, error)
+	Delete(ctx context.Context, in *FileName, opts ...grpc.CallOption) (*UploadStatus, error)
 }
 
 type uploadServiceClient struct {
@@ -223,9 +266,19 @@ func (x *uploadServiceUploadClient) CloseAndRecv() (*UploadStatus, error) {
 	return m, nil
 }
 
+func (c *uploadServiceClient) Delete(ctx context.Context, in *FileName, opts ...grpc.CallOption) (*UploadStatus, error) {
+	out := new(UploadStatus)
+	err := c.cc.Invoke(ctx, "/filetransfer.UploadService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UploadServiceServer is the server API for UploadService service.
 type UploadServiceServer interface {
 	Upload(UploadService_UploadServer) error
+	Delete(context.Context, *FileName) (*UploadStatus, error)
 }
 
 // UnimplementedUploadServiceServer can be embedded to have forward compatible implementations.
@@ -235,6 +288,9 @@ type UnimplementedUploadServiceServer struct {
 func (*UnimplementedUploadServiceServer) Upload(srv UploadService_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (*UnimplementedUploadServiceServer) Delete(ctx context.Context, req *FileName) (*UploadStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
 
 func RegisterUploadServiceServer(s *grpc.Server, srv UploadServiceServer) {
 	s.RegisterService(&_UploadService_serviceDesc, srv)
@@ -266,10 +322,33 @@ func (x *uploadServiceUploadServer) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func _UploadService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UploadServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filetransfer.UploadService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UploadServiceServer).Delete(ctx, req.(*FileName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UploadService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filetransfer.UploadService",
 	HandlerType: (*UploadServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Delete",
+			Handler:    _UploadService_Delete_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Upload",
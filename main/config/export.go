@@ -9,13 +9,35 @@ import (
 
 var ConfigFields = struct {
 	// db
-	DBMaxConnNum string
+	DBMaxConnNum     string
+	DBMigrateOnStart string
 	// logger
 	LogFile string
 	// redis
 	RedisAddr string
 	// csrf
 	CsrfDuration string
+	// totp
+	TotpIssuer string
+	// login attempts
+	AttemptsWindow      string
+	AttemptsFreeByLogin string
+	AttemptsFreeByIP    string
+	AttemptsBaseLock    string
+	AttemptsMaxLock     string
+	// rate limits by route group
+	RateLimits string
+	// catalog cache of artists, albums and tracks
+	CatalogCacheTTL string
+	// feed
+	FeedCacheTTL string
+	// notifications
+	NotificationsKeepAlive string
+	// player
+	PlayerStateTTL string
+	// charts
+	ChartsSize            string
+	ChartsRefreshInterval string
 	// fileserver
 	FSRoot        string
 	FSAddr        string
@@ -23,7 +45,8 @@ var ConfigFields = struct {
 	AvatarDefault string
 	AvatarTypes   string
 	// api
-	ApiPrefix string
+	ApiPrefix         string
+	ApiRequestTimeout string
 	// cors
 	CorsAllowedOrigins string
 	CorsAllowedCreds   string
@@ -36,32 +59,62 @@ var ConfigFields = struct {
 	GRPCsessions string
 	GRPCfs       string
 	// main
-	MainAddr string
+	MainAddr        string
+	ShutdownTimeout string
+	DrainDelay      string
+	HealthTimeout   string
+	MaxBodySize     string
+	TrustedProxies  string
+	// tracing
+	TracingService  string
+	TracingEndpoint string
 	// ssl
 	SSLkey       string
 	SSLfullchain string
 }{
-	DBMaxConnNum:       "db.max_conn_num",
-	LogFile:            "logger.file",
-	RedisAddr:          "redis.addr",
-	CsrfDuration:       "csrf.duration",
-	FSRoot:             "fileserver.root",
-	FSAddr:             "fileserver.addr",
-	AvatarDefault:      "fileserver.avatar.default",
-	AvatarDir:          "fileserver.avatar.dir",
-	AvatarTypes:        "fileserver.avatar.types",
-	ApiPrefix:          "api.prefix",
-	CorsAllowedOrigins: "cors.allowed_origins",
-	CorsAllowedCreds:   "cors.allowed_cred",
-	CorsAllowedHeaders: "cors.allowed_headers",
-	CorsAllowedMethods: "cors.allowed_methods",
-	CorsDebug:          "cors.debug",
-	CookieExpireTime:   "cookie.expire",
-	GRPCfs:             "grpc.fileserver",
-	GRPCsessions:       "grpc.session",
-	MainAddr:           "main.addr",
-	SSLkey:             "ssl.key",
-	SSLfullchain:       "ssl.fullchain",
+	DBMaxConnNum:           "db.max_conn_num",
+	DBMigrateOnStart:       "db.migrate_on_start",
+	LogFile:                "logger.file",
+	RedisAddr:              "redis.addr",
+	CsrfDuration:           "csrf.duration",
+	TotpIssuer:             "totp.issuer",
+	AttemptsWindow:         "attempts.window",
+	AttemptsFreeByLogin:    "attempts.free_by_login",
+	AttemptsFreeByIP:       "attempts.free_by_ip",
+	AttemptsBaseLock:       "attempts.base_lock",
+	AttemptsMaxLock:        "attempts.max_lock",
+	RateLimits:             "ratelimit",
+	CatalogCacheTTL:        "catalog.cache_ttl",
+	FeedCacheTTL:           "feed.cache_ttl",
+	NotificationsKeepAlive: "notifications.keep_alive",
+	PlayerStateTTL:         "player.state_ttl",
+	ChartsSize:             "charts.size",
+	ChartsRefreshInterval:  "charts.refresh_interval",
+	FSRoot:                 "fileserver.root",
+	FSAddr:                 "fileserver.addr",
+	AvatarDefault:          "fileserver.avatar.default",
+	AvatarDir:              "fileserver.avatar.dir",
+	AvatarTypes:            "fileserver.avatar.types",
+	ApiPrefix:              "api.prefix",
+	ApiRequestTimeout:      "api.request_timeout",
+	CorsAllowedOrigins:     "cors.allowed_origins",
+	CorsAllowedCreds:       "cors.allowed_cred",
+	CorsAllowedHeaders:     "cors.allowed_headers",
+	CorsAllowedMethods:     "cors.allowed_methods",
+	CorsDebug:              "cors.debug",
+	CookieExpireTime:       "cookie.expire",
+	GRPCfs:                 "grpc.fileserver",
+	GRPCsessions:           "grpc.session",
+	MainAddr:               "main.addr",
+	ShutdownTimeout:        "main.shutdown_timeout",
+	DrainDelay:             "main.drain_delay",
+	HealthTimeout:          "main.health_timeout",
+	MaxBodySize:            "main.max_body_size",
+	TrustedProxies:         "main.trusted_proxies",
+	TracingService:         "tracing.service",
+	TracingEndpoint:        "tracing.endpoint",
+	SSLkey:                 "ssl.key",
+	SSLfullchain:           "ssl.fullchain",
 }
 
 type requestID int
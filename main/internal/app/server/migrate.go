@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/config"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/migrations"
+	"github.com/joho/godotenv"
+)
+
+const migrateUsage = `usage: no_homo_main migrate <command>
+  up               apply all pending migrations
+  down [-steps n]  revert the last n migrations, 1 by default
+  down -all        revert all migrations
+  version          print the current schema version
+  force <version>  mark migrations up to version as applied without running them,
+                   e.g. for a database created before the migrations`
+
+// Migrate runs the migrate command of the binary against the database from DB_CONN
+func Migrate(args []string) {
+	if err := godotenv.Load(); err != nil {
+		log.Fatalf("Failed to export env vars: %v", err)
+	}
+	if err := config.ExportConfig(); err != nil {
+		log.Fatalf("Failed to export config: %v", err)
+	}
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	db, err := sql.Open("postgres", os.Getenv("DB_CONN"))
+	if err != nil {
+		log.Fatalf("Failed to start db: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		err = migrateUp(ctx, migrator)
+	case "down":
+		flags := flag.NewFlagSet("down", flag.ExitOnError)
+		steps := flags.Int("steps", 1, "number of migrations to revert")
+		all := flags.Bool("all", false, "revert all migrations")
+		_ = flags.Parse(args[1:])
+		if *all {
+			*steps = math.MaxInt32
+		}
+		var reverted []migrations.Migration
+		reverted, err = migrator.Down(ctx, *steps)
+		for _, elem := range reverted {
+			log.Printf("reverted migration %d_%s", elem.Version, elem.Name)
+		}
+	case "version":
+		var version uint64
+		if version, err = migrator.Version(ctx); err == nil {
+			fmt.Println(version)
+		}
+	case "force":
+		if len(args) != 2 {
+			log.Fatal(migrateUsage)
+		}
+		var version uint64
+		if version, err = strconv.ParseUint(args[1], 10, 64); err == nil {
+			err = migrator.Force(ctx, version)
+		}
+	default:
+		log.Fatal(migrateUsage)
+	}
+	if err != nil {
+		log.Fatalf("Failed to migrate: %v", err)
+	}
+}
+
+func newMigrator(db *sql.DB) (*migrations.Migrator, error) {
+	list, err := migrations.Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(db, list), nil
+}
+
+func migrateUp(ctx context.Context, migrator *migrations.Migrator) error {
+	applied, err := migrator.Up(ctx)
+	for _, elem := range applied {
+		log.Printf("applied migration %d_%s", elem.Version, elem.Name)
+	}
+	return err
+}
@@ -4,38 +4,77 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/2020_1_no_homomorphism/no_homo_main/config"
+	adminDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin/delivery"
+	adminRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin/repository"
+	adminUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
 	albumDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album/delivery"
 	albumRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album/repository"
 	albumUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
 	artistDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist/delivery"
 	artistRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist/repository"
 	artistUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist/usecase"
+	attemptsRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts/repository"
+	attemptsUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	chartDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart/delivery"
+	chartRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart/repository"
+	chartUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart/usecase"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/csrf/repository"
 	csrfLib "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/csrf/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	feedDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed/delivery"
+	feedRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed/repository"
+	feedUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	genreDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre/delivery"
+	genreRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre/repository"
+	genreUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/health"
+	lyricsDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics/delivery"
+	lyricsRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics/repository"
+	lyricsUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/metrics"
 	m "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	notificationDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification/delivery"
+	notificationRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification/repository"
+	notificationUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification/usecase"
+	playerDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player/delivery"
+	playerRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player/repository"
+	playerUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player/usecase"
 	playlistDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist/delivery"
 	playlistRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist/repository"
 	playlistUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit"
+	ratelimitRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit/repository"
 	searchDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/search/delivery"
 	searchUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/search/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 	trackDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track/delivery"
 	trackRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track/repository"
 	trackUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track/usecase"
 	userDelivery "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user/delivery"
 	userRepo "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user/repository"
 	userUC "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user/usecase"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/2020_1_no_homomorphism/no_homo_main/proto/filetransfer"
 	"github.com/2020_1_no_homomorphism/no_homo_main/proto/session"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/gorilla/mux"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/jinzhu/gorm"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -43,9 +82,13 @@ import (
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
+const notificationsRetryDelay = 5 * time.Second
+
 func getInterceptor(mainLogger *logger.MainLogger) func(
 	ctx context.Context,
 	method string,
@@ -66,48 +109,104 @@ func getInterceptor(mainLogger *logger.MainLogger) func(
 	) error {
 		start := time.Now()
 		err := invoker(ctx, method, req, reply, cc, opts...)
-		mainLogger.Tracef("call=%v req=%#v reply=%#v time=%v err=%v",
-			method, req, reply, time.Since(start), err)
+		mainLogger.Tracef("call=%v req=%#v reply=%#v time=%v err=%v trace_id=%v",
+			method, req, reply, time.Since(start), err, tracing.TraceID(ctx))
 		return err
 	}
 }
 
-func InitHandler(mainLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.CryptToken, sessManager session.AuthCheckerClient, fileserver filetransfer.UploadServiceClient) (
+func InitHandler(mainLogger *logger.MainLogger, db *gorm.DB, redisConn *redis.Pool, csrfToken csrfLib.CryptToken, sessManager session.AuthCheckerClient, fileserver filetransfer.UploadServiceClient) (
 	userDelivery.UserHandler,
 	trackDelivery.TrackHandler,
 	playlistDelivery.PlaylistHandler,
 	albumDelivery.AlbumHandler,
 	artistDelivery.ArtistHandler,
 	searchDelivery.SearchHandler,
+	adminDelivery.AdminHandler,
+	feedDelivery.FeedHandler,
+	notificationDelivery.NotificationHandler,
+	playerDelivery.PlayerHandler,
+	genreDelivery.GenreHandler,
+	chartDelivery.ChartHandler,
+	lyricsDelivery.LyricsHandler,
 	m.AuthMidleware,
 	m.CsrfMiddleware,
+	m.RateLimitMiddleware,
 ) {
 
-	trackRep := trackRepo.NewDbTrackRepo(db)
+	dbTrackRep := trackRepo.NewDbTrackRepo(db)
 	playlistRep := playlistRepo.NewDbPlaylistRepository(db)
-	albumRep := albumRepo.NewDbAlbumRepository(db)
-	artistRep := artistRepo.NewDbArtistRepository(db)
+	dbAlbumRep := albumRepo.NewDbAlbumRepository(db)
+	dbArtistRep := artistRepo.NewDbArtistRepository(db)
 	dbRep := userRepo.NewDbUserRepository(db, viper.GetString(config.ConfigFields.AvatarDefault))
+	attemptsRep := attemptsRepo.NewRedisAttemptsManager(redisConn)
+	adminRep := adminRepo.NewDbAdminRepository(db)
+	dbGenreRep := genreRepo.NewDbGenreRepository(db)
+	chartRep := chartRepo.NewDbChartRepository(db)
+	lyricsRep := lyricsRepo.NewDbLyricsRepository(db)
+	dbFeedRep := feedRepo.NewDbFeedRepository(db)
+
+	var feedRep feed.Repository = &dbFeedRep
+	if ttl := viper.GetInt64(config.ConfigFields.FeedCacheTTL); ttl > 0 {
+		cachedFeedRep := feedRepo.NewRedisFeedRepository(&dbFeedRep, redisConn, ttl)
+		feedRep = &cachedFeedRep
+	}
+
+	var trackRep track.Repository = &dbTrackRep
+	var albumRep album.Repository = &dbAlbumRep
+	var artistRep artist.Repository = &dbArtistRep
+	var genreRep genre.Repository = &dbGenreRep
+	if ttl := time.Duration(viper.GetInt64(config.ConfigFields.CatalogCacheTTL)) * time.Second; ttl > 0 {
+		cachedTrackRep := trackRepo.NewRedisTrackRepository(&dbTrackRep, cache.New(redisConn, "tracks", ttl), mainLogger)
+		cachedAlbumRep := albumRepo.NewRedisAlbumRepository(&dbAlbumRep, cache.New(redisConn, "albums", ttl), mainLogger)
+		cachedArtistRep := artistRepo.NewRedisArtistRepository(&dbArtistRep, cache.New(redisConn, "artists", ttl), mainLogger)
+		cachedGenreRep := genreRepo.NewRedisGenreRepository(&dbGenreRep, cache.New(redisConn, "genres", ttl), mainLogger)
+		trackRep, albumRep, artistRep, genreRep = &cachedTrackRep, &cachedAlbumRep, &cachedArtistRep, &cachedGenreRep
+	}
+	notificationRep := notificationRepo.NewDbNotificationRepository(db)
+	notificationBroker := notificationRepo.NewRedisBroker(redisConn)
+	playerRep := playerRepo.NewRedisPlayerRepository(redisConn, viper.GetInt64(config.ConfigFields.PlayerStateTTL))
+
+	NotificationUC := notificationUC.NewNotificationUseCase(&notificationRep, &notificationBroker)
+	go listenNotifications(NotificationUC, mainLogger)
+
+	AttemptsUC := attemptsUC.NewAttemptsUseCase(&attemptsRep, attemptsUC.Limits{
+		Window:      viper.GetInt64(config.ConfigFields.AttemptsWindow),
+		FreeByLogin: viper.GetInt64(config.ConfigFields.AttemptsFreeByLogin),
+		FreeByIP:    viper.GetInt64(config.ConfigFields.AttemptsFreeByIP),
+		BaseLock:    viper.GetInt64(config.ConfigFields.AttemptsBaseLock),
+		MaxLock:     viper.GetInt64(config.ConfigFields.AttemptsMaxLock),
+	})
 
 	ArtistUC := artistUC.ArtistUseCase{
-		ArtistRepository: &artistRep,
+		ArtistRepository: artistRep,
 	}
 
 	AlbumUC := albumUC.AlbumUseCase{
-		AlbumRepository: &albumRep,
+		AlbumRepository: albumRep,
+		TrackRepository: trackRep,
 	}
 
 	PlaylistUC := playlistUC.PlaylistUseCase{
-		PlRepository: &playlistRep,
+		PlRepository:   &playlistRep,
+		NotificationUC: NotificationUC,
+		Log:            mainLogger,
 	}
 
 	UserUC := userUC.UserUseCase{
-		Repository:  &dbRep,
-		FileService: fileserver,
-		AvatarDir:   viper.GetString(config.ConfigFields.AvatarDir),
+		Repository:         &dbRep,
+		TrackRepository:    trackRep,
+		AlbumRepository:    albumRep,
+		ArtistRepository:   artistRep,
+		PlaylistRepository: &playlistRep,
+		FileService:        fileserver,
+		AvatarDir:          viper.GetString(config.ConfigFields.AvatarDir),
+		AvatarDefault:      viper.GetString(config.ConfigFields.AvatarDefault),
+		TotpIssuer:         viper.GetString(config.ConfigFields.TotpIssuer),
+		Log:                mainLogger,
 	}
 	TrackUC := trackUC.TrackUseCase{
-		Repository: &trackRep,
+		Repository: trackRep,
 	}
 
 	playlistHandler := playlistDelivery.PlaylistHandler{
@@ -128,6 +227,7 @@ func InitHandler(mainLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.C
 		Log:             mainLogger,
 		ImgTypes:        viper.GetStringMapString(config.ConfigFields.AvatarTypes),
 		CSRF:            &csrfToken,
+		Attempts:        &AttemptsUC,
 	}
 
 	trackHandler := trackDelivery.TrackHandler{
@@ -143,9 +243,76 @@ func InitHandler(mainLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.C
 
 	searchHandler := searchDelivery.SearchHandler{
 		SearchUC: searchUC.SearchUseCase{
-			ArtistRepo: &artistRep,
-			AlbumRepo:  &albumRep,
-			TrackRepo:  &trackRep,
+			ArtistRepo: artistRep,
+			AlbumRepo:  albumRep,
+			TrackRepo:  trackRep,
+			LyricsRepo: &lyricsRep,
+		},
+		Log: mainLogger,
+	}
+
+	adminHandler := adminDelivery.AdminHandler{
+		AdminUC: &adminUC.AdminUseCase{
+			ArtistRepository: artistRep,
+			AlbumRepository:  albumRep,
+			TrackRepository:  trackRep,
+			GenreRepository:  genreRep,
+			AuditRepository:  &adminRep,
+			NotificationUC:   NotificationUC,
+			Log:              mainLogger,
+		},
+		Log: mainLogger,
+	}
+
+	feedHandler := feedDelivery.FeedHandler{
+		FeedUC: &feedUC.FeedUseCase{
+			Repository: feedRep,
+		},
+		Log: mainLogger,
+	}
+
+	notificationHandler := notificationDelivery.NotificationHandler{
+		NotificationUC: NotificationUC,
+		Log:            mainLogger,
+		KeepAlive:      time.Duration(viper.GetInt64(config.ConfigFields.NotificationsKeepAlive)) * time.Second,
+	}
+
+	playerHandler := playerDelivery.PlayerHandler{
+		PlayerUC: &playerUC.PlayerUseCase{
+			Repository:         &playerRep,
+			TrackRepository:    trackRep,
+			PlaylistRepository: &playlistRep,
+			NotificationUC:     NotificationUC,
+			Log:                mainLogger,
+		},
+		Log: mainLogger,
+	}
+
+	genreHandler := genreDelivery.GenreHandler{
+		GenreUC: &genreUC.GenreUseCase{
+			Repository: genreRep,
+		},
+		Log: mainLogger,
+	}
+
+	ChartUC := chartUC.ChartUseCase{
+		Repository:      &chartRep,
+		GenreRepository: genreRep,
+		Size:            viper.GetUint64(config.ConfigFields.ChartsSize),
+	}
+	if interval := viper.GetInt64(config.ConfigFields.ChartsRefreshInterval); interval > 0 {
+		go refreshCharts(&ChartUC, time.Duration(interval)*time.Second, mainLogger)
+	}
+
+	chartHandler := chartDelivery.ChartHandler{
+		ChartUC: &ChartUC,
+		Log:     mainLogger,
+	}
+
+	lyricsHandler := lyricsDelivery.LyricsHandler{
+		LyricsUC: &lyricsUC.LyricsUseCase{
+			Repository:      &lyricsRep,
+			TrackRepository: trackRep,
 		},
 		Log: mainLogger,
 	}
@@ -153,17 +320,69 @@ func InitHandler(mainLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.C
 	auth := m.NewAuthMiddleware(sessManager, &UserUC, mainLogger)
 	csrf := m.NewCsrfMiddleware(&csrfToken)
 
-	return userHandler, trackHandler, playlistHandler, albumHandler, artistHandler, searchHandler, auth, csrf
+	var limits map[string]ratelimit.Limit
+	if err := viper.UnmarshalKey(config.ConfigFields.RateLimits, &limits); err != nil {
+		mainLogger.LogError(context.Background(), "server", "InitHandler", fmt.Errorf("failed to read rate limits: %w", err))
+	}
+	limiter := ratelimitRepo.NewRedisLimiter(redisConn)
+	rateLimit := m.NewRateLimitMiddleware(&limiter, limits, mainLogger)
+
+	return userHandler, trackHandler, playlistHandler, albumHandler, artistHandler, searchHandler, adminHandler, feedHandler, notificationHandler, playerHandler, genreHandler, chartHandler, lyricsHandler, auth, csrf, rateLimit
+}
+
+// listenNotifications keeps the replica subscribed to notifications published by the others
+func listenNotifications(uc *notificationUC.NotificationUseCase, mainLogger *logger.MainLogger) {
+	for {
+		err := uc.Listen()
+		mainLogger.LogError(context.Background(), "server", "listenNotifications", err)
+		time.Sleep(notificationsRetryDelay)
+	}
+}
+
+// refreshCharts periodically rebuilds chart snapshots of the current periods
+func refreshCharts(uc *chartUC.ChartUseCase, interval time.Duration, mainLogger *logger.MainLogger) {
+	for {
+		if err := uc.Refresh(context.Background(), time.Now()); err != nil {
+			mainLogger.LogError(context.Background(), "server", "refreshCharts", err)
+		}
+		time.Sleep(interval)
+	}
 }
 
-func InitRouter(customLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.CryptToken, sessManager session.AuthCheckerClient, fileserver filetransfer.UploadServiceClient) http.Handler {
-	user, track, playlist, album, artist, search, auth, csrf := InitHandler(customLogger, db, csrfToken, sessManager, fileserver)
+// rate limit groups, their limits are set in the ratelimit section of the config
+const (
+	limitAuth      = "auth"
+	limitSearch    = "search"
+	limitPlaylists = "playlists"
+	limitUploads   = "uploads"
+)
+
+func InitRouter(customLogger *logger.MainLogger, db *gorm.DB, redisConn *redis.Pool, csrfToken csrfLib.CryptToken, sessManager session.AuthCheckerClient, fileserver filetransfer.UploadServiceClient, checker *health.Checker) http.Handler {
+	user, track, playlist, album, artist, search, admin, feed, notification, player, genre, chart, lyrics, auth, csrf, limits := InitHandler(customLogger, db, redisConn, csrfToken, sessManager, fileserver)
+
+	trustedProxies, err := m.ParseTrustedProxies(viper.GetStringSlice(config.ConfigFields.TrustedProxies))
+	if err != nil {
+		customLogger.LogError(context.Background(), "server", "InitRouter", fmt.Errorf("failed to read trusted proxies: %w", err))
+	}
 
 	r := mux.NewRouter().PathPrefix(viper.GetString(config.ConfigFields.ApiPrefix)).Subrouter()
+	r.Use(m.RealIP(trustedProxies))
+	r.Use(otelmux.Middleware(viper.GetString(config.ConfigFields.TracingService)))
+	r.Use(m.TraceRequestID(user.Log))
+	r.Use(m.RequestTimeout(time.Duration(viper.GetInt64(config.ConfigFields.ApiRequestTimeout)) * time.Second))
+
+	r.HandleFunc("/genres", genre.GetGenres).Methods("GET")
+	r.Handle("/genres/{id:[0-9]+}/artists/{start:[0-9]+}/{end:[0-9]+}", m.BoundedVars(artist.GetBoundedArtistsByGenre, user.Log)).Methods("GET")
+	r.Handle("/genres/{id:[0-9]+}/albums/{start:[0-9]+}/{end:[0-9]+}", m.BoundedVars(album.GetBoundedAlbumsByGenre, user.Log)).Methods("GET")
+	r.Handle("/genres/{id:[0-9]+}/tracks/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(m.BoundedVars(track.GetBoundedGenreTracks, user.Log), true)).Methods("GET")
+
+	r.HandleFunc("/charts/{type:tracks|albums|artists}/{window:daily|weekly|monthly}", chart.GetChart).Methods("GET")
 
 	r.Handle("/users/albums", auth.Auth(album.GetUserAlbums, false)).Methods("GET")
 	r.Handle("/albums/{id:[0-9]+}", auth.Auth(album.GetFullAlbum, true)).Methods("GET")
+	r.Handle("/albums/{id:[0-9]+}/details", auth.Auth(album.GetAlbumDetails, true)).Methods("GET")
 	r.Handle("/albums/{id:[0-9]+}/rating", auth.Auth(album.RateAlbum, false)).Methods("POST")
+	r.HandleFunc("/artists/{id:[0-9]+}/discography", album.GetDiscography).Methods("GET")
 	r.Handle("/artists/{id:[0-9]+}/albums/{start:[0-9]+}/{end:[0-9]+}", m.BoundedVars(album.GetBoundedAlbumsByArtistId, user.Log)).Methods("GET")
 
 	r.Handle("/users/artists", auth.Auth(artist.SubscriptionList, false)).Methods("GET")
@@ -172,37 +391,82 @@ func InitRouter(customLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.
 	r.HandleFunc("/artists/{start:[0-9]+}/{end:[0-9]+}", artist.GetBoundedArtists).Methods("GET")
 	r.Handle("/artists/{id:[0-9]+}/subscription", auth.Auth(artist.Subscribe, false)).Methods("POST") //todo csrf
 
-	r.Handle("/users/playlists", auth.Auth(playlist.GetUserPlaylists, false)).Methods("GET")
-	r.Handle("/playlists/{id:[0-9]+}", auth.Auth(playlist.GetFullPlaylistById, true)).Methods("GET")
-	r.Handle("/playlists/tracks/{id:[0-9]+}", auth.Auth(playlist.GetPlaylistsIDByTrack, false)).Methods("GET")
-	r.Handle("/playlists/tracks", auth.Auth(csrf.CSRFCheck(playlist.AddTrackToPlaylist), false)).Methods("POST")
-	r.Handle("/playlists/new/{name}", auth.Auth(csrf.CSRFCheck(playlist.CreatePlaylist), false)).Methods("POST")
-	r.Handle("/playlists/{id:[0-9]+}", auth.Auth(csrf.CSRFCheck(playlist.DeletePlaylist), false)).Methods("DELETE")
-	r.Handle("/playlists/{playlist:[0-9]+}/tracks/{track:[0-9]+}", auth.Auth(playlist.DeleteTrackFromPlaylist, false)).Methods("DELETE")
-	r.Handle("/playlists/{id:[0-9]+}/tracks/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(m.BoundedVars(playlist.GetBoundedPlaylistTracks, user.Log), true)).Methods("GET")
-	r.Handle("/playlists/{id:[0-9]+}/privacy", auth.Auth(playlist.ChangePrivacy, false)).Methods("POST")    //todo csrf
-	r.Handle("/playlists/shared/{id:[0-9]+}", auth.Auth(playlist.AddSharedPlaylist, false)).Methods("POST") //todo csrf
+	r.Handle("/users/playlists", auth.Auth(limits.Limit(limitPlaylists, playlist.GetUserPlaylists), false)).Methods("GET")
+	r.Handle("/playlists/{id:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, playlist.GetFullPlaylistById), true)).Methods("GET")
+	r.Handle("/playlists/tracks/{id:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, playlist.GetPlaylistsIDByTrack), false)).Methods("GET")
+	r.Handle("/playlists/tracks", auth.Auth(limits.Limit(limitPlaylists, csrf.CSRFCheck(playlist.AddTrackToPlaylist)), false)).Methods("POST")
+	r.Handle("/playlists/new/{name}", auth.Auth(limits.Limit(limitPlaylists, csrf.CSRFCheck(playlist.CreatePlaylist)), false)).Methods("POST")
+	r.Handle("/playlists/{id:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, csrf.CSRFCheck(playlist.DeletePlaylist)), false)).Methods("DELETE")
+	r.Handle("/playlists/{playlist:[0-9]+}/tracks/{track:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, playlist.DeleteTrackFromPlaylist), false)).Methods("DELETE")
+	r.Handle("/playlists/{id:[0-9]+}/tracks/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, m.BoundedVars(playlist.GetBoundedPlaylistTracks, user.Log)), true)).Methods("GET")
+	r.Handle("/playlists/{id:[0-9]+}/privacy", auth.Auth(limits.Limit(limitPlaylists, playlist.ChangePrivacy), false)).Methods("POST")    //todo csrf
+	r.Handle("/playlists/shared/{id:[0-9]+}", auth.Auth(limits.Limit(limitPlaylists, playlist.AddSharedPlaylist), false)).Methods("POST") //todo csrf
 
 	r.Handle("/users/tracks", auth.Auth(track.GetUserTracks, false)).Methods("GET")
 	r.HandleFunc("/tracks/{id:[0-9]+}", track.GetTrack).Methods("GET")
 	r.Handle("/tracks/{id:[0-9]+}/rating", auth.Auth(track.RateTrack, false)).Methods("POST")
+	r.Handle("/tracks/{id:[0-9]+}/plays", auth.Auth(csrf.CSRFCheck(chart.AddPlay), false)).Methods("POST")
+	r.HandleFunc("/tracks/{id:[0-9]+}/lyrics", lyrics.GetLyrics).Methods("GET")
+	r.Handle("/tracks/{id:[0-9]+}/lyrics", auth.Auth(auth.Role(csrf.CSRFCheck(lyrics.SetLyrics), models.RoleArtist), false)).Methods("PUT")
+	r.Handle("/tracks/{id:[0-9]+}/lyrics", auth.Auth(auth.Role(csrf.CSRFCheck(lyrics.DeleteLyrics), models.RoleArtist), false)).Methods("DELETE")
 	r.Handle("/albums/{id:[0-9]+}/tracks/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(m.BoundedVars(track.GetBoundedAlbumTracks, user.Log), true)).Methods("GET")
 	r.Handle("/artists/{id:[0-9]+}/tracks/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(m.BoundedVars(track.GetBoundedArtistTracks, user.Log), true)).Methods("GET")
 
 	r.Handle("/users", auth.Auth(user.CheckAuth, false))
 	r.HandleFunc("/users/{id:[0-9]+}/stat", user.GetUserStat).Methods("GET")
-	r.Handle("/users/login", auth.Auth(user.Login, true)).Methods("POST")
-	r.Handle("/users/signup", auth.Auth(user.Create, true)).Methods("POST")
+	r.Handle("/users/login", auth.Auth(limits.Limit(limitAuth, user.Login), true)).Methods("POST")
+	r.Handle("/users/signup", auth.Auth(limits.Limit(limitAuth, user.Create), true)).Methods("POST")
 	r.Handle("/users/token", auth.Auth(user.GetCSRF, false)).Methods("GET")
 	r.Handle("/users/me", auth.Auth(user.SelfProfile, false)).Methods("GET")
+	r.Handle("/users/me", auth.Auth(csrf.CSRFCheck(user.DeleteAccount), false)).Methods("DELETE")
+	r.Handle("/users/me/export", auth.Auth(user.ExportData, false)).Methods("GET")
+	r.Handle("/users/feed", auth.Auth(feed.GetFeed, false)).Methods("GET")
+	r.Handle("/users/notifications/stream", auth.Auth(notification.Stream, false)).Methods("GET").Name(m.NoTimeout)
+	r.Handle("/users/notifications/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(notification.GetNotifications, false)).Methods("GET")
+	r.Handle("/users/notifications/{id:[0-9]+}/read", auth.Auth(csrf.CSRFCheck(notification.MarkRead), false)).Methods("POST")
+	r.Handle("/users/player", auth.Auth(player.GetState, false)).Methods("GET")
+	r.Handle("/users/player", auth.Auth(csrf.CSRFCheck(player.UpdateState), false)).Methods("PUT")
+	r.Handle("/users/player/next", auth.Auth(csrf.CSRFCheck(player.Next), false)).Methods("POST")
+	r.Handle("/users/player/queue", auth.Auth(csrf.CSRFCheck(player.SeedQueue), false)).Methods("POST")
+	r.Handle("/users/player/queue/next", auth.Auth(csrf.CSRFCheck(player.PlayNext), false)).Methods("POST")
+	r.Handle("/users/player/queue/tracks", auth.Auth(csrf.CSRFCheck(player.AddToQueue), false)).Methods("POST")
+	r.Handle("/users/player/queue/shuffle", auth.Auth(csrf.CSRFCheck(player.Shuffle), false)).Methods("POST")
 	r.Handle("/users/logout", auth.Auth(user.Logout, false)).Methods("DELETE") //todo убрать глаголы
 	r.Handle("/users/profiles/{profile}", auth.Auth(user.Profile, false)).Methods("GET")
+	r.Handle("/users/profiles/{profile}/full", auth.Auth(user.GetFullProfile, true)).Methods("GET")
+	r.Handle("/users/{id:[0-9]+}/follow", auth.Auth(csrf.CSRFCheck(user.Follow), false)).Methods("POST")
+	r.Handle("/users/{id:[0-9]+}/follow", auth.Auth(csrf.CSRFCheck(user.Unfollow), false)).Methods("DELETE")
+	r.HandleFunc("/users/{id:[0-9]+}/followers/{start:[0-9]+}/{end:[0-9]+}", m.BoundedVars(user.GetFollowers, user.Log)).Methods("GET")
+	r.HandleFunc("/users/{id:[0-9]+}/following/{start:[0-9]+}/{end:[0-9]+}", m.BoundedVars(user.GetFollowing, user.Log)).Methods("GET")
 	r.Handle("/users/settings", auth.Auth(csrf.CSRFCheck(user.Update), false)).Methods("PUT")
-	r.Handle("/users/images", auth.Auth(csrf.CSRFCheck(user.UpdateAvatar), false)).Methods("POST")
-
-	r.HandleFunc("/media/{text}/{count:[0-9]+}", search.Search).Methods("GET")
+	r.Handle("/users/images", auth.Auth(limits.Limit(limitUploads, csrf.CSRFCheck(user.UpdateAvatar)), false)).Methods("POST")
+	r.Handle("/users/2fa", auth.Auth(csrf.CSRFCheck(user.SetupTwoFactor), false)).Methods("POST")
+	r.Handle("/users/2fa/confirm", auth.Auth(csrf.CSRFCheck(user.EnableTwoFactor), false)).Methods("POST")
+	r.Handle("/users/2fa", auth.Auth(csrf.CSRFCheck(user.DisableTwoFactor), false)).Methods("DELETE")
+	r.Handle("/users/{id:[0-9]+}/role", auth.Auth(auth.Role(csrf.CSRFCheck(user.SetRole), models.RoleAdmin), false)).Methods("PUT")
+
+	r.Handle("/media/{text}/{count:[0-9]+}", auth.Auth(limits.Limit(limitSearch, search.Search), true)).Methods("GET")
+
+	r.Handle("/admin/artists", auth.Auth(auth.Role(csrf.CSRFCheck(admin.CreateArtist), models.RoleAdmin), false)).Methods("POST")
+	r.Handle("/admin/artists/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.UpdateArtist), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/artists/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.DeleteArtist), models.RoleAdmin), false)).Methods("DELETE")
+	r.Handle("/admin/albums", auth.Auth(auth.Role(csrf.CSRFCheck(admin.CreateAlbum), models.RoleAdmin), false)).Methods("POST")
+	r.Handle("/admin/albums/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.UpdateAlbum), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/albums/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.DeleteAlbum), models.RoleAdmin), false)).Methods("DELETE")
+	r.Handle("/admin/albums/{id:[0-9]+}/tracks", auth.Auth(auth.Role(csrf.CSRFCheck(admin.SetAlbumTracks), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/albums/{id:[0-9]+}/artists", auth.Auth(auth.Role(csrf.CSRFCheck(admin.SetAlbumArtists), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/albums/{id:[0-9]+}/genres", auth.Auth(auth.Role(csrf.CSRFCheck(admin.SetAlbumGenres), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/tracks", auth.Auth(auth.Role(csrf.CSRFCheck(admin.CreateTrack), models.RoleAdmin), false)).Methods("POST")
+	r.Handle("/admin/tracks/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.UpdateTrack), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/tracks/{id:[0-9]+}", auth.Auth(auth.Role(csrf.CSRFCheck(admin.DeleteTrack), models.RoleAdmin), false)).Methods("DELETE")
+	r.Handle("/admin/tracks/{id:[0-9]+}/artists", auth.Auth(auth.Role(csrf.CSRFCheck(admin.SetTrackArtists), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/tracks/{id:[0-9]+}/genres", auth.Auth(auth.Role(csrf.CSRFCheck(admin.SetTrackGenres), models.RoleAdmin), false)).Methods("PUT")
+	r.Handle("/admin/genres", auth.Auth(auth.Role(csrf.CSRFCheck(admin.CreateGenre), models.RoleAdmin), false)).Methods("POST")
+	r.Handle("/admin/audit/{start:[0-9]+}/{end:[0-9]+}", auth.Auth(auth.Role(admin.GetAuditLog, models.RoleAdmin), false)).Methods("GET")
 
 	r.Handle("/metrics", promhttp.Handler())
+	r.HandleFunc("/healthz", checker.Liveness).Methods("GET")
+	r.HandleFunc("/readyz", checker.Readiness).Methods("GET")
 
 	accessMiddleware := m.AccessLogMiddleware(r, user.Log)
 	panicMiddleware := m.PanicMiddleware(accessMiddleware, user.Log)
@@ -210,17 +474,19 @@ func InitRouter(customLogger *logger.MainLogger, db *gorm.DB, csrfToken csrfLib.
 	return panicMiddleware
 }
 
-func StartNew() {
+// StartNew serves the api until SIGINT or SIGTERM. Errors are returned once the opened
+// connections are closed, so the caller can exit
+func StartNew() error {
 	if err := godotenv.Load(); err != nil {
-		log.Fatalf("Failed to export env vars: %v", err)
+		return fmt.Errorf("failed to export env vars: %w", err)
 	}
 	if err := config.ExportConfig(); err != nil {
-		log.Fatalf("Failed to export config: %v", err)
+		return fmt.Errorf("failed to export config: %w", err)
 	}
 
 	db, err := gorm.Open("postgres", os.Getenv("DB_CONN"))
 	if err != nil {
-		log.Fatalf("Failed to start db: %v", err)
+		return fmt.Errorf("failed to start db: %w", err)
 	}
 
 	defer db.Close()
@@ -228,7 +494,17 @@ func StartNew() {
 	db.DB().SetMaxOpenConns(viper.GetInt(config.ConfigFields.DBMaxConnNum))
 
 	if err := db.DB().Ping(); err != nil {
-		log.Fatalf("Failed to ping db: %v", err)
+		return fmt.Errorf("failed to ping db: %w", err)
+	}
+
+	if viper.GetBool(config.ConfigFields.DBMigrateOnStart) {
+		migrator, err := newMigrator(db.DB())
+		if err != nil {
+			return fmt.Errorf("failed to load migrations: %w", err)
+		}
+		if err := migrateUp(context.Background(), migrator); err != nil {
+			return fmt.Errorf("failed to migrate db: %w", err)
+		}
 	}
 
 	c := cors.New(config.CorsInit())
@@ -244,35 +520,50 @@ func StartNew() {
 	customLogger.SetLevel(logrus.TraceLevel)
 	defer f.Close()
 
+	shutdownTracing, err := tracing.Init(context.Background(),
+		viper.GetString(config.ConfigFields.TracingService),
+		viper.GetString(config.ConfigFields.TracingEndpoint),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			customLogger.Errorf("failed to flush spans: %v", err)
+		}
+	}()
+
 	redisAddr := flag.String("addr", viper.GetString(config.ConfigFields.RedisAddr), "redis addr")
 	redisConn := &redis.Pool{
 		MaxIdle:   80,
 		MaxActive: 12000,
 		Wait:      true,
 		Dial: func() (redis.Conn, error) {
-			conn, err := redis.DialURL(*redisAddr)
-			if err != nil {
-				log.Fatalf("failed to init redis pool: %v", err)
-			}
-			return conn, err
+			return redis.DialURL(*redisAddr)
 		},
 	}
 	defer redisConn.Close()
 
+	metrics.Register(db.DB(), redisConn)
+
 	csrfRepo := repository.NewRedisTokenManager(redisConn)
 
 	csrfToken, err := csrfLib.NewAesCryptHashToken(os.Getenv("CSRF_SECRET"), viper.GetInt64(config.ConfigFields.CsrfDuration), &csrfRepo)
 	if err != nil {
-		log.Fatalf("failed to init csrf token: %v", err)
+		return fmt.Errorf("failed to init csrf token: %w", err)
 	}
 
 	grpcSessionsConn, err := grpc.Dial(
 		viper.GetString(config.ConfigFields.GRPCsessions),
-		grpc.WithUnaryInterceptor(getInterceptor(customLogger)),
+		grpc.WithChainUnaryInterceptor(
+			otelgrpc.UnaryClientInterceptor(),
+			grpc_prometheus.UnaryClientInterceptor,
+			getInterceptor(customLogger),
+		),
 		grpc.WithInsecure(),
 	)
 	if err != nil {
-		log.Fatalf("cant connect to grpc")
+		return fmt.Errorf("failed to connect to sessions grpc: %w", err)
 	}
 	defer grpcSessionsConn.Close()
 
@@ -280,21 +571,65 @@ func StartNew() {
 
 	grpcFileserverConn, err := grpc.Dial(
 		viper.GetString(config.ConfigFields.GRPCfs),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), grpc_prometheus.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), grpc_prometheus.StreamClientInterceptor),
 		grpc.WithInsecure(),
 	)
 	if err != nil {
-		log.Fatalf("cant connect to grpc")
+		return fmt.Errorf("failed to connect to fileserver grpc: %w", err)
 	}
 	defer grpcFileserverConn.Close()
 
 	fileserver := filetransfer.NewUploadServiceClient(grpcFileserverConn)
 
-	routes := InitRouter(customLogger, db, csrfToken, sessManager, fileserver)
+	checker := health.NewChecker(time.Duration(viper.GetInt64(config.ConfigFields.HealthTimeout)) * time.Second)
+	checker.Add("postgres", health.DB(db.DB()))
+	checker.Add("redis", health.Redis(redisConn))
+	checker.Add("sessions", health.GRPC(grpcSessionsConn))
+	checker.Add("fileserver", health.GRPC(grpcFileserverConn))
 
-	fmt.Println("Starts server at ", viper.GetString(config.ConfigFields.MainAddr))
-	err = http.ListenAndServe(viper.GetString(config.ConfigFields.MainAddr), c.Handler(m.HeadersHandler(routes)))
-	if err != nil {
-		log.Println(err)
-		return
+	if size := viper.GetInt64(config.ConfigFields.MaxBodySize); size > 0 {
+		validation.MaxBodySize = size
+	}
+	routes := InitRouter(customLogger, db, redisConn, csrfToken, sessManager, fileserver, checker)
+
+	// baseCtx outlives graceful shutdown and is cancelled only to cut the requests which didn't drain in time,
+	// e.g. notification streams
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+	srv := &http.Server{
+		Addr:    viper.GetString(config.ConfigFields.MainAddr),
+		Handler: c.Handler(m.HeadersHandler(routes)),
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		fmt.Println("Starts server at ", srv.Addr)
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped: %w", err)
+	case sig := <-stop:
+		customLogger.Infof("got %v, shutting down", sig)
+	}
+
+	// keep serving while balancers poll /readyz and take the instance out of rotation
+	checker.Drain()
+	time.Sleep(time.Duration(viper.GetInt64(config.ConfigFields.DrainDelay)) * time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(viper.GetInt64(config.ConfigFields.ShutdownTimeout))*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		customLogger.Warnf("requests didn't drain in time: %v", err)
+		cancelRequests()
+		srv.Close()
 	}
+	return nil
 }
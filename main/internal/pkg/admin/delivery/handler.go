@@ -0,0 +1,360 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+)
+
+type AdminHandler struct {
+	AdminUC admin.UseCase
+	Log     *logger.MainLogger
+}
+
+func (h *AdminHandler) getUser(w http.ResponseWriter, r *http.Request, funcName string) (models.User, bool) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return models.User{}, false
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "admin delivery", funcName, "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return models.User{}, false
+	}
+	return user, true
+}
+
+func (h *AdminHandler) getID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return "", false
+	}
+	return id, true
+}
+
+func (h *AdminHandler) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := validation.DecodeJSON(r, v); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return false
+	}
+	return true
+}
+
+func (h *AdminHandler) sendCreated(w http.ResponseWriter, r *http.Request, funcName string, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.Log.LogWarning(r.Context(), "admin delivery", funcName, "failed to encode json"+err.Error())
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusCreated)
+}
+
+func (h *AdminHandler) sendResult(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), msg+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *AdminHandler) CreateArtist(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "CreateArtist")
+	if !ok {
+		return
+	}
+	input := models.Artist{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	artist, err := h.AdminUC.CreateArtist(r.Context(), user, input)
+	if err != nil {
+		h.sendResult(w, r, err, "failed to create artist:")
+		return
+	}
+	h.sendCreated(w, r, "CreateArtist", artist)
+}
+
+func (h *AdminHandler) UpdateArtist(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "UpdateArtist")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.Artist{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+	input.Id = id
+
+	h.sendResult(w, r, h.AdminUC.UpdateArtist(r.Context(), user, input), "failed to update artist:")
+}
+
+func (h *AdminHandler) DeleteArtist(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "DeleteArtist")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.DeleteArtist(r.Context(), user, id), "failed to delete artist:")
+}
+
+func (h *AdminHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "CreateAlbum")
+	if !ok {
+		return
+	}
+	input := models.Album{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	album, err := h.AdminUC.CreateAlbum(r.Context(), user, input)
+	if err != nil {
+		h.sendResult(w, r, err, "failed to create album:")
+		return
+	}
+	h.sendCreated(w, r, "CreateAlbum", album)
+}
+
+func (h *AdminHandler) UpdateAlbum(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "UpdateAlbum")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.Album{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+	input.Id = id
+
+	h.sendResult(w, r, h.AdminUC.UpdateAlbum(r.Context(), user, input), "failed to update album:")
+}
+
+func (h *AdminHandler) DeleteAlbum(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "DeleteAlbum")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.DeleteAlbum(r.Context(), user, id), "failed to delete album:")
+}
+
+func (h *AdminHandler) SetAlbumTracks(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "SetAlbumTracks")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.AlbumTracks{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.SetAlbumTracks(r.Context(), user, id, input), "failed to set album tracks:")
+}
+
+func (h *AdminHandler) SetAlbumArtists(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "SetAlbumArtists")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.ArtistCredits{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.SetAlbumArtists(r.Context(), user, id, input), "failed to set album artists:")
+}
+
+func (h *AdminHandler) SetAlbumGenres(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "SetAlbumGenres")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.GenreTags{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.SetAlbumGenres(r.Context(), user, id, input), "failed to set album genres:")
+}
+
+func (h *AdminHandler) CreateTrack(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "CreateTrack")
+	if !ok {
+		return
+	}
+	input := models.Track{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	track, err := h.AdminUC.CreateTrack(r.Context(), user, input)
+	if err != nil {
+		h.sendResult(w, r, err, "failed to create track:")
+		return
+	}
+	h.sendCreated(w, r, "CreateTrack", track)
+}
+
+func (h *AdminHandler) UpdateTrack(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "UpdateTrack")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.Track{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+	input.Id = id
+
+	h.sendResult(w, r, h.AdminUC.UpdateTrack(r.Context(), user, input), "failed to update track:")
+}
+
+func (h *AdminHandler) SetTrackArtists(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "SetTrackArtists")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.ArtistCredits{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.SetTrackArtists(r.Context(), user, id, input), "failed to set track artists:")
+}
+
+func (h *AdminHandler) SetTrackGenres(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "SetTrackGenres")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+	input := models.GenreTags{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.SetTrackGenres(r.Context(), user, id, input), "failed to set track genres:")
+}
+
+func (h *AdminHandler) CreateGenre(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "CreateGenre")
+	if !ok {
+		return
+	}
+	input := models.Genre{}
+	if !h.decode(w, r, &input) {
+		return
+	}
+
+	genre, err := h.AdminUC.CreateGenre(r.Context(), user, input)
+	if err != nil {
+		h.sendResult(w, r, err, "failed to create genre:")
+		return
+	}
+	h.sendCreated(w, r, "CreateGenre", genre)
+}
+
+func (h *AdminHandler) DeleteTrack(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.getUser(w, r, "DeleteTrack")
+	if !ok {
+		return
+	}
+	id, ok := h.getID(w, r)
+	if !ok {
+		return
+	}
+
+	h.sendResult(w, r, h.AdminUC.DeleteTrack(r.Context(), user, id), "failed to delete track:")
+}
+
+func (h *AdminHandler) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	start, okStart := vars["start"]
+	end, okEnd := vars["end"]
+
+	if !okStart || !okEnd {
+		h.Log.HttpInfo(r.Context(), "no data in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	uStart, err1 := strconv.ParseUint(start, 10, 32)
+	uEnd, err2 := strconv.ParseUint(end, 10, 32)
+	if err1 != nil || err2 != nil || uStart > uEnd {
+		h.Log.HttpInfo(r.Context(), "failed to parse start or end parameters", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log, err := h.AdminUC.GetAuditLog(r.Context(), uStart, uEnd)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get audit log"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Entries []models.AuditEntry `json:"entries"`
+	}{log})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "admin delivery", "GetAuditLog", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
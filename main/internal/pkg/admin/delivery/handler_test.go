@@ -0,0 +1,382 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var adminHandler AdminHandler
+
+var testAdmin = models.User{
+	Id:    "1",
+	Login: "admin",
+	Role:  models.RoleAdmin,
+}
+
+func init() {
+	adminHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestCreateArtist(t *testing.T) {
+	input := models.Artist{
+		Name:  "Test Artist",
+		Genre: "rock",
+	}
+
+	t.Run("CreateArtist-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		created := input
+		created.Id = "5"
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			CreateArtist(gomock.Any(), testAdmin, input).
+			Return(created, nil)
+
+		adminHandler.AdminUC = m
+
+		body, err := json.Marshal(created)
+		assert.NoError(t, err)
+
+		apitest.New("CreateArtist-OK").
+			Handler(middleware.AuthMiddlewareMock(adminHandler.CreateArtist, true, testAdmin, "")).
+			Method("Post").
+			JSON(input).
+			Expect(t).
+			Body(string(body)).
+			Status(http.StatusCreated).
+			End()
+	})
+
+	t.Run("CreateArtist-UseCaseError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			CreateArtist(gomock.Any(), testAdmin, input).
+			Return(models.Artist{}, errors.New("test error"))
+
+		adminHandler.AdminUC = m
+
+		apitest.New("CreateArtist-UseCaseError").
+			Handler(middleware.AuthMiddlewareMock(adminHandler.CreateArtist, true, testAdmin, "")).
+			Method("Post").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("CreateArtist-WrongJSON", func(t *testing.T) {
+		apitest.New("CreateArtist-WrongJSON").
+			Handler(middleware.AuthMiddlewareMock(adminHandler.CreateArtist, true, testAdmin, "")).
+			Method("Post").
+			Body("{name:").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("CreateArtist-NoCSRF", func(t *testing.T) {
+		apitest.New("CreateArtist-NoCSRF").
+			Handler(http.HandlerFunc(adminHandler.CreateArtist)).
+			Method("Post").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
+
+func TestUpdateTrack(t *testing.T) {
+	input := models.Track{
+		Name:     "Test Track",
+		Duration: 215,
+		Link:     "/static/audio/test.mp3",
+		ArtistID: "3",
+	}
+
+	t.Run("UpdateTrack-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := input
+		expected.Id = "11"
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			UpdateTrack(gomock.Any(), testAdmin, expected).
+			Return(nil)
+
+		adminHandler.AdminUC = m
+
+		handler := middleware.SetMuxVars(adminHandler.UpdateTrack, "id", expected.Id)
+
+		apitest.New("UpdateTrack-OK").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("UpdateTrack-NoMuxVars", func(t *testing.T) {
+		apitest.New("UpdateTrack-NoMuxVars").
+			Handler(middleware.AuthMiddlewareMock(adminHandler.UpdateTrack, true, testAdmin, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestDeleteAlbum(t *testing.T) {
+	t.Run("DeleteAlbum-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			DeleteAlbum(gomock.Any(), testAdmin, "7").
+			Return(nil)
+
+		adminHandler.AdminUC = m
+
+		handler := middleware.SetMuxVars(adminHandler.DeleteAlbum, "id", "7")
+
+		apitest.New("DeleteAlbum-OK").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+			Method("Delete").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("DeleteAlbum-NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			DeleteAlbum(gomock.Any(), testAdmin, "7").
+			Return(apperrors.New(apperrors.NotFound, "album not found"))
+
+		adminHandler.AdminUC = m
+
+		handler := middleware.SetMuxVars(adminHandler.DeleteAlbum, "id", "7")
+
+		apitest.New("DeleteAlbum-NotFound").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+			Method("Delete").
+			Expect(t).
+			Status(http.StatusNotFound).
+			Body(`{"error":{"code":"not_found","message":"album not found"}}`).
+			End()
+	})
+}
+
+func TestSetAlbumTracks(t *testing.T) {
+	input := models.AlbumTracks{Tracks: []string{"3", "1", "2"}, Discs: []uint{1, 1, 2}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := admin.NewMockUseCase(ctrl)
+	m.EXPECT().
+		SetAlbumTracks(gomock.Any(), testAdmin, "7", input).
+		Return(nil)
+
+	adminHandler.AdminUC = m
+
+	handler := middleware.SetMuxVars(adminHandler.SetAlbumTracks, "id", "7")
+
+	apitest.New("SetAlbumTracks-OK").
+		Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+		Method("Put").
+		JSON(input).
+		Expect(t).
+		Status(http.StatusOK).
+		End()
+}
+
+func TestSetTrackArtists(t *testing.T) {
+	t.Run("SetTrackArtists-OK", func(t *testing.T) {
+		input := models.ArtistCredits{Artists: []models.ArtistCredit{{Id: "4", Role: models.CreditFeatured}}}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			SetTrackArtists(gomock.Any(), testAdmin, "11", input).
+			Return(nil)
+
+		adminHandler.AdminUC = m
+
+		handler := middleware.SetMuxVars(adminHandler.SetTrackArtists, "id", "11")
+
+		apitest.New("SetTrackArtists-OK").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("SetTrackArtists-BadJSON", func(t *testing.T) {
+		handler := middleware.SetMuxVars(adminHandler.SetTrackArtists, "id", "11")
+
+		apitest.New("SetTrackArtists-BadJSON").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+			Method("Put").
+			Body("{artists").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestSetAlbumArtists(t *testing.T) {
+	input := models.ArtistCredits{Artists: []models.ArtistCredit{{Id: "5", Role: models.CreditProducer}}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := admin.NewMockUseCase(ctrl)
+	m.EXPECT().
+		SetAlbumArtists(gomock.Any(), testAdmin, "7", input).
+		Return(errors.New("fk violation"))
+
+	adminHandler.AdminUC = m
+
+	handler := middleware.SetMuxVars(adminHandler.SetAlbumArtists, "id", "7")
+
+	apitest.New("SetAlbumArtists-Error").
+		Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+		Method("Put").
+		JSON(input).
+		Expect(t).
+		Status(http.StatusInternalServerError).
+		End()
+}
+
+func TestCreateGenre(t *testing.T) {
+	input := models.Genre{Name: "grunge", ParentId: "1"}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := admin.NewMockUseCase(ctrl)
+	m.EXPECT().
+		CreateGenre(gomock.Any(), testAdmin, input).
+		Return(models.Genre{Id: "4", Name: "grunge", ParentId: "1"}, nil)
+
+	adminHandler.AdminUC = m
+
+	apitest.New("CreateGenre-OK").
+		Handler(middleware.AuthMiddlewareMock(adminHandler.CreateGenre, true, testAdmin, "")).
+		Method("Post").
+		JSON(input).
+		Expect(t).
+		Status(http.StatusCreated).
+		Body(`{"id":"4","name":"grunge","parent_id":"1"}`).
+		End()
+}
+
+func TestSetTrackGenres(t *testing.T) {
+	input := models.GenreTags{Genres: []string{"4"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := admin.NewMockUseCase(ctrl)
+	m.EXPECT().
+		SetTrackGenres(gomock.Any(), testAdmin, "11", input).
+		Return(nil)
+
+	adminHandler.AdminUC = m
+
+	handler := middleware.SetMuxVars(adminHandler.SetTrackGenres, "id", "11")
+
+	apitest.New("SetTrackGenres-OK").
+		Handler(middleware.AuthMiddlewareMock(handler, true, testAdmin, "")).
+		Method("Put").
+		JSON(input).
+		Expect(t).
+		Status(http.StatusOK).
+		End()
+}
+
+func TestGetAuditLog(t *testing.T) {
+	t.Run("GetAuditLog-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		entries := []models.AuditEntry{
+			{
+				Id:        "1",
+				UserId:    testAdmin.Id,
+				Entity:    admin.EntityTrack,
+				EntityId:  "11",
+				Action:    admin.ActionDelete,
+				CreatedAt: "2020-05-12T10:00:00Z",
+			},
+		}
+
+		m := admin.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetAuditLog(gomock.Any(), uint64(0), uint64(10)).
+			Return(entries, nil)
+
+		adminHandler.AdminUC = m
+
+		body, err := json.Marshal(struct {
+			Entries []models.AuditEntry `json:"entries"`
+		}{entries})
+		assert.NoError(t, err)
+
+		handler := middleware.SetUnlimitedVars(adminHandler.GetAuditLog,
+			middleware.VarsPair{Key: "start", Value: "0"},
+			middleware.VarsPair{Key: "end", Value: "10"},
+		)
+
+		apitest.New("GetAuditLog-OK").
+			Handler(handler).
+			Method("Get").
+			Expect(t).
+			Body(string(body)).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetAuditLog-WrongBounds", func(t *testing.T) {
+		handler := middleware.SetUnlimitedVars(adminHandler.GetAuditLog,
+			middleware.VarsPair{Key: "start", Value: "10"},
+			middleware.VarsPair{Key: "end", Value: "0"},
+		)
+
+		apitest.New("GetAuditLog-WrongBounds").
+			Handler(handler).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
@@ -0,0 +1,11 @@
+package admin
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	AddAuditEntry(ctx context.Context, entry models.AuditEntry) error
+	GetAuditLog(ctx context.Context, start, end uint64) ([]models.AuditEntry, error)
+}
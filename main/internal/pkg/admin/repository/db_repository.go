@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+	"strconv"
+	"time"
+)
+
+// AuditEntry is a row of catalog_audit, user_id is null once the account of the author is deleted
+type AuditEntry struct {
+	Id        uint64    `gorm:"column:id"`
+	UserId    *uint64   `gorm:"column:user_id"`
+	Entity    string    `gorm:"column:entity"`
+	EntityId  uint64    `gorm:"column:entity_id"`
+	Action    string    `gorm:"column:action"`
+	Changes   []byte    `gorm:"column:changes"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+type DbAdminRepository struct {
+	db *gorm.DB
+}
+
+func NewDbAdminRepository(database *gorm.DB) DbAdminRepository {
+	return DbAdminRepository{
+		db: database,
+	}
+}
+
+func toModel(entry AuditEntry) models.AuditEntry {
+	return models.AuditEntry{
+		Id:        strconv.FormatUint(entry.Id, 10),
+		UserId:    fromNullable(entry.UserId),
+		Entity:    entry.Entity,
+		EntityId:  strconv.FormatUint(entry.EntityId, 10),
+		Action:    entry.Action,
+		Changes:   entry.Changes,
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func fromNullable(id *uint64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(*id, 10)
+}
+
+func (ar *DbAdminRepository) AddAuditEntry(ctx context.Context, entry models.AuditEntry) error {
+	var changes interface{}
+	if len(entry.Changes) != 0 {
+		changes = string(entry.Changes)
+	}
+
+	db := database.WithContext(ctx, ar.db).Exec("insert into catalog_audit (user_id, entity, entity_id, action, changes) values (?, ?, ?, ?, ?::jsonb)",
+		entry.UserId, entry.Entity, entry.EntityId, entry.Action, changes)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to add audit entry: %w", err)
+	}
+	return nil
+}
+
+func (ar *DbAdminRepository) GetAuditLog(ctx context.Context, start, end uint64) ([]models.AuditEntry, error) {
+	var entries []AuditEntry
+	limit := end - start
+
+	db := database.WithContext(ctx, ar.db).
+		Table("catalog_audit").
+		Order("id desc").
+		Limit(limit).
+		Offset(start).
+		Find(&entries)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get audit log: %w", err)
+	}
+
+	log := make([]models.AuditEntry, len(entries))
+	for i, elem := range entries {
+		log[i] = toModel(elem)
+	}
+	return log, nil
+}
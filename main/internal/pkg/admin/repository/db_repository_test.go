@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbAdminRepository
+	entry      models.AuditEntry
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.entry = models.AuditEntry{
+		Id:        "3",
+		UserId:    "1",
+		Entity:    "album",
+		EntityId:  "42",
+		Action:    "update",
+		Changes:   []byte(`{"name":"test-album"}`),
+		CreatedAt: "2020-05-12T10:00:00Z",
+	}
+
+	s.repository = NewDbAdminRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestAddAuditEntry() {
+	entry := s.entry
+
+	s.mock.ExpectExec("insert into catalog_audit").
+		WithArgs(entry.UserId, entry.Entity, entry.EntityId, entry.Action, string(entry.Changes)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.AddAuditEntry(context.Background(), entry))
+
+	//test on entry without changes
+	entry.Changes = nil
+
+	s.mock.ExpectExec("insert into catalog_audit").
+		WithArgs(entry.UserId, entry.Entity, entry.EntityId, entry.Action, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.AddAuditEntry(context.Background(), entry))
+
+	//test on db error
+	s.mock.ExpectExec("insert into catalog_audit").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.AddAuditEntry(context.Background(), entry))
+}
+
+func (s *Suite) TestGetAuditLog() {
+	entry := s.entry
+	createdAt, _ := time.Parse(time.RFC3339, entry.CreatedAt)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "catalog_audit" ORDER BY id desc LIMIT 10 OFFSET 0`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "entity", "entity_id", "action", "changes", "created_at"}).
+			AddRow(entry.Id, entry.UserId, entry.Entity, entry.EntityId, entry.Action, entry.Changes, createdAt))
+
+	res, err := s.repository.GetAuditLog(context.Background(), 0, 10)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.AuditEntry{entry}, res))
+
+	//entries of deleted accounts are kept without the author
+	s.mock.ExpectQuery("SELECT").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "entity", "entity_id", "action", "changes", "created_at"}).
+			AddRow(entry.Id, nil, entry.Entity, entry.EntityId, entry.Action, entry.Changes, createdAt))
+
+	res, err = s.repository.GetAuditLog(context.Background(), 0, 10)
+
+	require.NoError(s.T(), err)
+	entry.UserId = ""
+	require.Nil(s.T(), deep.Equal([]models.AuditEntry{entry}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetAuditLog(context.Background(), 0, 10)
+
+	require.Error(s.T(), err)
+}
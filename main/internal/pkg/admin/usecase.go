@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+const (
+	EntityArtist = "artist"
+	EntityAlbum  = "album"
+	EntityTrack  = "track"
+	EntityGenre  = "genre"
+
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionReorder = "reorder"
+	ActionCredit  = "credit"
+	ActionTag     = "tag"
+)
+
+type UseCase interface {
+	CreateArtist(ctx context.Context, user models.User, artist models.Artist) (models.Artist, error)
+	UpdateArtist(ctx context.Context, user models.User, artist models.Artist) error
+	DeleteArtist(ctx context.Context, user models.User, id string) error
+	CreateAlbum(ctx context.Context, user models.User, album models.Album) (models.Album, error)
+	UpdateAlbum(ctx context.Context, user models.User, album models.Album) error
+	DeleteAlbum(ctx context.Context, user models.User, id string) error
+	SetAlbumTracks(ctx context.Context, user models.User, aID string, tracks models.AlbumTracks) error
+	SetAlbumArtists(ctx context.Context, user models.User, aID string, credits models.ArtistCredits) error
+	SetAlbumGenres(ctx context.Context, user models.User, aID string, tags models.GenreTags) error
+	CreateTrack(ctx context.Context, user models.User, track models.Track) (models.Track, error)
+	UpdateTrack(ctx context.Context, user models.User, track models.Track) error
+	SetTrackArtists(ctx context.Context, user models.User, tID string, credits models.ArtistCredits) error
+	SetTrackGenres(ctx context.Context, user models.User, tID string, tags models.GenreTags) error
+	DeleteTrack(ctx context.Context, user models.User, id string) error
+	CreateGenre(ctx context.Context, user models.User, genre models.Genre) (models.Genre, error)
+	GetAuditLog(ctx context.Context, start, end uint64) ([]models.AuditEntry, error)
+}
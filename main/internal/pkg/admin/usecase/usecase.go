@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+// MaxAuditCount is the most audit entries returned by one request
+const MaxAuditCount = 100
+
+type AdminUseCase struct {
+	ArtistRepository artist.Repository
+	AlbumRepository  album.Repository
+	TrackRepository  track.Repository
+	GenreRepository  genre.Repository
+	AuditRepository  admin.Repository
+	NotificationUC   notification.UseCase
+	Log              *logger.MainLogger
+}
+
+// audit records a change that is already applied, so a failed record is only logged, an error would
+// make the client repeat a change that went through
+func (uc *AdminUseCase) audit(ctx context.Context, user models.User, entity string, id string, action string, changes interface{}) {
+	entry := models.AuditEntry{
+		UserId:   user.Id,
+		Entity:   entity,
+		EntityId: id,
+		Action:   action,
+	}
+	if changes != nil {
+		data, err := json.Marshal(changes)
+		if err != nil {
+			uc.Log.LogWarning(ctx, "admin usecase", "audit", "failed to marshal audit changes of "+entity+" "+id+": "+err.Error())
+		}
+		entry.Changes = data
+	}
+	if err := uc.AuditRepository.AddAuditEntry(ctx, entry); err != nil {
+		uc.Log.LogWarning(ctx, "admin usecase", "audit", "failed to audit "+action+" of "+entity+" "+id+": "+err.Error())
+	}
+}
+
+// announceRelease tells everyone subscribed to the artist about a new album or track. The release
+// is already created, so a failed announcement is only logged, an error would make the client create it again
+func (uc *AdminUseCase) announceRelease(ctx context.Context, entity string, id string, name string, artistID string) {
+	err := uc.NotificationUC.NotifySubscribers(ctx, artistID, models.NotificationRelease, models.ReleasePayload{
+		Type:     entity,
+		Id:       id,
+		Name:     name,
+		ArtistId: artistID,
+	})
+	if err != nil {
+		uc.Log.LogWarning(ctx, "admin usecase", "announceRelease", "failed to announce "+entity+" "+id+": "+err.Error())
+	}
+}
+
+func (uc *AdminUseCase) CreateArtist(ctx context.Context, user models.User, artist models.Artist) (models.Artist, error) {
+	if err := validateArtist(artist); err != nil {
+		return models.Artist{}, err
+	}
+	id, err := uc.ArtistRepository.CreateArtist(ctx, artist)
+	if err != nil {
+		return models.Artist{}, err
+	}
+	artist.Id = id
+	uc.audit(ctx, user, admin.EntityArtist, id, admin.ActionCreate, artist)
+	return artist, nil
+}
+
+func (uc *AdminUseCase) UpdateArtist(ctx context.Context, user models.User, artist models.Artist) error {
+	if err := validateArtist(artist); err != nil {
+		return err
+	}
+	if err := uc.ArtistRepository.UpdateArtist(ctx, artist); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityArtist, artist.Id, admin.ActionUpdate, artist)
+	return nil
+}
+
+func (uc *AdminUseCase) DeleteArtist(ctx context.Context, user models.User, id string) error {
+	if err := uc.ArtistRepository.DeleteArtist(ctx, id); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityArtist, id, admin.ActionDelete, nil)
+	return nil
+}
+
+func (uc *AdminUseCase) CreateAlbum(ctx context.Context, user models.User, album models.Album) (models.Album, error) {
+	if err := validateAlbum(album); err != nil {
+		return models.Album{}, err
+	}
+	id, err := uc.AlbumRepository.CreateAlbum(ctx, album)
+	if err != nil {
+		return models.Album{}, err
+	}
+	album.Id = id
+	uc.audit(ctx, user, admin.EntityAlbum, id, admin.ActionCreate, album)
+	uc.announceRelease(ctx, admin.EntityAlbum, id, album.Name, album.ArtistId)
+	return album, nil
+}
+
+func (uc *AdminUseCase) UpdateAlbum(ctx context.Context, user models.User, album models.Album) error {
+	if err := validateAlbum(album); err != nil {
+		return err
+	}
+	if err := uc.AlbumRepository.UpdateAlbum(ctx, album); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityAlbum, album.Id, admin.ActionUpdate, album)
+	return nil
+}
+
+func (uc *AdminUseCase) DeleteAlbum(ctx context.Context, user models.User, id string) error {
+	if err := uc.AlbumRepository.DeleteAlbum(ctx, id); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityAlbum, id, admin.ActionDelete, nil)
+	return nil
+}
+
+func (uc *AdminUseCase) SetAlbumTracks(ctx context.Context, user models.User, aID string, tracks models.AlbumTracks) error {
+	if err := validateAlbumTracks(tracks); err != nil {
+		return err
+	}
+	if err := uc.AlbumRepository.SetAlbumTracks(ctx, aID, tracks); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityAlbum, aID, admin.ActionReorder, tracks)
+	return nil
+}
+
+func (uc *AdminUseCase) SetAlbumArtists(ctx context.Context, user models.User, aID string, credits models.ArtistCredits) error {
+	if err := validateCredits(credits); err != nil {
+		return err
+	}
+	if err := uc.AlbumRepository.SetAlbumArtists(ctx, aID, credits.Artists); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityAlbum, aID, admin.ActionCredit, credits)
+	return nil
+}
+
+func (uc *AdminUseCase) SetAlbumGenres(ctx context.Context, user models.User, aID string, tags models.GenreTags) error {
+	if err := validateGenreTags(tags); err != nil {
+		return err
+	}
+	if err := uc.GenreRepository.SetAlbumGenres(ctx, aID, tags.Genres); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityAlbum, aID, admin.ActionTag, tags)
+	return nil
+}
+
+func (uc *AdminUseCase) CreateTrack(ctx context.Context, user models.User, track models.Track) (models.Track, error) {
+	if err := validateTrack(track); err != nil {
+		return models.Track{}, err
+	}
+	id, err := uc.TrackRepository.CreateTrack(ctx, track)
+	if err != nil {
+		return models.Track{}, err
+	}
+	track.Id = id
+	uc.audit(ctx, user, admin.EntityTrack, id, admin.ActionCreate, track)
+	uc.announceRelease(ctx, admin.EntityTrack, id, track.Name, track.ArtistID)
+	return track, nil
+}
+
+func (uc *AdminUseCase) UpdateTrack(ctx context.Context, user models.User, track models.Track) error {
+	if err := validateTrack(track); err != nil {
+		return err
+	}
+	if err := uc.TrackRepository.UpdateTrack(ctx, track); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityTrack, track.Id, admin.ActionUpdate, track)
+	return nil
+}
+
+func (uc *AdminUseCase) SetTrackArtists(ctx context.Context, user models.User, tID string, credits models.ArtistCredits) error {
+	if err := validateCredits(credits); err != nil {
+		return err
+	}
+	if err := uc.TrackRepository.SetTrackArtists(ctx, tID, credits.Artists); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityTrack, tID, admin.ActionCredit, credits)
+	return nil
+}
+
+func (uc *AdminUseCase) SetTrackGenres(ctx context.Context, user models.User, tID string, tags models.GenreTags) error {
+	if err := validateGenreTags(tags); err != nil {
+		return err
+	}
+	if err := uc.GenreRepository.SetTrackGenres(ctx, tID, tags.Genres); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityTrack, tID, admin.ActionTag, tags)
+	return nil
+}
+
+func (uc *AdminUseCase) DeleteTrack(ctx context.Context, user models.User, id string) error {
+	if err := uc.TrackRepository.DeleteTrack(ctx, id); err != nil {
+		return err
+	}
+	uc.audit(ctx, user, admin.EntityTrack, id, admin.ActionDelete, nil)
+	return nil
+}
+
+func (uc *AdminUseCase) CreateGenre(ctx context.Context, user models.User, genre models.Genre) (models.Genre, error) {
+	if err := validateGenre(genre); err != nil {
+		return models.Genre{}, err
+	}
+	id, err := uc.GenreRepository.CreateGenre(ctx, genre)
+	if err != nil {
+		return models.Genre{}, err
+	}
+	genre.Id = id
+	uc.audit(ctx, user, admin.EntityGenre, id, admin.ActionCreate, genre)
+	return genre, nil
+}
+
+func (uc *AdminUseCase) GetAuditLog(ctx context.Context, start, end uint64) ([]models.AuditEntry, error) {
+	if end-start > MaxAuditCount {
+		end = start + MaxAuditCount
+	}
+	return uc.AuditRepository.GetAuditLog(ctx, start, end)
+}
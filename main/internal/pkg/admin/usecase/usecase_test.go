@@ -0,0 +1,633 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/admin"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+var testAdmin = models.User{
+	Id:    "1",
+	Login: "admin",
+	Role:  models.RoleAdmin,
+}
+
+var testArtist = models.Artist{
+	Name:  "Test Artist",
+	Genre: "rock",
+}
+
+var testAlbum = models.Album{
+	Id:       "7",
+	Name:     "Test Album",
+	Release:  "12-01-1999",
+	ArtistId: "3",
+}
+
+var testTrack = models.Track{
+	Id:       "11",
+	Name:     "Test Track",
+	Duration: 215,
+	Link:     "/static/audio/test.mp3",
+	ArtistID: "3",
+}
+
+func TestCreateArtist(t *testing.T) {
+	t.Run("CreateArtist-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		artistRep := artist.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		artistRep.EXPECT().
+			CreateArtist(gomock.Any(), testArtist).
+			Return("5", nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entry models.AuditEntry) error {
+				assert.Equal(t, testAdmin.Id, entry.UserId)
+				assert.Equal(t, admin.EntityArtist, entry.Entity)
+				assert.Equal(t, "5", entry.EntityId)
+				assert.Equal(t, admin.ActionCreate, entry.Action)
+				assert.Contains(t, string(entry.Changes), testArtist.Name)
+				return nil
+			})
+
+		useCase := AdminUseCase{
+			ArtistRepository: artistRep,
+			AuditRepository:  auditRep,
+		}
+
+		res, err := useCase.CreateArtist(context.Background(), testAdmin, testArtist)
+		assert.NoError(t, err)
+		assert.Equal(t, "5", res.Id)
+	})
+
+	t.Run("CreateArtist-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			ArtistRepository: artist.NewMockRepository(ctrl),
+			AuditRepository:  admin.NewMockRepository(ctrl),
+		}
+
+		input := testArtist
+		input.Name = strings.Repeat("a", artistNameLen+1)
+
+		_, err := useCase.CreateArtist(context.Background(), testAdmin, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("CreateArtist-RepoError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		artistRep := artist.NewMockRepository(ctrl)
+		artistRep.EXPECT().
+			CreateArtist(gomock.Any(), testArtist).
+			Return("", errors.New("test error"))
+
+		useCase := AdminUseCase{
+			ArtistRepository: artistRep,
+			AuditRepository:  admin.NewMockRepository(ctrl),
+		}
+
+		_, err := useCase.CreateArtist(context.Background(), testAdmin, testArtist)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteArtist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	artistRep := artist.NewMockRepository(ctrl)
+	auditRep := admin.NewMockRepository(ctrl)
+
+	artistRep.EXPECT().
+		DeleteArtist(gomock.Any(), "5").
+		Return(nil)
+
+	auditRep.EXPECT().
+		AddAuditEntry(gomock.Any(), models.AuditEntry{
+			UserId:   testAdmin.Id,
+			Entity:   admin.EntityArtist,
+			EntityId: "5",
+			Action:   admin.ActionDelete,
+		}).
+		Return(nil)
+
+	useCase := AdminUseCase{
+		ArtistRepository: artistRep,
+		AuditRepository:  auditRep,
+	}
+
+	assert.NoError(t, useCase.DeleteArtist(context.Background(), testAdmin, "5"))
+}
+
+func TestCreateAlbum(t *testing.T) {
+	t.Run("CreateAlbum-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+		notificationUC := notification.NewMockUseCase(ctrl)
+
+		albumRep.EXPECT().
+			CreateAlbum(gomock.Any(), testAlbum).
+			Return("7", nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		notificationUC.EXPECT().
+			NotifySubscribers(gomock.Any(), testAlbum.ArtistId, models.NotificationRelease, models.ReleasePayload{
+				Type:     admin.EntityAlbum,
+				Id:       "7",
+				Name:     testAlbum.Name,
+				ArtistId: testAlbum.ArtistId,
+			}).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+			NotificationUC:  notificationUC,
+		}
+
+		res, err := useCase.CreateAlbum(context.Background(), testAdmin, testAlbum)
+		assert.NoError(t, err)
+		assert.Equal(t, "7", res.Id)
+	})
+
+	t.Run("CreateAlbum-NotifyError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+		notificationUC := notification.NewMockUseCase(ctrl)
+
+		albumRep.EXPECT().
+			CreateAlbum(gomock.Any(), testAlbum).
+			Return("7", nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		notificationUC.EXPECT().
+			NotifySubscribers(gomock.Any(), testAlbum.ArtistId, models.NotificationRelease, gomock.Any()).
+			Return(errors.New("test error"))
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+			NotificationUC:  notificationUC,
+			Log:             logger.NewLogger(os.Stdout),
+		}
+
+		//the album is created, so a failed announcement doesn't fail the request
+		res, err := useCase.CreateAlbum(context.Background(), testAdmin, testAlbum)
+		assert.NoError(t, err)
+		assert.Equal(t, "7", res.Id)
+	})
+}
+
+func TestUpdateAlbum(t *testing.T) {
+	t.Run("UpdateAlbum-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		albumRep.EXPECT().
+			UpdateAlbum(gomock.Any(), testAlbum).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+		}
+
+		assert.NoError(t, useCase.UpdateAlbum(context.Background(), testAdmin, testAlbum))
+	})
+
+	t.Run("UpdateAlbum-WrongRelease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			AlbumRepository: album.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		input := testAlbum
+		input.Release = "1999-01-12"
+
+		assert.Error(t, useCase.UpdateAlbum(context.Background(), testAdmin, input))
+	})
+
+	t.Run("UpdateAlbum-AuditError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		albumRep.EXPECT().
+			UpdateAlbum(gomock.Any(), testAlbum).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(errors.New("test error"))
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+			Log:             logger.NewLogger(os.Stdout),
+		}
+
+		//the album is updated, so a failed audit record doesn't fail the request
+		assert.NoError(t, useCase.UpdateAlbum(context.Background(), testAdmin, testAlbum))
+	})
+}
+
+func TestSetAlbumTracks(t *testing.T) {
+	t.Run("SetAlbumTracks-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tracks := models.AlbumTracks{Tracks: []string{"3", "1", "2"}}
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		albumRep.EXPECT().
+			SetAlbumTracks(gomock.Any(), testAlbum.Id, tracks).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), models.AuditEntry{
+				UserId:   testAdmin.Id,
+				Entity:   admin.EntityAlbum,
+				EntityId: testAlbum.Id,
+				Action:   admin.ActionReorder,
+				Changes:  []byte(`{"tracks":["3","1","2"]}`),
+			}).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+		}
+
+		assert.NoError(t, useCase.SetAlbumTracks(context.Background(), testAdmin, testAlbum.Id, tracks))
+	})
+
+	t.Run("SetAlbumTracks-Duplicate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			AlbumRepository: album.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		assert.Error(t, useCase.SetAlbumTracks(context.Background(), testAdmin, testAlbum.Id, models.AlbumTracks{Tracks: []string{"1", "2", "1"}}))
+	})
+
+	t.Run("SetAlbumTracks-WrongDiscs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			AlbumRepository: album.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		for _, input := range []models.AlbumTracks{
+			{Tracks: []string{"1", "2"}, Discs: []uint{1}},
+			{Tracks: []string{"1", "2"}, Discs: []uint{1, 0}},
+		} {
+			assert.Error(t, useCase.SetAlbumTracks(context.Background(), testAdmin, testAlbum.Id, input))
+		}
+	})
+}
+
+func TestSetAlbumArtists(t *testing.T) {
+	t.Run("SetAlbumArtists-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		credits := models.ArtistCredits{Artists: []models.ArtistCredit{
+			{Id: "4", Role: models.CreditFeatured},
+			{Id: "4", Role: models.CreditProducer},
+		}}
+
+		albumRep := album.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		albumRep.EXPECT().
+			SetAlbumArtists(gomock.Any(), testAlbum.Id, credits.Artists).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), models.AuditEntry{
+				UserId:   testAdmin.Id,
+				Entity:   admin.EntityAlbum,
+				EntityId: testAlbum.Id,
+				Action:   admin.ActionCredit,
+				Changes:  []byte(`{"artists":[{"id":"4","role":"featured"},{"id":"4","role":"producer"}]}`),
+			}).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			AlbumRepository: albumRep,
+			AuditRepository: auditRep,
+		}
+
+		assert.NoError(t, useCase.SetAlbumArtists(context.Background(), testAdmin, testAlbum.Id, credits))
+	})
+
+	t.Run("SetAlbumArtists-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			AlbumRepository: album.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		for _, input := range [][]models.ArtistCredit{
+			{{Id: "4", Role: models.CreditPrimary}},
+			{{Id: "4", Role: "singer"}},
+			{{Id: "abc", Role: models.CreditFeatured}},
+			{{Id: "4", Role: models.CreditFeatured}, {Id: "4", Role: models.CreditFeatured}},
+		} {
+			assert.Error(t, useCase.SetAlbumArtists(context.Background(), testAdmin, testAlbum.Id, models.ArtistCredits{Artists: input}))
+		}
+	})
+}
+
+func TestSetTrackArtists(t *testing.T) {
+	t.Run("SetTrackArtists-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		credits := models.ArtistCredits{Artists: []models.ArtistCredit{{Id: "9", Role: models.CreditFeatured}}}
+
+		trackRep := track.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		trackRep.EXPECT().
+			SetTrackArtists(gomock.Any(), "12", credits.Artists).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			TrackRepository: trackRep,
+			AuditRepository: auditRep,
+		}
+
+		assert.NoError(t, useCase.SetTrackArtists(context.Background(), testAdmin, "12", credits))
+	})
+
+	t.Run("SetTrackArtists-RepoError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		credits := models.ArtistCredits{Artists: []models.ArtistCredit{{Id: "9", Role: models.CreditFeatured}}}
+
+		trackRep := track.NewMockRepository(ctrl)
+		trackRep.EXPECT().
+			SetTrackArtists(gomock.Any(), "12", credits.Artists).
+			Return(errors.New("fk violation"))
+
+		useCase := AdminUseCase{
+			TrackRepository: trackRep,
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		assert.Error(t, useCase.SetTrackArtists(context.Background(), testAdmin, "12", credits))
+	})
+}
+
+func TestSetAlbumGenres(t *testing.T) {
+	t.Run("SetAlbumGenres-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tags := models.GenreTags{Genres: []string{"1", "4"}}
+
+		genreRep := genre.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		genreRep.EXPECT().
+			SetAlbumGenres(gomock.Any(), testAlbum.Id, tags.Genres).
+			Return(nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), models.AuditEntry{
+				UserId:   testAdmin.Id,
+				Entity:   admin.EntityAlbum,
+				EntityId: testAlbum.Id,
+				Action:   admin.ActionTag,
+				Changes:  []byte(`{"genres":["1","4"]}`),
+			}).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			GenreRepository: genreRep,
+			AuditRepository: auditRep,
+		}
+
+		assert.NoError(t, useCase.SetAlbumGenres(context.Background(), testAdmin, testAlbum.Id, tags))
+	})
+
+	t.Run("SetAlbumGenres-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			GenreRepository: genre.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		for _, input := range [][]string{
+			{"1", "1"},
+			{"rock"},
+			{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+		} {
+			assert.Error(t, useCase.SetAlbumGenres(context.Background(), testAdmin, testAlbum.Id, models.GenreTags{Genres: input}))
+		}
+	})
+}
+
+func TestCreateGenre(t *testing.T) {
+	t.Run("CreateGenre-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		input := models.Genre{Name: "grunge", ParentId: "1"}
+
+		genreRep := genre.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		genreRep.EXPECT().
+			CreateGenre(gomock.Any(), input).
+			Return("4", nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			GenreRepository: genreRep,
+			AuditRepository: auditRep,
+		}
+
+		result, err := useCase.CreateGenre(context.Background(), testAdmin, input)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Genre{Id: "4", Name: "grunge", ParentId: "1"}, result)
+	})
+
+	t.Run("CreateGenre-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			GenreRepository: genre.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		for _, input := range []models.Genre{
+			{Name: ""},
+			{Name: "this genre name is definitely too long"},
+			{Name: "grunge", ParentId: "rock"},
+		} {
+			_, err := useCase.CreateGenre(context.Background(), testAdmin, input)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestCreateTrack(t *testing.T) {
+	t.Run("CreateTrack-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		trackRep := track.NewMockRepository(ctrl)
+		auditRep := admin.NewMockRepository(ctrl)
+
+		trackRep.EXPECT().
+			CreateTrack(gomock.Any(), testTrack).
+			Return("12", nil)
+
+		auditRep.EXPECT().
+			AddAuditEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		notificationUC := notification.NewMockUseCase(ctrl)
+		notificationUC.EXPECT().
+			NotifySubscribers(gomock.Any(), testTrack.ArtistID, models.NotificationRelease, models.ReleasePayload{
+				Type:     admin.EntityTrack,
+				Id:       "12",
+				Name:     testTrack.Name,
+				ArtistId: testTrack.ArtistID,
+			}).
+			Return(nil)
+
+		useCase := AdminUseCase{
+			TrackRepository: trackRep,
+			AuditRepository: auditRep,
+			NotificationUC:  notificationUC,
+		}
+
+		res, err := useCase.CreateTrack(context.Background(), testAdmin, testTrack)
+		assert.NoError(t, err)
+		assert.Equal(t, "12", res.Id)
+	})
+
+	t.Run("CreateTrack-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := AdminUseCase{
+			TrackRepository: track.NewMockRepository(ctrl),
+			AuditRepository: admin.NewMockRepository(ctrl),
+		}
+
+		for _, input := range []models.Track{
+			{Name: "", Duration: 1, Link: "l", ArtistID: "1"},
+			{Name: "n", Duration: 0, Link: "l", ArtistID: "1"},
+			{Name: "n", Duration: 1, Link: "", ArtistID: "1"},
+			{Name: "n", Duration: 1, Link: "l", ArtistID: "artist"},
+		} {
+			_, err := useCase.CreateTrack(context.Background(), testAdmin, input)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestGetAuditLog(t *testing.T) {
+	t.Run("GetAuditLog-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		auditRep := admin.NewMockRepository(ctrl)
+		entries := []models.AuditEntry{{Id: "1", Entity: admin.EntityArtist}}
+
+		auditRep.EXPECT().
+			GetAuditLog(gomock.Any(), uint64(10), uint64(30)).
+			Return(entries, nil)
+
+		useCase := AdminUseCase{AuditRepository: auditRep}
+
+		res, err := useCase.GetAuditLog(context.Background(), 10, 30)
+		assert.NoError(t, err)
+		assert.Equal(t, entries, res)
+	})
+
+	t.Run("GetAuditLog-Clamped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		auditRep := admin.NewMockRepository(ctrl)
+
+		auditRep.EXPECT().
+			GetAuditLog(gomock.Any(), uint64(10), uint64(10+MaxAuditCount)).
+			Return(nil, nil)
+
+		useCase := AdminUseCase{AuditRepository: auditRep}
+
+		_, err := useCase.GetAuditLog(context.Background(), 10, 1<<32)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// limits follow the column sizes in the migrations
+const (
+	artistNameLen  = 50
+	artistGenreLen = 30
+	albumNameLen   = 100
+	trackNameLen   = 100
+	imageLen       = 100
+	albumGenreLen  = 30
+	labelLen       = 50
+	maxLabels      = 10
+	maxAlbumTracks = 32767
+	maxDisc        = 32767
+	maxCredits     = 50
+	genreNameLen   = 30
+	maxGenreTags   = 10
+)
+
+func checkLen(field string, value string, max int) error {
+	length := utf8.RuneCountInString(value)
+	if length == 0 {
+		return apperrors.Errorf(apperrors.Validation, "%s is empty", field)
+	}
+	if length > max {
+		return apperrors.Errorf(apperrors.Validation, "%s is longer than %d characters", field, max)
+	}
+	return nil
+}
+
+func checkMaxLen(field string, value string, max int) error {
+	if utf8.RuneCountInString(value) > max {
+		return apperrors.Errorf(apperrors.Validation, "%s is longer than %d characters", field, max)
+	}
+	return nil
+}
+
+func checkID(field string, value string) error {
+	if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+		return apperrors.Errorf(apperrors.Validation, "%s is not a valid id", field)
+	}
+	return nil
+}
+
+func validateArtist(artist models.Artist) error {
+	if err := checkLen("name", artist.Name, artistNameLen); err != nil {
+		return err
+	}
+	if err := checkMaxLen("image", artist.Image, imageLen); err != nil {
+		return err
+	}
+	return checkMaxLen("genre", artist.Genre, artistGenreLen)
+}
+
+func validateAlbum(album models.Album) error {
+	if err := checkLen("name", album.Name, albumNameLen); err != nil {
+		return err
+	}
+	if err := checkMaxLen("image", album.Image, imageLen); err != nil {
+		return err
+	}
+	if _, err := time.Parse("02-01-2006", album.Release); err != nil {
+		return apperrors.New(apperrors.Validation, "release must be in dd-mm-yyyy format")
+	}
+	switch album.ReleaseType {
+	case "", models.ReleaseAlbum, models.ReleaseSingle, models.ReleaseEP, models.ReleaseCompilation:
+	default:
+		return apperrors.Errorf(apperrors.Validation, "unknown release type %q", album.ReleaseType)
+	}
+	if err := checkMaxLen("genre", album.Genre, albumGenreLen); err != nil {
+		return err
+	}
+	if len(album.Labels) > maxLabels {
+		return apperrors.Errorf(apperrors.Validation, "album can't have more than %d labels", maxLabels)
+	}
+	for _, label := range album.Labels {
+		if err := checkLen("label", label, labelLen); err != nil {
+			return err
+		}
+	}
+	return checkID("artist_id", album.ArtistId)
+}
+
+func validateTrack(track models.Track) error {
+	if err := checkLen("name", track.Name, trackNameLen); err != nil {
+		return err
+	}
+	if track.Duration == 0 {
+		return apperrors.New(apperrors.Validation, "duration is empty")
+	}
+	if track.Link == "" {
+		return apperrors.New(apperrors.Validation, "link is empty")
+	}
+	return checkID("artist_id", track.ArtistID)
+}
+
+func validateAlbumTracks(tracks models.AlbumTracks) error {
+	if len(tracks.Tracks) > maxAlbumTracks {
+		return apperrors.Errorf(apperrors.Validation, "album can't have more than %d tracks", maxAlbumTracks)
+	}
+	if len(tracks.Discs) != 0 && len(tracks.Discs) != len(tracks.Tracks) {
+		return apperrors.New(apperrors.Validation, "discs must be set for every track")
+	}
+	for _, disc := range tracks.Discs {
+		if disc == 0 || disc > maxDisc {
+			return apperrors.Errorf(apperrors.Validation, "disc must be from 1 to %d", maxDisc)
+		}
+	}
+	seen := make(map[string]bool, len(tracks.Tracks))
+	for _, tID := range tracks.Tracks {
+		if err := checkID("track id", tID); err != nil {
+			return err
+		}
+		if seen[tID] {
+			return apperrors.Errorf(apperrors.Validation, "track %s is listed twice", tID)
+		}
+		seen[tID] = true
+	}
+	return nil
+}
+
+// validateCredits checks additional credits, the primary artist is set with artist_id
+func validateCredits(credits models.ArtistCredits) error {
+	if len(credits.Artists) > maxCredits {
+		return apperrors.Errorf(apperrors.Validation, "can't have more than %d credits", maxCredits)
+	}
+	seen := make(map[models.ArtistCredit]bool, len(credits.Artists))
+	for _, elem := range credits.Artists {
+		if err := checkID("artist id", elem.Id); err != nil {
+			return err
+		}
+		switch elem.Role {
+		case models.CreditFeatured, models.CreditProducer:
+		default:
+			return apperrors.Errorf(apperrors.Validation, "unknown credit role %q", elem.Role)
+		}
+		key := models.ArtistCredit{Id: elem.Id, Role: elem.Role}
+		if seen[key] {
+			return apperrors.Errorf(apperrors.Validation, "artist %s is credited as %s twice", elem.Id, elem.Role)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func validateGenre(genre models.Genre) error {
+	if err := checkLen("name", genre.Name, genreNameLen); err != nil {
+		return err
+	}
+	if genre.ParentId == "" {
+		return nil
+	}
+	return checkID("parent_id", genre.ParentId)
+}
+
+func validateGenreTags(tags models.GenreTags) error {
+	if len(tags.Genres) > maxGenreTags {
+		return apperrors.Errorf(apperrors.Validation, "can't have more than %d genres", maxGenreTags)
+	}
+	seen := make(map[string]bool, len(tags.Genres))
+	for _, gID := range tags.Genres {
+		if err := checkID("genre id", gID); err != nil {
+			return err
+		}
+		if seen[gID] {
+			return apperrors.Errorf(apperrors.Validation, "genre %s is listed twice", gID)
+		}
+		seen[gID] = true
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package admin is a generated GoMock package.
+package admin
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCase is a mock of UseCase interface
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateArtist mocks base method
+func (m *MockUseCase) CreateArtist(ctx context.Context, user models.User, artist models.Artist) (models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArtist", ctx, user, artist)
+	ret0, _ := ret[0].(models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArtist indicates an expected call of CreateArtist
+func (mr *MockUseCaseMockRecorder) CreateArtist(ctx, user, artist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArtist", reflect.TypeOf((*MockUseCase)(nil).CreateArtist), ctx, user, artist)
+}
+
+// UpdateArtist mocks base method
+func (m *MockUseCase) UpdateArtist(ctx context.Context, user models.User, artist models.Artist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArtist", ctx, user, artist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArtist indicates an expected call of UpdateArtist
+func (mr *MockUseCaseMockRecorder) UpdateArtist(ctx, user, artist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtist", reflect.TypeOf((*MockUseCase)(nil).UpdateArtist), ctx, user, artist)
+}
+
+// DeleteArtist mocks base method
+func (m *MockUseCase) DeleteArtist(ctx context.Context, user models.User, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtist", ctx, user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArtist indicates an expected call of DeleteArtist
+func (mr *MockUseCaseMockRecorder) DeleteArtist(ctx, user, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockUseCase)(nil).DeleteArtist), ctx, user, id)
+}
+
+// CreateAlbum mocks base method
+func (m *MockUseCase) CreateAlbum(ctx context.Context, user models.User, album models.Album) (models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbum", ctx, user, album)
+	ret0, _ := ret[0].(models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum
+func (mr *MockUseCaseMockRecorder) CreateAlbum(ctx, user, album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockUseCase)(nil).CreateAlbum), ctx, user, album)
+}
+
+// UpdateAlbum mocks base method
+func (m *MockUseCase) UpdateAlbum(ctx context.Context, user models.User, album models.Album) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbum", ctx, user, album)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbum indicates an expected call of UpdateAlbum
+func (mr *MockUseCaseMockRecorder) UpdateAlbum(ctx, user, album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbum", reflect.TypeOf((*MockUseCase)(nil).UpdateAlbum), ctx, user, album)
+}
+
+// DeleteAlbum mocks base method
+func (m *MockUseCase) DeleteAlbum(ctx context.Context, user models.User, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlbum", ctx, user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum
+func (mr *MockUseCaseMockRecorder) DeleteAlbum(ctx, user, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockUseCase)(nil).DeleteAlbum), ctx, user, id)
+}
+
+// SetAlbumTracks mocks base method
+func (m *MockUseCase) SetAlbumTracks(ctx context.Context, user models.User, aID string, tracks models.AlbumTracks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumTracks", ctx, user, aID, tracks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumTracks indicates an expected call of SetAlbumTracks
+func (mr *MockUseCaseMockRecorder) SetAlbumTracks(ctx, user, aID, tracks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumTracks", reflect.TypeOf((*MockUseCase)(nil).SetAlbumTracks), ctx, user, aID, tracks)
+}
+
+// SetAlbumArtists mocks base method
+func (m *MockUseCase) SetAlbumArtists(ctx context.Context, user models.User, aID string, credits models.ArtistCredits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumArtists", ctx, user, aID, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumArtists indicates an expected call of SetAlbumArtists
+func (mr *MockUseCaseMockRecorder) SetAlbumArtists(ctx, user, aID, credits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumArtists", reflect.TypeOf((*MockUseCase)(nil).SetAlbumArtists), ctx, user, aID, credits)
+}
+
+// SetAlbumGenres mocks base method
+func (m *MockUseCase) SetAlbumGenres(ctx context.Context, user models.User, aID string, tags models.GenreTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumGenres", ctx, user, aID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumGenres indicates an expected call of SetAlbumGenres
+func (mr *MockUseCaseMockRecorder) SetAlbumGenres(ctx, user, aID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumGenres", reflect.TypeOf((*MockUseCase)(nil).SetAlbumGenres), ctx, user, aID, tags)
+}
+
+// CreateTrack mocks base method
+func (m *MockUseCase) CreateTrack(ctx context.Context, user models.User, track models.Track) (models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrack", ctx, user, track)
+	ret0, _ := ret[0].(models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrack indicates an expected call of CreateTrack
+func (mr *MockUseCaseMockRecorder) CreateTrack(ctx, user, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrack", reflect.TypeOf((*MockUseCase)(nil).CreateTrack), ctx, user, track)
+}
+
+// UpdateTrack mocks base method
+func (m *MockUseCase) UpdateTrack(ctx context.Context, user models.User, track models.Track) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrack", ctx, user, track)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTrack indicates an expected call of UpdateTrack
+func (mr *MockUseCaseMockRecorder) UpdateTrack(ctx, user, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrack", reflect.TypeOf((*MockUseCase)(nil).UpdateTrack), ctx, user, track)
+}
+
+// SetTrackArtists mocks base method
+func (m *MockUseCase) SetTrackArtists(ctx context.Context, user models.User, tID string, credits models.ArtistCredits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackArtists", ctx, user, tID, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrackArtists indicates an expected call of SetTrackArtists
+func (mr *MockUseCaseMockRecorder) SetTrackArtists(ctx, user, tID, credits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtists", reflect.TypeOf((*MockUseCase)(nil).SetTrackArtists), ctx, user, tID, credits)
+}
+
+// SetTrackGenres mocks base method
+func (m *MockUseCase) SetTrackGenres(ctx context.Context, user models.User, tID string, tags models.GenreTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackGenres", ctx, user, tID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrackGenres indicates an expected call of SetTrackGenres
+func (mr *MockUseCaseMockRecorder) SetTrackGenres(ctx, user, tID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackGenres", reflect.TypeOf((*MockUseCase)(nil).SetTrackGenres), ctx, user, tID, tags)
+}
+
+// DeleteTrack mocks base method
+func (m *MockUseCase) DeleteTrack(ctx context.Context, user models.User, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrack", ctx, user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrack indicates an expected call of DeleteTrack
+func (mr *MockUseCaseMockRecorder) DeleteTrack(ctx, user, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockUseCase)(nil).DeleteTrack), ctx, user, id)
+}
+
+// CreateGenre mocks base method
+func (m *MockUseCase) CreateGenre(ctx context.Context, user models.User, genre models.Genre) (models.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGenre", ctx, user, genre)
+	ret0, _ := ret[0].(models.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGenre indicates an expected call of CreateGenre
+func (mr *MockUseCaseMockRecorder) CreateGenre(ctx, user, genre interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockUseCase)(nil).CreateGenre), ctx, user, genre)
+}
+
+// GetAuditLog mocks base method
+func (m *MockUseCase) GetAuditLog(ctx context.Context, start, end uint64) ([]models.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, start, end)
+	ret0, _ := ret[0].([]models.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog
+func (mr *MockUseCaseMockRecorder) GetAuditLog(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockUseCase)(nil).GetAuditLog), ctx, start, end)
+}
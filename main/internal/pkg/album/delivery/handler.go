@@ -3,6 +3,7 @@ package delivery
 import (
 	"encoding/json"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
@@ -30,10 +31,9 @@ func (h *AlbumHandler) GetFullAlbum(w http.ResponseWriter, r *http.Request) {
 		user = models.User{Id: ""}
 	}
 
-	albumData, err := h.AlbumUC.GetAlbumById(varId, user.Id)
+	albumData, err := h.AlbumUC.GetAlbumById(r.Context(), varId, user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get album data"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get album data"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -49,6 +49,61 @@ func (h *AlbumHandler) GetFullAlbum(w http.ResponseWriter, r *http.Request) {
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *AlbumHandler) GetAlbumDetails(w http.ResponseWriter, r *http.Request) {
+	varId, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		user = models.User{Id: ""}
+	}
+
+	details, err := h.AlbumUC.GetAlbumDetails(r.Context(), varId, user.Id)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get album details"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(details)
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "album delivery", "GetAlbumDetails", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *AlbumHandler) GetDiscography(w http.ResponseWriter, r *http.Request) {
+	varId, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	discography, err := h.AlbumUC.GetDiscography(r.Context(), varId)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get discography"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(discography)
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "album delivery", "GetDiscography", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
 func (h *AlbumHandler) GetUserAlbums(w http.ResponseWriter, r *http.Request) {
 	user, ok := r.Context().Value(middleware.UserKey).(models.User)
 	if !ok {
@@ -57,10 +112,9 @@ func (h *AlbumHandler) GetUserAlbums(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	albums, err := h.AlbumUC.GetUserAlbums(user.Id)
+	albums, err := h.AlbumUC.GetUserAlbums(r.Context(), user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get user' albums"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get user' albums"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -90,10 +144,9 @@ func (h *AlbumHandler) GetBoundedAlbumsByArtistId(w http.ResponseWriter, r *http
 		return
 	}
 
-	albums, err := h.AlbumUC.GetBoundedAlbumsByArtistId(artistId, start, end)
+	albums, err := h.AlbumUC.GetBoundedAlbumsByArtistId(r.Context(), artistId, start, end)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get albums"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get albums"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -112,6 +165,38 @@ func (h *AlbumHandler) GetBoundedAlbumsByArtistId(w http.ResponseWriter, r *http
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *AlbumHandler) GetBoundedAlbumsByGenre(w http.ResponseWriter, r *http.Request) {
+	genreId, okId := r.Context().Value(middleware.Id).(string)
+	start, okStart := r.Context().Value(middleware.Start).(uint64)
+	end, okEnd := r.Context().Value(middleware.End).(uint64)
+
+	if !okId || !okStart || !okEnd {
+		h.Log.LogWarning(r.Context(), "album delivery", "GetBoundedAlbumsByGenre", "failed to get vars")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	albums, err := h.AlbumUC.GetBoundedAlbumsByGenre(r.Context(), genreId, start, end)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get albums"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Id     string         `json:"id"`
+		Albums []models.Album `json:"albums"`
+	}{genreId, albums})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "album delivery", "GetBoundedAlbumsByGenre", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
 func (h *AlbumHandler) RateAlbum(w http.ResponseWriter, r *http.Request) {
 	user, ok := r.Context().Value(middleware.UserKey).(models.User)
 	if !ok {
@@ -126,10 +211,9 @@ func (h *AlbumHandler) RateAlbum(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.AlbumUC.RateAlbum(varId, user.Id)
+	err := h.AlbumUC.RateAlbum(r.Context(), varId, user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to rate albums"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to rate albums"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 
@@ -62,7 +62,7 @@ func TestGetUserAlbums(t *testing.T) {
 		m := album.NewMockUseCase(ctrl)
 
 		m.EXPECT().
-			GetUserAlbums(testUser.Id).
+			GetUserAlbums(gomock.Any(), testUser.Id).
 			Return(albums, nil)
 
 		albumHandlers.AlbumUC = m
@@ -108,7 +108,7 @@ func TestGetUserAlbums(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			GetUserAlbums(testUser.Id).
+			GetUserAlbums(gomock.Any(), testUser.Id).
 			Return([]models.Album{}, testError)
 
 		albumHandlers.AlbumUC = m
@@ -119,7 +119,7 @@ func TestGetUserAlbums(t *testing.T) {
 			Cookie("session_id", "randomSessionIdValueForTesting").
 			URL("/users/albums").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -151,7 +151,7 @@ func TestGetFullAlbum(t *testing.T) {
 		m := album.NewMockUseCase(ctrl)
 
 		m.EXPECT().
-			GetAlbumById(idVal, "").
+			GetAlbumById(gomock.Any(), idVal, "").
 			Return(albumModel, nil)
 
 		albumHandlers.AlbumUC = m
@@ -193,7 +193,7 @@ func TestGetFullAlbum(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			GetAlbumById(idVal, "").
+			GetAlbumById(gomock.Any(), idVal, "").
 			Return(models.Album{}, testError)
 
 		albumHandlers.AlbumUC = m
@@ -204,9 +204,153 @@ func TestGetFullAlbum(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/albums/12").
 			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestGetAlbumDetails(t *testing.T) {
+	t.Run("GetAlbumDetails-OK", func(t *testing.T) {
+		idVal := "12"
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		details := models.AlbumDetails{
+			Album: models.Album{
+				Id:          idVal,
+				Name:        "KekLol",
+				Release:     "23-01-1999",
+				ReleaseType: models.ReleaseEP,
+				ArtistId:    "42",
+			},
+			TrackCount: 1,
+			Duration:   200,
+			DiscCount:  1,
+			Tracks: []models.AlbumTrack{{
+				Track:  models.Track{Id: "5", Name: "track", Duration: 200},
+				Disc:   1,
+				Number: 1,
+			}},
+		}
+		detailsMarshal, err := json.Marshal(details)
+		assert.NoError(t, err)
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetAlbumDetails(gomock.Any(), idVal, "").
+			Return(details, nil)
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetAlbumDetails-OK").
+			Handler(middleware.SetMuxVars(albumHandlers.GetAlbumDetails, "id", idVal)).
+			Method("Get").
+			URL("/api/v1/albums/12/details").
+			Expect(t).
+			Body(string(detailsMarshal)).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetAlbumDetails-NoVars", func(t *testing.T) {
+		apitest.New("GetAlbumDetails-NoVars").
+			Handler(http.HandlerFunc(albumHandlers.GetAlbumDetails)).
+			Method("Get").
+			URL("/api/v1/albums/12/details").
+			Expect(t).
 			Status(http.StatusBadRequest).
 			End()
 	})
+
+	t.Run("GetAlbumDetails-Error", func(t *testing.T) {
+		idVal := "12"
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetAlbumDetails(gomock.Any(), idVal, "").
+			Return(models.AlbumDetails{}, errors.New("test error"))
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetAlbumDetails-Error").
+			Handler(middleware.SetMuxVars(albumHandlers.GetAlbumDetails, "id", idVal)).
+			Method("Get").
+			URL("/api/v1/albums/12/details").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestGetDiscography(t *testing.T) {
+	t.Run("GetDiscography-OK", func(t *testing.T) {
+		idVal := "42"
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		discography := models.Discography{
+			ArtistId: idVal,
+			Albums:   []models.AlbumDetails{},
+			Singles: []models.AlbumDetails{{
+				Album:      models.Album{Id: "3", Name: "single", ReleaseType: models.ReleaseSingle, ArtistId: idVal},
+				TrackCount: 1,
+				DiscCount:  1,
+			}},
+			EPs:          []models.AlbumDetails{},
+			Compilations: []models.AlbumDetails{},
+		}
+		discographyMarshal, err := json.Marshal(discography)
+		assert.NoError(t, err)
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetDiscography(gomock.Any(), idVal).
+			Return(discography, nil)
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetDiscography-OK").
+			Handler(middleware.SetMuxVars(albumHandlers.GetDiscography, "id", idVal)).
+			Method("Get").
+			URL("/api/v1/artists/42/discography").
+			Expect(t).
+			Body(string(discographyMarshal)).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetDiscography-NoVars", func(t *testing.T) {
+		apitest.New("GetDiscography-NoVars").
+			Handler(http.HandlerFunc(albumHandlers.GetDiscography)).
+			Method("Get").
+			URL("/api/v1/artists/42/discography").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("GetDiscography-Error", func(t *testing.T) {
+		idVal := "42"
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetDiscography(gomock.Any(), idVal).
+			Return(models.Discography{}, errors.New("test error"))
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetDiscography-Error").
+			Handler(middleware.SetMuxVars(albumHandlers.GetDiscography, "id", idVal)).
+			Method("Get").
+			URL("/api/v1/artists/42/discography").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
 }
 
 func TestGetBoundedAlbumsByArtistId(t *testing.T) {
@@ -246,7 +390,7 @@ func TestGetBoundedAlbumsByArtistId(t *testing.T) {
 		var endUint uint64 = 2
 
 		m.EXPECT().
-			GetBoundedAlbumsByArtistId(artistId, startUint, endUint).
+			GetBoundedAlbumsByArtistId(gomock.Any(), artistId, startUint, endUint).
 			Return(albums, nil)
 
 		albumHandlers.AlbumUC = m
@@ -294,7 +438,7 @@ func TestGetBoundedAlbumsByArtistId(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			GetBoundedAlbumsByArtistId(artistId, startUint, endUint).
+			GetBoundedAlbumsByArtistId(gomock.Any(), artistId, startUint, endUint).
 			Return([]models.Album{}, testError)
 
 		albumHandlers.AlbumUC = m
@@ -303,7 +447,7 @@ func TestGetBoundedAlbumsByArtistId(t *testing.T) {
 			Handler(vars).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -325,7 +469,7 @@ func TestRateAlbum(t *testing.T) {
 		m := album.NewMockUseCase(ctrl)
 
 		m.EXPECT().
-			RateAlbum(artistId, testUser.Id).
+			RateAlbum(gomock.Any(), artistId, testUser.Id).
 			Return(nil)
 
 		albumHandlers.AlbumUC = m
@@ -381,7 +525,7 @@ func TestRateAlbum(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			RateAlbum(artistId, testUser.Id).
+			RateAlbum(gomock.Any(), artistId, testUser.Id).
 			Return(testError)
 
 		albumHandlers.AlbumUC = m
@@ -390,7 +534,60 @@ func TestRateAlbum(t *testing.T) {
 			Handler(handler).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestGetBoundedAlbumsByGenre(t *testing.T) {
+	t.Run("GetBoundedAlbumsByGenre-OK", func(t *testing.T) {
+		genreId := "3"
+
+		boundedVars := middleware.BoundedVars(albumHandlers.GetBoundedAlbumsByGenre, albumHandlers.Log)
+		vars := middleware.SetTripleVars(boundedVars, genreId, "0", "10")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		albums := []models.Album{{Id: "1", Name: "album", Release: "12-01-1999", ArtistId: "4", ArtistName: "artist"}}
+		albumsMarshal, err := json.Marshal(albums)
+		assert.NoError(t, err)
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetBoundedAlbumsByGenre(gomock.Any(), genreId, uint64(0), uint64(10)).
+			Return(albums, nil)
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetBoundedAlbumsByGenre-OK").
+			Handler(vars).
+			Method("Get").
+			Expect(t).
+			Body(`{"id":"3","albums":` + string(albumsMarshal) + `}`).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetBoundedAlbumsByGenre-UseCaseError", func(t *testing.T) {
+		boundedVars := middleware.BoundedVars(albumHandlers.GetBoundedAlbumsByGenre, albumHandlers.Log)
+		vars := middleware.SetTripleVars(boundedVars, "3", "0", "10")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockUseCase(ctrl)
+		m.EXPECT().
+			GetBoundedAlbumsByGenre(gomock.Any(), "3", uint64(0), uint64(10)).
+			Return(nil, errors.New("db error"))
+
+		albumHandlers.AlbumUC = m
+
+		apitest.New("GetBoundedAlbumsByGenre-UseCaseError").
+			Handler(vars).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -1,12 +1,24 @@
 package album
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type Repository interface {
-	GetUserAlbums(uId string) ([]models.Album, error)
-	GetAlbumById(aId string) (models.Album, error)
-	GetBoundedAlbumsByArtistId(id string, start, end uint64) ([]models.Album, error)
-	Search(text string, count uint) ([]models.AlbumSearch, error)
-	RateAlbum(aID, uID string) error
-	CheckLike(aID, uID string) bool
+	GetUserAlbums(ctx context.Context, uId string) ([]models.Album, error)
+	GetAlbumById(ctx context.Context, aId string) (models.Album, error)
+	GetAlbumDetails(ctx context.Context, aID string) (models.AlbumDetails, error)
+	GetAlbumTracks(ctx context.Context, aID string) ([]models.AlbumTrack, error)
+	GetDiscography(ctx context.Context, artistID string) ([]models.AlbumDetails, error)
+	GetBoundedAlbumsByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Album, error)
+	GetBoundedAlbumsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Album, error)
+	Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error)
+	RateAlbum(ctx context.Context, aID, uID string) error
+	CheckLike(ctx context.Context, aID, uID string) bool
+	CreateAlbum(ctx context.Context, album models.Album) (string, error)
+	UpdateAlbum(ctx context.Context, album models.Album) error
+	DeleteAlbum(ctx context.Context, id string) error
+	SetAlbumTracks(ctx context.Context, aID string, tracks models.AlbumTracks) error
+	SetAlbumArtists(ctx context.Context, aID string, credits []models.ArtistCredit) error
 }
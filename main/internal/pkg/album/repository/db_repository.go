@@ -1,20 +1,88 @@
 package repository
 
 import (
+	"context"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"strconv"
 	"time"
 )
 
+// Albums is a row of the album_info view, AlbumRecord is a row of the albums table.
+// album_info leaves out deleted albums and albums of deleted artists
 type Albums struct {
-	Id         uint64    `gorm:"column:id"`
-	Name       string    `gorm:"column:name"`
-	Image      string    `gorm:"column:image"`
-	Release    time.Time `gorm:"column:release"`
-	ArtistName string    `gorm:"column:artist_name"`
-	ArtistId   uint64    `gorm:"column:artist_id"`
+	Id          uint64         `gorm:"column:id"`
+	Name        string         `gorm:"column:name"`
+	Image       string         `gorm:"column:image"`
+	Release     time.Time      `gorm:"column:release"`
+	ArtistName  string         `gorm:"column:artist_name"`
+	ArtistId    uint64         `gorm:"column:artist_id"`
+	ReleaseType string         `gorm:"column:release_type"`
+	Genre       *string        `gorm:"column:genre"`
+	Labels      pq.StringArray `gorm:"column:labels"`
+	CreditIds   pq.Int64Array  `gorm:"column:credit_ids"`
+	CreditNames pq.StringArray `gorm:"column:credit_names"`
+	CreditRoles pq.StringArray `gorm:"column:credit_roles"`
+	DeletedAt   *time.Time     `gorm:"column:deleted_at"`
+}
+
+func (Albums) TableName() string {
+	return "album_info"
+}
+
+type AlbumRecord struct {
+	Id          uint64         `gorm:"column:id"`
+	Name        string         `gorm:"column:name"`
+	Image       string         `gorm:"column:image"`
+	Release     time.Time      `gorm:"column:release"`
+	ArtistId    uint64         `gorm:"column:artist_id"`
+	ReleaseType string         `gorm:"column:release_type"`
+	Genre       *string        `gorm:"column:genre"`
+	Labels      pq.StringArray `gorm:"column:labels"`
+	DeletedAt   *time.Time     `gorm:"column:deleted_at"`
+}
+
+func (AlbumRecord) TableName() string {
+	return "albums"
+}
+
+// AlbumDetails is a row of the album_details view
+type AlbumDetails struct {
+	Id          uint64         `gorm:"column:album_id"`
+	Name        string         `gorm:"column:album_name"`
+	Image       string         `gorm:"column:album_image"`
+	Release     time.Time      `gorm:"column:release"`
+	ReleaseType string         `gorm:"column:release_type"`
+	Genre       *string        `gorm:"column:genre"`
+	Labels      pq.StringArray `gorm:"column:labels"`
+	ArtistId    uint64         `gorm:"column:artist_id"`
+	ArtistName  string         `gorm:"column:artist_name"`
+	CreditIds   pq.Int64Array  `gorm:"column:credit_ids"`
+	CreditNames pq.StringArray `gorm:"column:credit_names"`
+	CreditRoles pq.StringArray `gorm:"column:credit_roles"`
+	TrackCount  uint           `gorm:"column:track_count"`
+	Duration    uint           `gorm:"column:duration"`
+	DiscCount   uint           `gorm:"column:disc_count"`
+}
+
+// AlbumTrack is a row of the tracks_in_album view
+type AlbumTrack struct {
+	Id          uint64         `gorm:"column:track_id"`
+	Name        string         `gorm:"column:track_name"`
+	ArtistName  string         `gorm:"column:artist_name"`
+	ArtistId    uint64         `gorm:"column:artist_id"`
+	Duration    uint           `gorm:"column:duration"`
+	Link        string         `gorm:"column:link"`
+	Image       string         `gorm:"column:track_image"`
+	CreditIds   pq.Int64Array  `gorm:"column:credit_ids"`
+	CreditNames pq.StringArray `gorm:"column:credit_names"`
+	CreditRoles pq.StringArray `gorm:"column:credit_roles"`
+	Disc        uint           `gorm:"column:disc"`
+	Number      uint           `gorm:"column:number"`
 }
 
 type LikedAlbums struct {
@@ -44,21 +112,90 @@ func toSearchModel(albums Albums) models.AlbumSearch {
 
 func toModel(album Albums) models.Album {
 	return models.Album{
-		Id:         strconv.FormatUint(album.Id, 10),
-		Name:       album.Name,
-		Image:      album.Image,
-		Release:    album.Release.Format("02-01-2006"),
-		ArtistName: album.ArtistName,
-		ArtistId:   strconv.FormatUint(album.ArtistId, 10),
+		Id:          strconv.FormatUint(album.Id, 10),
+		Name:        album.Name,
+		Image:       album.Image,
+		Release:     album.Release.Format("02-01-2006"),
+		ArtistName:  album.ArtistName,
+		ArtistId:    strconv.FormatUint(album.ArtistId, 10),
+		ReleaseType: album.ReleaseType,
+		Genre:       fromNullable(album.Genre),
+		Labels:      album.Labels,
+		Artists:     models.NewArtistCredits(album.CreditIds, album.CreditNames, album.CreditRoles),
+	}
+}
+
+func toDetailsModel(album AlbumDetails) models.AlbumDetails {
+	return models.AlbumDetails{
+		Album: models.Album{
+			Id:          strconv.FormatUint(album.Id, 10),
+			Name:        album.Name,
+			Image:       album.Image,
+			Release:     album.Release.Format("02-01-2006"),
+			ArtistName:  album.ArtistName,
+			ArtistId:    strconv.FormatUint(album.ArtistId, 10),
+			ReleaseType: album.ReleaseType,
+			Genre:       fromNullable(album.Genre),
+			Labels:      album.Labels,
+			Artists:     models.NewArtistCredits(album.CreditIds, album.CreditNames, album.CreditRoles),
+		},
+		TrackCount: album.TrackCount,
+		Duration:   album.Duration,
+		DiscCount:  album.DiscCount,
 	}
 }
 
-func (ar *DbAlbumRepository) GetUserAlbums(id string) ([]models.Album, error) {
+func toTrackModel(track AlbumTrack) models.AlbumTrack {
+	return models.AlbumTrack{
+		Track: models.Track{
+			Id:       strconv.FormatUint(track.Id, 10),
+			Name:     track.Name,
+			Artist:   track.ArtistName,
+			ArtistID: strconv.FormatUint(track.ArtistId, 10),
+			Duration: track.Duration,
+			Image:    track.Image,
+			Link:     track.Link,
+			Artists:  models.NewArtistCredits(track.CreditIds, track.CreditNames, track.CreditRoles),
+		},
+		Disc:   track.Disc,
+		Number: track.Number,
+	}
+}
+
+func fromNullable(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func toNullable(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func releaseType(value string) string {
+	if value == "" {
+		return models.ReleaseAlbum
+	}
+	return value
+}
+
+func labels(value []string) pq.StringArray {
+	if value == nil {
+		return pq.StringArray{}
+	}
+	return value
+}
+
+func (ar *DbAlbumRepository) GetUserAlbums(ctx context.Context, id string) ([]models.Album, error) {
 	var dbAlbum []Albums
 
 	sqlQuery := "SELECT album_id as id, album_name as name, album_image as image, artist_name, artist_id FROM user_albums WHERE user_id = ?"
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Raw(sqlQuery, id).
 		Scan(&dbAlbum)
 
@@ -75,10 +212,10 @@ func (ar *DbAlbumRepository) GetUserAlbums(id string) ([]models.Album, error) {
 	return albums, nil
 }
 
-func (ar *DbAlbumRepository) GetAlbumById(id string) (models.Album, error) {
+func (ar *DbAlbumRepository) GetAlbumById(ctx context.Context, id string) (models.Album, error) {
 	var dbAlbum Albums
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Where("id = ?", id).
 		Find(&dbAlbum)
 
@@ -89,11 +226,11 @@ func (ar *DbAlbumRepository) GetAlbumById(id string) (models.Album, error) {
 	return toModel(dbAlbum), nil
 }
 
-func (ar *DbAlbumRepository) GetBoundedAlbumsByArtistId(id string, start, end uint64) ([]models.Album, error) {
+func (ar *DbAlbumRepository) GetBoundedAlbumsByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Album, error) {
 	var dbAlbum []Albums
 	limit := end - start
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Where("artist_id = ?", id).
 		Order("release").
 		Limit(limit).
@@ -112,17 +249,94 @@ func (ar *DbAlbumRepository) GetBoundedAlbumsByArtistId(id string, start, end ui
 	return albumsArray, nil
 }
 
-func (ar *DbAlbumRepository) Search(text string, count uint) ([]models.AlbumSearch, error) {
+// GetBoundedAlbumsByGenre includes albums of all subgenres, newest first
+func (ar *DbAlbumRepository) GetBoundedAlbumsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Album, error) {
+	var dbAlbum []Albums
+	limit := end - start
+
+	db := database.WithContext(ctx, ar.db).
+		Where("id IN (SELECT album_id FROM genre_albums WHERE genre_id = ?)", gID).
+		Order("release desc, id desc").
+		Limit(limit).
+		Offset(start).
+		Find(&dbAlbum)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get genre albums: %w", err)
+	}
+
+	albumsArray := make([]models.Album, len(dbAlbum))
+	for i, elem := range dbAlbum {
+		albumsArray[i] = toModel(elem)
+	}
+	return albumsArray, nil
+}
+
+func (ar *DbAlbumRepository) GetAlbumDetails(ctx context.Context, aID string) (models.AlbumDetails, error) {
+	var album AlbumDetails
+
+	db := database.WithContext(ctx, ar.db).
+		Table("album_details").
+		Where("album_id = ?", aID).
+		Find(&album)
+
+	if err := db.Error; err != nil {
+		return models.AlbumDetails{}, fmt.Errorf("failed to get album details: %w", err)
+	}
+	return toDetailsModel(album), nil
+}
+
+func (ar *DbAlbumRepository) GetAlbumTracks(ctx context.Context, aID string) ([]models.AlbumTrack, error) {
+	var tracks []AlbumTrack
+
+	db := database.WithContext(ctx, ar.db).
+		Table("tracks_in_album").
+		Where("album_id = ?", aID).
+		Order("disc, index").
+		Find(&tracks)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get album tracks: %w", err)
+	}
+
+	result := make([]models.AlbumTrack, len(tracks))
+	for i, elem := range tracks {
+		result[i] = toTrackModel(elem)
+	}
+	return result, nil
+}
+
+func (ar *DbAlbumRepository) GetDiscography(ctx context.Context, artistID string) ([]models.AlbumDetails, error) {
+	var albums []AlbumDetails
+
+	db := database.WithContext(ctx, ar.db).
+		Table("album_details").
+		Where("artist_id = ?", artistID).
+		Order("release desc, album_id desc").
+		Find(&albums)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get discography: %w", err)
+	}
+
+	result := make([]models.AlbumDetails, len(albums))
+	for i, elem := range albums {
+		result[i] = toDetailsModel(elem)
+	}
+	return result, nil
+}
+
+func (ar *DbAlbumRepository) Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error) {
 	var albums []Albums
 
-	db := ar.db.
-		Table("albums").
+	db := database.WithContext(ctx, ar.db).
+		Table("album_info").
 		Where("name ILIKE ?", "%"+text+"%").
 		Limit(count).
 		Find(&albums)
 
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to search albums: %v", err)
+		return nil, fmt.Errorf("failed to search albums: %w", err)
 	}
 
 	albumSearch := make([]models.AlbumSearch, len(albums))
@@ -132,18 +346,18 @@ func (ar *DbAlbumRepository) Search(text string, count uint) ([]models.AlbumSear
 	return albumSearch, nil
 }
 
-func (ar *DbAlbumRepository) RateAlbum(aID, uID string) error {
+func (ar *DbAlbumRepository) RateAlbum(ctx context.Context, aID, uID string) error {
 	liked := LikedAlbums{}
 
-	db := ar.db.Raw("select * from liked_albums where user_id = ? and album_id = ?", uID, aID).Scan(&liked)
+	db := database.WithContext(ctx, ar.db).Raw("select * from liked_albums where user_id = ? and album_id = ?", uID, aID).Scan(&liked)
 	switch db.Error {
 	case gorm.ErrRecordNotFound:
-		db := ar.db.Exec("insert into liked_albums(user_id, album_id) values (?, ?)", uID, aID)
+		db := database.WithContext(ctx, ar.db).Exec("insert into liked_albums(user_id, album_id) values (?, ?)", uID, aID)
 		if err := db.Error; err != nil {
 			return err
 		}
 	case nil:
-		db := ar.db.Exec("delete from liked_albums where user_id = ? and album_id = ?", uID, aID)
+		db := database.WithContext(ctx, ar.db).Exec("delete from liked_albums where user_id = ? and album_id = ?", uID, aID)
 		if err := db.Error; err != nil {
 			return err
 		}
@@ -153,10 +367,10 @@ func (ar *DbAlbumRepository) RateAlbum(aID, uID string) error {
 	return nil
 }
 
-func (ar *DbAlbumRepository) CheckLike(aID, uID string) bool {
+func (ar *DbAlbumRepository) CheckLike(ctx context.Context, aID, uID string) bool {
 	var liked LikedAlbums
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Table("liked_albums").
 		Where("album_id = ? and user_id = ?", aID, uID).
 		Find(&liked)
@@ -167,3 +381,152 @@ func (ar *DbAlbumRepository) CheckLike(aID, uID string) bool {
 
 	return true
 }
+
+// checkArtist makes sure albums are not attached to deleted artists,
+// missing ones are rejected by the foreign key anyway
+func (ar *DbAlbumRepository) checkArtist(ctx context.Context, artistID string) error {
+	var artist struct {
+		Id uint64 `gorm:"column:id"`
+	}
+
+	db := database.WithContext(ctx, ar.db).
+		Table("artists").
+		Select("id").
+		Where("id = ? and deleted_at is null", artistID).
+		Find(&artist)
+
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to get artist: %w", err)
+	}
+	return nil
+}
+
+func (ar *DbAlbumRepository) CreateAlbum(ctx context.Context, album models.Album) (string, error) {
+	release, err := time.Parse("02-01-2006", album.Release)
+	if err != nil {
+		return "", apperrors.Errorf(apperrors.Validation, "failed to parse release date: %w", err)
+	}
+	artistID, err := strconv.ParseUint(album.ArtistId, 10, 64)
+	if err != nil {
+		return "", apperrors.Errorf(apperrors.Validation, "failed to parse artist id: %w", err)
+	}
+	if err := ar.checkArtist(ctx, album.ArtistId); err != nil {
+		return "", err
+	}
+
+	dbAlbum := AlbumRecord{
+		Name:        album.Name,
+		Image:       album.Image,
+		Release:     release,
+		ArtistId:    artistID,
+		ReleaseType: releaseType(album.ReleaseType),
+		Genre:       toNullable(album.Genre),
+		Labels:      labels(album.Labels),
+	}
+
+	db := database.WithContext(ctx, ar.db)
+	if dbAlbum.Image == "" {
+		db = db.Omit("image")
+	}
+	if err := db.Create(&dbAlbum).Error; err != nil {
+		return "", fmt.Errorf("failed to create album: %w", err)
+	}
+	return strconv.FormatUint(dbAlbum.Id, 10), nil
+}
+
+func (ar *DbAlbumRepository) UpdateAlbum(ctx context.Context, album models.Album) error {
+	release, err := time.Parse("02-01-2006", album.Release)
+	if err != nil {
+		return apperrors.Errorf(apperrors.Validation, "failed to parse release date: %w", err)
+	}
+	if err := ar.checkArtist(ctx, album.ArtistId); err != nil {
+		return err
+	}
+
+	db := database.WithContext(ctx, ar.db).Exec("update albums set name = ?, image = coalesce(nullif(?, ''), image), release = ?, "+
+		"artist_id = ?, release_type = ?, genre = ?, labels = ? where id = ? and deleted_at is null",
+		album.Name, album.Image, release, album.ArtistId,
+		releaseType(album.ReleaseType), toNullable(album.Genre), labels(album.Labels), album.Id)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to update album: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "album not found")
+	}
+	return nil
+}
+
+func (ar *DbAlbumRepository) DeleteAlbum(ctx context.Context, id string) error {
+	db := database.WithContext(ctx, ar.db).Exec("update albums set deleted_at = now() where id = ? and deleted_at is null", id)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to delete album: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "album not found")
+	}
+	return nil
+}
+
+func (ar *DbAlbumRepository) SetAlbumTracks(ctx context.Context, aID string, tracks models.AlbumTracks) error {
+	tx := database.WithContext(ctx, ar.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	db := tx.Exec("delete from album_tracks where album_id = ?", aID)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear album tracks: %w", err)
+	}
+
+	for i, tID := range tracks.Tracks {
+		var disc uint = 1
+		if len(tracks.Discs) > i {
+			disc = tracks.Discs[i]
+		}
+		db = tx.Exec("insert into album_tracks (album_id, track_id, index, disc) values (?, ?, ?, ?)", aID, tID, i+1, disc)
+		if err := db.Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to insert album track: %w", err)
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// SetAlbumArtists replaces featured and producer credits of the album,
+// the primary credit follows albums.artist_id
+func (ar *DbAlbumRepository) SetAlbumArtists(ctx context.Context, aID string, credits []models.ArtistCredit) error {
+	tx := database.WithContext(ctx, ar.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// locking the album keeps it from being deleted while its credits are rewritten
+	db := tx.Exec("select id from albums where id = ? and deleted_at is null for update", aID)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to lock album: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.NotFound, "album not found")
+	}
+
+	db = tx.Exec("delete from album_artists where album_id = ? and role <> ?", aID, models.CreditPrimary)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear album artists: %w", err)
+	}
+
+	for i, elem := range credits {
+		db = tx.Exec("insert into album_artists (album_id, artist_id, role, position) values (?, ?, ?, ?)",
+			aID, elem.Id, elem.Role, i+1)
+		if err := db.Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to insert album artist: %w", err)
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -1,13 +1,16 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-test/deep"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"regexp"
@@ -47,13 +50,16 @@ func (s *Suite) SetupSuite() {
 			IsLiked:    false,
 		},
 		{
-			Id:         "523523",
-			Name:       "te5235sdfgst-name",
-			Image:      "img-test3",
-			Release:    "11-11-2011",
-			ArtistName: "kek",
-			ArtistId:   "2344123",
-			IsLiked:    false,
+			Id:          "523523",
+			Name:        "te5235sdfgst-name",
+			Image:       "img-test3",
+			Release:     "11-11-2011",
+			ArtistName:  "kek",
+			ArtistId:    "2344123",
+			IsLiked:     false,
+			ReleaseType: models.ReleaseEP,
+			Genre:       "rock",
+			Labels:      []string{"Sub Pop"},
 		},
 	}
 
@@ -80,13 +86,17 @@ func (s *Suite) TestAlbumById() {
 	loc := time.Local
 	testTime := time.Date(1999, 1, 12, 0, 0, 0, 0, loc)
 
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "albums" WHERE (id = $1)`)).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "album_info" WHERE "album_info"."deleted_at" IS NULL AND ((id = $1))`)).
 		WithArgs(album.Id).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id"}).
-			AddRow(album.Id, album.Name, testTime, album.Image, album.ArtistId))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id", "credit_ids", "credit_names", "credit_roles"}).
+			AddRow(album.Id, album.Name, testTime, album.Image, album.ArtistId, "{3487919,12}", "{main,producer}", "{primary,producer}"))
 
-	res, err := s.repository.GetAlbumById(album.Id)
+	res, err := s.repository.GetAlbumById(context.Background(), album.Id)
 
+	album.Artists = []models.ArtistCredit{
+		{Id: album.ArtistId, Name: "main", Role: models.CreditPrimary},
+		{Id: "12", Name: "producer", Role: models.CreditProducer},
+	}
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(album, res))
 
@@ -95,7 +105,7 @@ func (s *Suite) TestAlbumById() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(album.Id).WillReturnError(dbError)
 
-	_, err = s.repository.GetAlbumById(album.Id)
+	_, err = s.repository.GetAlbumById(context.Background(), album.Id)
 
 	require.Error(s.T(), err)
 }
@@ -113,7 +123,7 @@ func (s *Suite) TestGetUserAlbums() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id", "artist_name"}).
 			AddRow(album[0].Id, album[0].Name, testTime, album[0].Image, album[0].ArtistId, album[0].ArtistName))
 
-	res, err := s.repository.GetUserAlbums(album[0].Id)
+	res, err := s.repository.GetUserAlbums(context.Background(), album[0].Id)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(album[:1], res))
@@ -123,7 +133,7 @@ func (s *Suite) TestGetUserAlbums() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(album[0].Id).WillReturnError(dbError)
 
-	_, err = s.repository.GetUserAlbums(album[0].Id)
+	_, err = s.repository.GetUserAlbums(context.Background(), album[0].Id)
 
 	require.Error(s.T(), err)
 }
@@ -142,12 +152,12 @@ func (s *Suite) TestSearch() {
 	text := "artis"
 	count := 5
 
-	s.mock.ExpectQuery("SELECT").
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "album_info" WHERE "album_info"."deleted_at" IS NULL AND ((name ILIKE $1)) LIMIT 5`)).
 		WithArgs("%" + text + "%").
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "artist_id", "artist_name", "image"}).
 			AddRow(album[0].AlbumID, album[0].AlbumName, album[0].ArtistID, album[0].ArtistName, album[0].Image))
 
-	res, err := s.repository.Search(text, uint(count))
+	res, err := s.repository.Search(context.Background(), text, uint(count))
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(album, res))
@@ -157,7 +167,7 @@ func (s *Suite) TestSearch() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.Search(text, uint(count))
+	_, err = s.repository.Search(context.Background(), text, uint(count))
 
 	require.Error(s.T(), err)
 }
@@ -172,15 +182,15 @@ func (s *Suite) TestGetBoundedAlbumsByArtistId() {
 	testTime1, _ := time.Parse("02-01-2006", album[0].Release)
 	testTime2, _ := time.Parse("02-01-2006", album[1].Release)
 
-	query := fmt.Sprintf(`SELECT * FROM "albums" WHERE (artist_id = $1) ORDER BY "release" LIMIT %d OFFSET %d`, limit, start)
+	query := fmt.Sprintf(`SELECT * FROM "album_info" WHERE "album_info"."deleted_at" IS NULL AND ((artist_id = $1)) ORDER BY "release" LIMIT %d OFFSET %d`, limit, start)
 
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(album[0].ArtistId).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id", "artist_name"}).
-			AddRow(album[0].Id, album[0].Name, testTime1, album[0].Image, album[0].ArtistId, album[0].ArtistName).
-			AddRow(album[1].Id, album[1].Name, testTime2, album[1].Image, album[1].ArtistId, album[1].ArtistName))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id", "artist_name", "release_type", "genre", "labels"}).
+			AddRow(album[0].Id, album[0].Name, testTime1, album[0].Image, album[0].ArtistId, album[0].ArtistName, "", nil, nil).
+			AddRow(album[1].Id, album[1].Name, testTime2, album[1].Image, album[1].ArtistId, album[1].ArtistName, album[1].ReleaseType, album[1].Genre, `{"Sub Pop"}`))
 
-	res, err := s.repository.GetBoundedAlbumsByArtistId(album[0].ArtistId, start, end)
+	res, err := s.repository.GetBoundedAlbumsByArtistId(context.Background(), album[0].ArtistId, start, end)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(album, res))
@@ -190,11 +200,98 @@ func (s *Suite) TestGetBoundedAlbumsByArtistId() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.GetBoundedAlbumsByArtistId(album[0].ArtistId, start, end)
+	_, err = s.repository.GetBoundedAlbumsByArtistId(context.Background(), album[0].ArtistId, start, end)
 
 	require.Error(s.T(), err)
 }
 
+func (s *Suite) TestGetAlbumDetails() {
+	album := s.albums[1]
+	release, _ := time.Parse("02-01-2006", album.Release)
+	columns := []string{"album_id", "album_name", "album_image", "release", "release_type", "genre", "labels",
+		"artist_id", "artist_name", "track_count", "duration", "disc_count"}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "album_details" WHERE (album_id = $1)`)).
+		WithArgs(album.Id).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(album.Id, album.Name, album.Image, release, album.ReleaseType, album.Genre, `{"Sub Pop"}`,
+				album.ArtistId, album.ArtistName, 12, 2400, 2))
+
+	res, err := s.repository.GetAlbumDetails(context.Background(), album.Id)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(models.AlbumDetails{
+		Album:      album,
+		TrackCount: 12,
+		Duration:   2400,
+		DiscCount:  2,
+	}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs(album.Id).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err = s.repository.GetAlbumDetails(context.Background(), album.Id)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestGetAlbumTracks() {
+	aID := s.albums[0].Id
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tracks_in_album" WHERE (album_id = $1) ORDER BY disc, index`)).
+		WithArgs(aID).
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "artist_id", "duration", "link", "track_image", "disc", "number"}).
+			AddRow(5, "first", "artist", 3, 200, "/link/5", "/img/5", 1, 1).
+			AddRow(6, "second", "artist", 3, 180, "/link/6", "/img/6", 2, 1))
+
+	res, err := s.repository.GetAlbumTracks(context.Background(), aID)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.AlbumTrack{
+		{
+			Track:  models.Track{Id: "5", Name: "first", Artist: "artist", ArtistID: "3", Duration: 200, Link: "/link/5", Image: "/img/5"},
+			Disc:   1,
+			Number: 1,
+		},
+		{
+			Track:  models.Track{Id: "6", Name: "second", Artist: "artist", ArtistID: "3", Duration: 180, Link: "/link/6", Image: "/img/6"},
+			Disc:   2,
+			Number: 1,
+		},
+	}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs(aID).
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetAlbumTracks(context.Background(), aID)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestGetDiscography() {
+	artistID := s.albums[1].ArtistId
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "album_details" WHERE (artist_id = $1) ORDER BY release desc, album_id desc`)).
+		WithArgs(artistID).
+		WillReturnRows(sqlmock.NewRows([]string{"album_id", "release_type", "artist_id", "track_count"}).
+			AddRow(1, models.ReleaseSingle, artistID, 1).
+			AddRow(2, models.ReleaseAlbum, artistID, 10))
+
+	res, err := s.repository.GetDiscography(context.Background(), artistID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res, 2)
+	require.Equal(s.T(), models.ReleaseSingle, res[0].ReleaseType)
+	require.Equal(s.T(), uint(10), res[1].TrackCount)
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs(artistID).
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetDiscography(context.Background(), artistID)
+	require.Error(s.T(), err)
+}
+
 func (s *Suite) TestCheckLike() {
 	aID := "4125252"
 	uID := "67264262352"
@@ -206,7 +303,7 @@ func (s *Suite) TestCheckLike() {
 		WillReturnRows(sqlmock.NewRows([]string{"artist_id", "user_id"}).
 			AddRow(aID, uID))
 
-	res := s.repository.CheckLike(aID, uID)
+	res := s.repository.CheckLike(context.Background(), aID, uID)
 
 	require.True(s.T(), res)
 
@@ -216,7 +313,191 @@ func (s *Suite) TestCheckLike() {
 		WithArgs(aID, uID).
 		WillReturnError(dbError)
 
-	res = s.repository.CheckLike(aID, uID)
+	res = s.repository.CheckLike(context.Background(), aID, uID)
 
 	require.False(s.T(), res)
 }
+
+func (s *Suite) TestCreateAlbum() {
+	album := s.albums[0]
+	release, _ := time.Parse("02-01-2006", album.Release)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM "artists" WHERE (id = $1 and deleted_at is null)`)).
+		WithArgs(album.ArtistId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(album.ArtistId))
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "albums"`).
+		WithArgs(album.Name, album.Image, release, sqlmock.AnyArg(), models.ReleaseAlbum, nil, pq.StringArray{}, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(album.Id))
+	s.mock.ExpectCommit()
+
+	id, err := s.repository.CreateAlbum(context.Background(), album)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), album.Id, id)
+
+	//test on unknown artist
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(album.ArtistId).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err = s.repository.CreateAlbum(context.Background(), album)
+	require.Error(s.T(), err)
+
+	//test on wrong release date
+	album.Release = "1999-01-12"
+
+	_, err = s.repository.CreateAlbum(context.Background(), album)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestUpdateAlbum() {
+	album := s.albums[1]
+	release, _ := time.Parse("02-01-2006", album.Release)
+
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(album.ArtistId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(album.ArtistId))
+	s.mock.ExpectExec("update albums set name").
+		WithArgs(album.Name, album.Image, release, album.ArtistId,
+			album.ReleaseType, album.Genre, pq.StringArray(album.Labels), album.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.UpdateAlbum(context.Background(), album))
+
+	//test on not found
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(album.ArtistId).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(album.ArtistId))
+	s.mock.ExpectExec("update albums set name").
+		WithArgs(album.Name, album.Image, release, album.ArtistId,
+			album.ReleaseType, album.Genre, pq.StringArray(album.Labels), album.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.UpdateAlbum(context.Background(), album))
+}
+
+func (s *Suite) TestDeleteAlbum() {
+	id := s.albums[0].Id
+
+	s.mock.ExpectExec("update albums set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.DeleteAlbum(context.Background(), id))
+
+	//test on already deleted
+	s.mock.ExpectExec("update albums set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.DeleteAlbum(context.Background(), id))
+
+	//test on db error
+	s.mock.ExpectExec("update albums set deleted_at").
+		WithArgs(id).
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.DeleteAlbum(context.Background(), id))
+}
+
+func (s *Suite) TestSetAlbumTracks() {
+	aID := s.albums[0].Id
+	tracks := models.AlbumTracks{Tracks: []string{"12", "3", "7"}, Discs: []uint{1, 1, 2}}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from album_tracks").
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	for i, tID := range tracks.Tracks {
+		s.mock.ExpectExec("insert into album_tracks").
+			WithArgs(aID, tID, i+1, tracks.Discs[i]).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.SetAlbumTracks(context.Background(), aID, tracks))
+
+	//test on db error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from album_tracks").
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	s.mock.ExpectExec("insert into album_tracks").
+		WithArgs(aID, tracks.Tracks[0], 1, uint(1)).
+		WillReturnError(errors.New("db_error"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.SetAlbumTracks(context.Background(), aID, models.AlbumTracks{Tracks: tracks.Tracks}))
+}
+
+func (s *Suite) TestSetAlbumArtists() {
+	aID := s.albums[0].Id
+	credits := []models.ArtistCredit{
+		{Id: "7", Role: models.CreditFeatured},
+		{Id: "8", Role: models.CreditProducer},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from albums").
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from album_artists").
+		WithArgs(aID, models.CreditPrimary).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for i, elem := range credits {
+		s.mock.ExpectExec("insert into album_artists").
+			WithArgs(aID, elem.Id, elem.Role, i+1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.SetAlbumArtists(context.Background(), aID, credits))
+
+	//test on db error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from albums").
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from album_artists").
+		WithArgs(aID, models.CreditPrimary).
+		WillReturnError(errors.New("db_error"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.SetAlbumArtists(context.Background(), aID, credits))
+
+	//test on deleted album
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from albums").
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectRollback()
+
+	err := s.repository.SetAlbumArtists(context.Background(), aID, credits)
+	require.Error(s.T(), err)
+	require.True(s.T(), apperrors.Is(err, apperrors.NotFound))
+}
+
+func (s *Suite) TestGetBoundedAlbumsByGenre() {
+	album := s.albums[0]
+	release, _ := time.Parse("02-01-2006", album.Release)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "album_info" WHERE "album_info"."deleted_at" IS NULL AND ` +
+		`((id IN (SELECT album_id FROM genre_albums WHERE genre_id = $1))) ORDER BY release desc, id desc LIMIT 10 OFFSET 0`)).
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "release", "image", "artist_id", "artist_name"}).
+			AddRow(album.Id, album.Name, release, album.Image, album.ArtistId, album.ArtistName))
+
+	res, err := s.repository.GetBoundedAlbumsByGenre(context.Background(), "3", 0, 10)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.Album{album}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs("3").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetBoundedAlbumsByGenre(context.Background(), "3", 0, 10)
+
+	require.Error(s.T(), err)
+}
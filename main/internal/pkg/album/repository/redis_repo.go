@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+// RedisAlbumRepository caches catalog reads of the wrapped repository, likes and search pass through.
+// Writes invalidate the whole catalog cache
+type RedisAlbumRepository struct {
+	album.Repository
+	cache *cache.Cache
+	log   *logger.MainLogger
+}
+
+func NewRedisAlbumRepository(repository album.Repository, cache *cache.Cache, log *logger.MainLogger) RedisAlbumRepository {
+	return RedisAlbumRepository{
+		Repository: repository,
+		cache:      cache,
+		log:        log,
+	}
+}
+
+func (ar *RedisAlbumRepository) GetAlbumById(ctx context.Context, aId string) (models.Album, error) {
+	var result models.Album
+	err := ar.cache.Get(ctx, "album:"+aId, &result, func() (interface{}, error) {
+		return ar.Repository.GetAlbumById(ctx, aId)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) GetAlbumDetails(ctx context.Context, aID string) (models.AlbumDetails, error) {
+	var result models.AlbumDetails
+	err := ar.cache.Get(ctx, "details:"+aID, &result, func() (interface{}, error) {
+		return ar.Repository.GetAlbumDetails(ctx, aID)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) GetAlbumTracks(ctx context.Context, aID string) ([]models.AlbumTrack, error) {
+	var result []models.AlbumTrack
+	err := ar.cache.Get(ctx, "tracks:"+aID, &result, func() (interface{}, error) {
+		return ar.Repository.GetAlbumTracks(ctx, aID)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) GetDiscography(ctx context.Context, artistID string) ([]models.AlbumDetails, error) {
+	var result []models.AlbumDetails
+	err := ar.cache.Get(ctx, "discography:"+artistID, &result, func() (interface{}, error) {
+		return ar.Repository.GetDiscography(ctx, artistID)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) GetBoundedAlbumsByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Album, error) {
+	var result []models.Album
+	err := ar.cache.Get(ctx, fmt.Sprintf("artist:%s:%d:%d", id, start, end), &result, func() (interface{}, error) {
+		return ar.Repository.GetBoundedAlbumsByArtistId(ctx, id, start, end)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) GetBoundedAlbumsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Album, error) {
+	var result []models.Album
+	err := ar.cache.Get(ctx, fmt.Sprintf("genre:%s:%d:%d", gID, start, end), &result, func() (interface{}, error) {
+		return ar.Repository.GetBoundedAlbumsByGenre(ctx, gID, start, end)
+	})
+	return result, err
+}
+
+func (ar *RedisAlbumRepository) CreateAlbum(ctx context.Context, album models.Album) (string, error) {
+	id, err := ar.Repository.CreateAlbum(ctx, album)
+	if err != nil {
+		return "", err
+	}
+	ar.invalidate(ctx)
+	return id, nil
+}
+
+func (ar *RedisAlbumRepository) UpdateAlbum(ctx context.Context, album models.Album) error {
+	if err := ar.Repository.UpdateAlbum(ctx, album); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+func (ar *RedisAlbumRepository) DeleteAlbum(ctx context.Context, id string) error {
+	if err := ar.Repository.DeleteAlbum(ctx, id); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+func (ar *RedisAlbumRepository) SetAlbumTracks(ctx context.Context, aID string, tracks models.AlbumTracks) error {
+	if err := ar.Repository.SetAlbumTracks(ctx, aID, tracks); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+func (ar *RedisAlbumRepository) SetAlbumArtists(ctx context.Context, aID string, credits []models.ArtistCredit) error {
+	if err := ar.Repository.SetAlbumArtists(ctx, aID, credits); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+// invalidate never fails the write, it is committed already. The stale entries expire after ttl
+func (ar *RedisAlbumRepository) invalidate(ctx context.Context) {
+	if err := ar.cache.Invalidate(ctx); err != nil {
+		ar.log.LogWarning(ctx, "album repository", "invalidate", "album is saved, but the cache is stale: "+err.Error())
+	}
+}
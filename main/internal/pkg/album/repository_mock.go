@@ -5,6 +5,7 @@
 package album
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,89 +35,220 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // GetUserAlbums mocks base method
-func (m *MockRepository) GetUserAlbums(uId string) ([]models.Album, error) {
+func (m *MockRepository) GetUserAlbums(ctx context.Context, uId string) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAlbums", uId)
+	ret := m.ctrl.Call(m, "GetUserAlbums", ctx, uId)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserAlbums indicates an expected call of GetUserAlbums
-func (mr *MockRepositoryMockRecorder) GetUserAlbums(uId interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetUserAlbums(ctx, uId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAlbums", reflect.TypeOf((*MockRepository)(nil).GetUserAlbums), uId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAlbums", reflect.TypeOf((*MockRepository)(nil).GetUserAlbums), ctx, uId)
 }
 
 // GetAlbumById mocks base method
-func (m *MockRepository) GetAlbumById(aId string) (models.Album, error) {
+func (m *MockRepository) GetAlbumById(ctx context.Context, aId string) (models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlbumById", aId)
+	ret := m.ctrl.Call(m, "GetAlbumById", ctx, aId)
 	ret0, _ := ret[0].(models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlbumById indicates an expected call of GetAlbumById
-func (mr *MockRepositoryMockRecorder) GetAlbumById(aId interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetAlbumById(ctx, aId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumById", reflect.TypeOf((*MockRepository)(nil).GetAlbumById), aId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumById", reflect.TypeOf((*MockRepository)(nil).GetAlbumById), ctx, aId)
+}
+
+// GetAlbumDetails mocks base method
+func (m *MockRepository) GetAlbumDetails(ctx context.Context, aID string) (models.AlbumDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumDetails", ctx, aID)
+	ret0, _ := ret[0].(models.AlbumDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumDetails indicates an expected call of GetAlbumDetails
+func (mr *MockRepositoryMockRecorder) GetAlbumDetails(ctx, aID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumDetails", reflect.TypeOf((*MockRepository)(nil).GetAlbumDetails), ctx, aID)
+}
+
+// GetAlbumTracks mocks base method
+func (m *MockRepository) GetAlbumTracks(ctx context.Context, aID string) ([]models.AlbumTrack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumTracks", ctx, aID)
+	ret0, _ := ret[0].([]models.AlbumTrack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumTracks indicates an expected call of GetAlbumTracks
+func (mr *MockRepositoryMockRecorder) GetAlbumTracks(ctx, aID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumTracks", reflect.TypeOf((*MockRepository)(nil).GetAlbumTracks), ctx, aID)
+}
+
+// GetDiscography mocks base method
+func (m *MockRepository) GetDiscography(ctx context.Context, artistID string) ([]models.AlbumDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscography", ctx, artistID)
+	ret0, _ := ret[0].([]models.AlbumDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscography indicates an expected call of GetDiscography
+func (mr *MockRepositoryMockRecorder) GetDiscography(ctx, artistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscography", reflect.TypeOf((*MockRepository)(nil).GetDiscography), ctx, artistID)
 }
 
 // GetBoundedAlbumsByArtistId mocks base method
-func (m *MockRepository) GetBoundedAlbumsByArtistId(id string, start, end uint64) ([]models.Album, error) {
+func (m *MockRepository) GetBoundedAlbumsByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedAlbumsByArtistId", id, start, end)
+	ret := m.ctrl.Call(m, "GetBoundedAlbumsByArtistId", ctx, id, start, end)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedAlbumsByArtistId indicates an expected call of GetBoundedAlbumsByArtistId
-func (mr *MockRepositoryMockRecorder) GetBoundedAlbumsByArtistId(id, start, end interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBoundedAlbumsByArtistId(ctx, id, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByArtistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedAlbumsByArtistId), ctx, id, start, end)
+}
+
+// GetBoundedAlbumsByGenre mocks base method
+func (m *MockRepository) GetBoundedAlbumsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedAlbumsByGenre", ctx, gID, start, end)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedAlbumsByGenre indicates an expected call of GetBoundedAlbumsByGenre
+func (mr *MockRepositoryMockRecorder) GetBoundedAlbumsByGenre(ctx, gID, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByArtistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedAlbumsByArtistId), id, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByGenre", reflect.TypeOf((*MockRepository)(nil).GetBoundedAlbumsByGenre), ctx, gID, start, end)
 }
 
 // Search mocks base method
-func (m *MockRepository) Search(text string, count uint) ([]models.AlbumSearch, error) {
+func (m *MockRepository) Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.AlbumSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockRepositoryMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), ctx, text, count)
 }
 
 // RateAlbum mocks base method
-func (m *MockRepository) RateAlbum(aID, uID string) error {
+func (m *MockRepository) RateAlbum(ctx context.Context, aID, uID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RateAlbum", aID, uID)
+	ret := m.ctrl.Call(m, "RateAlbum", ctx, aID, uID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RateAlbum indicates an expected call of RateAlbum
-func (mr *MockRepositoryMockRecorder) RateAlbum(aID, uID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) RateAlbum(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateAlbum", reflect.TypeOf((*MockRepository)(nil).RateAlbum), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateAlbum", reflect.TypeOf((*MockRepository)(nil).RateAlbum), ctx, aID, uID)
 }
 
 // CheckLike mocks base method
-func (m *MockRepository) CheckLike(aID, uID string) bool {
+func (m *MockRepository) CheckLike(ctx context.Context, aID, uID string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckLike", aID, uID)
+	ret := m.ctrl.Call(m, "CheckLike", ctx, aID, uID)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // CheckLike indicates an expected call of CheckLike
-func (mr *MockRepositoryMockRecorder) CheckLike(aID, uID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CheckLike(ctx, aID, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLike", reflect.TypeOf((*MockRepository)(nil).CheckLike), ctx, aID, uID)
+}
+
+// CreateAlbum mocks base method
+func (m *MockRepository) CreateAlbum(ctx context.Context, album models.Album) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbum", ctx, album)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum
+func (mr *MockRepositoryMockRecorder) CreateAlbum(ctx, album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockRepository)(nil).CreateAlbum), ctx, album)
+}
+
+// UpdateAlbum mocks base method
+func (m *MockRepository) UpdateAlbum(ctx context.Context, album models.Album) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbum", ctx, album)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbum indicates an expected call of UpdateAlbum
+func (mr *MockRepositoryMockRecorder) UpdateAlbum(ctx, album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbum", reflect.TypeOf((*MockRepository)(nil).UpdateAlbum), ctx, album)
+}
+
+// DeleteAlbum mocks base method
+func (m *MockRepository) DeleteAlbum(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlbum", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum
+func (mr *MockRepositoryMockRecorder) DeleteAlbum(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockRepository)(nil).DeleteAlbum), ctx, id)
+}
+
+// SetAlbumTracks mocks base method
+func (m *MockRepository) SetAlbumTracks(ctx context.Context, aID string, tracks models.AlbumTracks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumTracks", ctx, aID, tracks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumTracks indicates an expected call of SetAlbumTracks
+func (mr *MockRepositoryMockRecorder) SetAlbumTracks(ctx, aID, tracks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumTracks", reflect.TypeOf((*MockRepository)(nil).SetAlbumTracks), ctx, aID, tracks)
+}
+
+// SetAlbumArtists mocks base method
+func (m *MockRepository) SetAlbumArtists(ctx context.Context, aID string, credits []models.ArtistCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumArtists", ctx, aID, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumArtists indicates an expected call of SetAlbumArtists
+func (mr *MockRepositoryMockRecorder) SetAlbumArtists(ctx, aID, credits interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLike", reflect.TypeOf((*MockRepository)(nil).CheckLike), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumArtists", reflect.TypeOf((*MockRepository)(nil).SetAlbumArtists), ctx, aID, credits)
 }
@@ -1,11 +1,17 @@
 package album
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type UseCase interface {
-	GetUserAlbums(id string) ([]models.Album, error)
-	GetAlbumById(aID, uID string) (models.Album, error)
-	GetBoundedAlbumsByArtistId(id string, start uint64, end uint64) ([]models.Album, error)
-	Search(text string, count uint) ([]models.AlbumSearch, error)
-	RateAlbum(aID, uID string) error
+	GetUserAlbums(ctx context.Context, id string) ([]models.Album, error)
+	GetAlbumById(ctx context.Context, aID, uID string) (models.Album, error)
+	GetAlbumDetails(ctx context.Context, aID, uID string) (models.AlbumDetails, error)
+	GetDiscography(ctx context.Context, artistID string) (models.Discography, error)
+	GetBoundedAlbumsByArtistId(ctx context.Context, id string, start uint64, end uint64) ([]models.Album, error)
+	GetBoundedAlbumsByGenre(ctx context.Context, gID string, start uint64, end uint64) ([]models.Album, error)
+	Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error)
+	RateAlbum(ctx context.Context, aID, uID string) error
 }
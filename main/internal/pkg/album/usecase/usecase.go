@@ -1,37 +1,100 @@
 package usecase
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 )
 
 type AlbumUseCase struct {
 	AlbumRepository album.Repository
+	TrackRepository track.Repository
 }
 
-func (uc AlbumUseCase) GetUserAlbums(id string) ([]models.Album, error) {
-	return uc.AlbumRepository.GetUserAlbums(id)
+func (uc AlbumUseCase) GetUserAlbums(ctx context.Context, id string) ([]models.Album, error) {
+	return uc.AlbumRepository.GetUserAlbums(ctx, id)
 }
 
-func (uc AlbumUseCase) GetAlbumById(aID, uID string) (models.Album, error) {
-	dbAlbum, err := uc.AlbumRepository.GetAlbumById(aID)
+func (uc AlbumUseCase) GetAlbumById(ctx context.Context, aID, uID string) (models.Album, error) {
+	dbAlbum, err := uc.AlbumRepository.GetAlbumById(ctx, aID)
 	if err != nil {
 		return models.Album{}, err
 	}
 	if uID != "" {
-		dbAlbum.IsLiked = uc.AlbumRepository.CheckLike(aID, uID)
+		dbAlbum.IsLiked = uc.AlbumRepository.CheckLike(ctx, aID, uID)
 	}
 	return dbAlbum, nil
 }
 
-func (uc AlbumUseCase) GetBoundedAlbumsByArtistId(id string, start uint64, end uint64) ([]models.Album, error) {
-	return uc.AlbumRepository.GetBoundedAlbumsByArtistId(id, start, end)
+func (uc AlbumUseCase) GetAlbumDetails(ctx context.Context, aID, uID string) (models.AlbumDetails, error) {
+	details, err := uc.AlbumRepository.GetAlbumDetails(ctx, aID)
+	if err != nil {
+		return models.AlbumDetails{}, err
+	}
+	details.Tracks, err = uc.AlbumRepository.GetAlbumTracks(ctx, aID)
+	if err != nil {
+		return models.AlbumDetails{}, err
+	}
+	if uID == "" {
+		return details, nil
+	}
+
+	details.IsLiked = uc.AlbumRepository.CheckLike(ctx, aID, uID)
+	tIDs := make([]string, len(details.Tracks))
+	for i, elem := range details.Tracks {
+		tIDs[i] = elem.Id
+	}
+	liked, err := uc.TrackRepository.CheckLikes(ctx, uID, tIDs)
+	if err != nil {
+		return models.AlbumDetails{}, err
+	}
+	for i, elem := range details.Tracks {
+		details.Tracks[i].IsLiked = liked[elem.Id]
+	}
+	return details, nil
+}
+
+func (uc AlbumUseCase) GetDiscography(ctx context.Context, artistID string) (models.Discography, error) {
+	releases, err := uc.AlbumRepository.GetDiscography(ctx, artistID)
+	if err != nil {
+		return models.Discography{}, err
+	}
+
+	discography := models.Discography{
+		ArtistId:     artistID,
+		Albums:       []models.AlbumDetails{},
+		Singles:      []models.AlbumDetails{},
+		EPs:          []models.AlbumDetails{},
+		Compilations: []models.AlbumDetails{},
+	}
+	for _, elem := range releases {
+		switch elem.ReleaseType {
+		case models.ReleaseSingle:
+			discography.Singles = append(discography.Singles, elem)
+		case models.ReleaseEP:
+			discography.EPs = append(discography.EPs, elem)
+		case models.ReleaseCompilation:
+			discography.Compilations = append(discography.Compilations, elem)
+		default:
+			discography.Albums = append(discography.Albums, elem)
+		}
+	}
+	return discography, nil
+}
+
+func (uc AlbumUseCase) GetBoundedAlbumsByArtistId(ctx context.Context, id string, start uint64, end uint64) ([]models.Album, error) {
+	return uc.AlbumRepository.GetBoundedAlbumsByArtistId(ctx, id, start, end)
+}
+
+func (uc AlbumUseCase) GetBoundedAlbumsByGenre(ctx context.Context, gID string, start uint64, end uint64) ([]models.Album, error) {
+	return uc.AlbumRepository.GetBoundedAlbumsByGenre(ctx, gID, start, end)
 }
 
-func (uc AlbumUseCase) Search(text string, count uint) ([]models.AlbumSearch, error) {
-	return uc.AlbumRepository.Search(text, count)
+func (uc AlbumUseCase) Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error) {
+	return uc.AlbumRepository.Search(ctx, text, count)
 }
 
-func (uc AlbumUseCase) RateAlbum(aID, uID string) error {
-	return uc.AlbumRepository.RateAlbum(aID, uID)
+func (uc AlbumUseCase) RateAlbum(ctx context.Context, aID, uID string) error {
+	return uc.AlbumRepository.RateAlbum(ctx, aID, uID)
 }
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testTracks() []models.AlbumTrack {
+	return []models.AlbumTrack{
+		{Track: models.Track{Id: "5", Name: "first"}, Disc: 1, Number: 1},
+		{Track: models.Track{Id: "6", Name: "second"}, Disc: 2, Number: 1},
+	}
+}
+
+func TestGetAlbumDetails(t *testing.T) {
+	details := models.AlbumDetails{
+		Album:      models.Album{Id: "1", Name: "album", ReleaseType: models.ReleaseAlbum},
+		TrackCount: 2,
+		Duration:   400,
+		DiscCount:  2,
+	}
+
+	t.Run("GetAlbumDetails-Guest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		m.EXPECT().GetAlbumDetails(gomock.Any(), "1").Return(details, nil)
+		m.EXPECT().GetAlbumTracks(gomock.Any(), "1").Return(testTracks(), nil)
+
+		useCase := AlbumUseCase{AlbumRepository: m}
+
+		result, err := useCase.GetAlbumDetails(context.Background(), "1", "")
+		assert.NoError(t, err)
+		assert.False(t, result.IsLiked)
+		assert.Equal(t, testTracks(), result.Tracks)
+	})
+
+	t.Run("GetAlbumDetails-Liked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		tr := track.NewMockRepository(ctrl)
+		m.EXPECT().GetAlbumDetails(gomock.Any(), "1").Return(details, nil)
+		m.EXPECT().GetAlbumTracks(gomock.Any(), "1").Return(testTracks(), nil)
+		m.EXPECT().CheckLike(gomock.Any(), "1", "7").Return(true)
+		tr.EXPECT().CheckLikes(gomock.Any(), "7", []string{"5", "6"}).Return(map[string]bool{"6": true}, nil)
+
+		useCase := AlbumUseCase{AlbumRepository: m, TrackRepository: tr}
+
+		result, err := useCase.GetAlbumDetails(context.Background(), "1", "7")
+		assert.NoError(t, err)
+		assert.True(t, result.IsLiked)
+		assert.False(t, result.Tracks[0].IsLiked)
+		assert.True(t, result.Tracks[1].IsLiked)
+	})
+
+	t.Run("GetAlbumDetails-TracksError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		m.EXPECT().GetAlbumDetails(gomock.Any(), "1").Return(details, nil)
+		m.EXPECT().GetAlbumTracks(gomock.Any(), "1").Return(nil, errors.New("db error"))
+
+		useCase := AlbumUseCase{AlbumRepository: m}
+
+		_, err := useCase.GetAlbumDetails(context.Background(), "1", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("GetAlbumDetails-LikesError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		tr := track.NewMockRepository(ctrl)
+		m.EXPECT().GetAlbumDetails(gomock.Any(), "1").Return(details, nil)
+		m.EXPECT().GetAlbumTracks(gomock.Any(), "1").Return(testTracks(), nil)
+		m.EXPECT().CheckLike(gomock.Any(), "1", "7").Return(false)
+		tr.EXPECT().CheckLikes(gomock.Any(), "7", []string{"5", "6"}).Return(nil, errors.New("db error"))
+
+		useCase := AlbumUseCase{AlbumRepository: m, TrackRepository: tr}
+
+		_, err := useCase.GetAlbumDetails(context.Background(), "1", "7")
+		assert.Error(t, err)
+	})
+
+	t.Run("GetAlbumDetails-NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		m.EXPECT().GetAlbumDetails(gomock.Any(), "1").Return(models.AlbumDetails{}, errors.New("not found"))
+
+		useCase := AlbumUseCase{AlbumRepository: m}
+
+		_, err := useCase.GetAlbumDetails(context.Background(), "1", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDiscography(t *testing.T) {
+	t.Run("GetDiscography-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		releases := []models.AlbumDetails{
+			{Album: models.Album{Id: "4", ReleaseType: models.ReleaseSingle}},
+			{Album: models.Album{Id: "3", ReleaseType: models.ReleaseAlbum}},
+			{Album: models.Album{Id: "2", ReleaseType: models.ReleaseEP}},
+			{Album: models.Album{Id: "1", ReleaseType: models.ReleaseSingle}},
+		}
+
+		m := album.NewMockRepository(ctrl)
+		m.EXPECT().GetDiscography(gomock.Any(), "42").Return(releases, nil)
+
+		useCase := AlbumUseCase{AlbumRepository: m}
+
+		result, err := useCase.GetDiscography(context.Background(), "42")
+		assert.NoError(t, err)
+		assert.Equal(t, models.Discography{
+			ArtistId:     "42",
+			Albums:       []models.AlbumDetails{releases[1]},
+			Singles:      []models.AlbumDetails{releases[0], releases[3]},
+			EPs:          []models.AlbumDetails{releases[2]},
+			Compilations: []models.AlbumDetails{},
+		}, result)
+	})
+
+	t.Run("GetDiscography-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := album.NewMockRepository(ctrl)
+		m.EXPECT().GetDiscography(gomock.Any(), "42").Return(nil, errors.New("db error"))
+
+		useCase := AlbumUseCase{AlbumRepository: m}
+
+		_, err := useCase.GetDiscography(context.Background(), "42")
+		assert.Error(t, err)
+	})
+}
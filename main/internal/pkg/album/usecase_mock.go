@@ -5,6 +5,7 @@
 package album
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,75 +35,120 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // GetUserAlbums mocks base method
-func (m *MockUseCase) GetUserAlbums(id string) ([]models.Album, error) {
+func (m *MockUseCase) GetUserAlbums(ctx context.Context, id string) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAlbums", id)
+	ret := m.ctrl.Call(m, "GetUserAlbums", ctx, id)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserAlbums indicates an expected call of GetUserAlbums
-func (mr *MockUseCaseMockRecorder) GetUserAlbums(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserAlbums(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAlbums", reflect.TypeOf((*MockUseCase)(nil).GetUserAlbums), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAlbums", reflect.TypeOf((*MockUseCase)(nil).GetUserAlbums), ctx, id)
 }
 
 // GetAlbumById mocks base method
-func (m *MockUseCase) GetAlbumById(aID, uID string) (models.Album, error) {
+func (m *MockUseCase) GetAlbumById(ctx context.Context, aID, uID string) (models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlbumById", aID, uID)
+	ret := m.ctrl.Call(m, "GetAlbumById", ctx, aID, uID)
 	ret0, _ := ret[0].(models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlbumById indicates an expected call of GetAlbumById
-func (mr *MockUseCaseMockRecorder) GetAlbumById(aID, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetAlbumById(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumById", reflect.TypeOf((*MockUseCase)(nil).GetAlbumById), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumById", reflect.TypeOf((*MockUseCase)(nil).GetAlbumById), ctx, aID, uID)
+}
+
+// GetAlbumDetails mocks base method
+func (m *MockUseCase) GetAlbumDetails(ctx context.Context, aID, uID string) (models.AlbumDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumDetails", ctx, aID, uID)
+	ret0, _ := ret[0].(models.AlbumDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumDetails indicates an expected call of GetAlbumDetails
+func (mr *MockUseCaseMockRecorder) GetAlbumDetails(ctx, aID, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumDetails", reflect.TypeOf((*MockUseCase)(nil).GetAlbumDetails), ctx, aID, uID)
+}
+
+// GetDiscography mocks base method
+func (m *MockUseCase) GetDiscography(ctx context.Context, artistID string) (models.Discography, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscography", ctx, artistID)
+	ret0, _ := ret[0].(models.Discography)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscography indicates an expected call of GetDiscography
+func (mr *MockUseCaseMockRecorder) GetDiscography(ctx, artistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscography", reflect.TypeOf((*MockUseCase)(nil).GetDiscography), ctx, artistID)
 }
 
 // GetBoundedAlbumsByArtistId mocks base method
-func (m *MockUseCase) GetBoundedAlbumsByArtistId(id string, start, end uint64) ([]models.Album, error) {
+func (m *MockUseCase) GetBoundedAlbumsByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedAlbumsByArtistId", id, start, end)
+	ret := m.ctrl.Call(m, "GetBoundedAlbumsByArtistId", ctx, id, start, end)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedAlbumsByArtistId indicates an expected call of GetBoundedAlbumsByArtistId
-func (mr *MockUseCaseMockRecorder) GetBoundedAlbumsByArtistId(id, start, end interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetBoundedAlbumsByArtistId(ctx, id, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByArtistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedAlbumsByArtistId), ctx, id, start, end)
+}
+
+// GetBoundedAlbumsByGenre mocks base method
+func (m *MockUseCase) GetBoundedAlbumsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedAlbumsByGenre", ctx, gID, start, end)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedAlbumsByGenre indicates an expected call of GetBoundedAlbumsByGenre
+func (mr *MockUseCaseMockRecorder) GetBoundedAlbumsByGenre(ctx, gID, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByArtistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedAlbumsByArtistId), id, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedAlbumsByGenre", reflect.TypeOf((*MockUseCase)(nil).GetBoundedAlbumsByGenre), ctx, gID, start, end)
 }
 
 // Search mocks base method
-func (m *MockUseCase) Search(text string, count uint) ([]models.AlbumSearch, error) {
+func (m *MockUseCase) Search(ctx context.Context, text string, count uint) ([]models.AlbumSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.AlbumSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockUseCaseMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), ctx, text, count)
 }
 
 // RateAlbum mocks base method
-func (m *MockUseCase) RateAlbum(aID, uID string) error {
+func (m *MockUseCase) RateAlbum(ctx context.Context, aID, uID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RateAlbum", aID, uID)
+	ret := m.ctrl.Call(m, "RateAlbum", ctx, aID, uID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RateAlbum indicates an expected call of RateAlbum
-func (mr *MockUseCaseMockRecorder) RateAlbum(aID, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) RateAlbum(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateAlbum", reflect.TypeOf((*MockUseCase)(nil).RateAlbum), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateAlbum", reflect.TypeOf((*MockUseCase)(nil).RateAlbum), ctx, aID, uID)
 }
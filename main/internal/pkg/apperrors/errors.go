@@ -0,0 +1,155 @@
+package apperrors
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind tells handlers what went wrong without parsing messages
+type Kind int
+
+const (
+	Internal Kind = iota
+	NotFound
+	Forbidden
+	Conflict
+	Validation
+	Unavailable
+	TooLarge
+	RateLimited
+)
+
+var kindNames = map[Kind]string{
+	Internal:    "internal",
+	NotFound:    "not_found",
+	Forbidden:   "forbidden",
+	Conflict:    "conflict",
+	Validation:  "validation",
+	Unavailable: "unavailable",
+	TooLarge:    "too_large",
+	RateLimited: "rate_limited",
+}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// FieldError tells the client which input field was rejected and why
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error is an error of a known kind, its message is safe to show to the client
+type Error struct {
+	Kind   Kind
+	Err    error
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func New(kind Kind, msg string) error {
+	return &Error{Kind: kind, Err: errors.New(msg)}
+}
+
+// Errorf formats like fmt.Errorf, so errors wrapped with %w stay reachable by errors.Is
+func Errorf(kind Kind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+// Fields returns the field errors of the first typed error in the chain of err
+func Fields(err error) []FieldError {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed.Fields
+	}
+	return nil
+}
+
+// Is reports whether err has the given kind
+func Is(err error, kind Kind) bool {
+	return KindOf(err) == kind
+}
+
+// KindOf returns the kind of the first typed error in the chain of err. Untyped errors of postgres,
+// network and grpc clients are classified by their cause, anything else is Internal
+func KindOf(err error) Kind {
+	if err == nil {
+		return Internal
+	}
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed.Kind
+	}
+	return classify(err)
+}
+
+func classify(err error) Kind {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return NotFound
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) || errors.Is(err, driver.ErrBadConn) {
+		return Unavailable
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return classifyPostgres(pqErr)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return Unavailable
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return classifyGRPC(grpcErr.GRPCStatus().Code())
+	}
+	return Internal
+}
+
+func classifyPostgres(err *pq.Error) Kind {
+	switch err.Code.Class() {
+	case "22": // data exception, e.g. malformed id
+		return Validation
+	case "23":
+		switch err.Code.Name() {
+		case "unique_violation", "foreign_key_violation":
+			return Conflict
+		default:
+			return Validation
+		}
+	case "08", "53", "57": // connection, insufficient resources, operator intervention
+		return Unavailable
+	}
+	return Internal
+}
+
+func classifyGRPC(code codes.Code) Kind {
+	switch code {
+	case codes.NotFound:
+		return NotFound
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return Forbidden
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return Conflict
+	case codes.InvalidArgument, codes.OutOfRange:
+		return Validation
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.ResourceExhausted:
+		return Unavailable
+	}
+	return Internal
+}
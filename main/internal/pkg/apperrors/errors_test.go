@@ -0,0 +1,59 @@
+package apperrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind Kind
+	}{
+		{"Nil", nil, Internal},
+		{"Plain", errors.New("test error"), Internal},
+		{"Typed", New(Forbidden, "not owner"), Forbidden},
+		{"Wrapped", fmt.Errorf("failed to get album: %w", New(NotFound, "album not found")), NotFound},
+		{"Outermost", Errorf(Conflict, "cant rate: %w", New(NotFound, "track not found")), Conflict},
+		{"Gorm", fmt.Errorf("failed to get user: %w", gorm.ErrRecordNotFound), NotFound},
+		{"Deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), Unavailable},
+		{"PostgresSyntax", &pq.Error{Code: "22P02"}, Validation},
+		{"PostgresUnique", &pq.Error{Code: "23505"}, Conflict},
+		{"PostgresNotNull", &pq.Error{Code: "23502"}, Validation},
+		{"PostgresConnection", &pq.Error{Code: "08006"}, Unavailable},
+		{"PostgresOther", &pq.Error{Code: "42P01"}, Internal},
+		{"Network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, Unavailable},
+		{"GRPCNotFound", status.Error(codes.NotFound, "no session"), NotFound},
+		{"GRPCWrapped", fmt.Errorf("check session: %w", status.Error(codes.Unavailable, "down")), Unavailable},
+		{"GRPCUnknown", status.Error(codes.Unknown, "oops"), Internal},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.kind, KindOf(test.err))
+		})
+	}
+}
+
+func TestIs(t *testing.T) {
+	err := fmt.Errorf("failed to delete lyrics: %w", New(Forbidden, "not owner"))
+	assert.True(t, Is(err, Forbidden))
+	assert.False(t, Is(err, NotFound))
+	assert.Equal(t, "failed to delete lyrics: not owner", err.Error())
+}
+
+func TestErrorfUnwrap(t *testing.T) {
+	cause := errors.New("cause")
+	err := Errorf(Validation, "bad input: %w", cause)
+	assert.True(t, errors.Is(err, cause))
+	assert.Equal(t, "bad input: cause", err.Error())
+}
@@ -0,0 +1,63 @@
+package apperrors
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+var statuses = map[Kind]int{
+	Internal:    http.StatusInternalServerError,
+	NotFound:    http.StatusNotFound,
+	Forbidden:   http.StatusForbidden,
+	Conflict:    http.StatusConflict,
+	Validation:  http.StatusBadRequest,
+	Unavailable: http.StatusServiceUnavailable,
+	TooLarge:    http.StatusRequestEntityTooLarge,
+	RateLimited: http.StatusTooManyRequests,
+}
+
+// messages are shown instead of errors classified by cause, those may contain queries or addresses
+var messages = map[Kind]string{
+	Internal:    "internal error",
+	NotFound:    "not found",
+	Forbidden:   "forbidden",
+	Conflict:    "conflict",
+	Validation:  "invalid input",
+	Unavailable: "service is temporarily unavailable",
+	TooLarge:    "request body is too large",
+	RateLimited: "too many requests",
+}
+
+func HTTPStatus(err error) int {
+	return statuses[KindOf(err)]
+}
+
+type body struct {
+	Error struct {
+		Code    string       `json:"code"`
+		Message string       `json:"message"`
+		Fields  []FieldError `json:"fields,omitempty"`
+	} `json:"error"`
+}
+
+// WriteHTTP writes the status matching the kind of err with a JSON body describing it
+// and returns the status for the access log
+func WriteHTTP(w http.ResponseWriter, err error) int {
+	kind := KindOf(err)
+	resp := body{}
+	resp.Error.Code = kind.String()
+	resp.Error.Message = messages[kind]
+
+	var typed *Error
+	if kind != Internal && errors.As(err, &typed) {
+		resp.Error.Message = typed.Error()
+		resp.Error.Fields = typed.Fields
+	}
+
+	code := statuses[kind]
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+	return code
+}
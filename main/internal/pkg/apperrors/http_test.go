@@ -0,0 +1,78 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWriteHTTP(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		body   string
+	}{
+		{
+			name:   "NotFound",
+			err:    fmt.Errorf("failed to get album: %w", New(NotFound, "album not found")),
+			status: http.StatusNotFound,
+			body:   `{"error":{"code":"not_found","message":"album not found"}}`,
+		},
+		{
+			name:   "Validation",
+			err:    New(Validation, "wrong id"),
+			status: http.StatusBadRequest,
+			body:   `{"error":{"code":"validation","message":"wrong id"}}`,
+		},
+		{
+			name: "Fields",
+			err: &Error{
+				Kind:   Validation,
+				Err:    errors.New("invalid fields: email"),
+				Fields: []FieldError{{Field: "email", Message: "must be a valid email address"}},
+			},
+			status: http.StatusBadRequest,
+			body:   `{"error":{"code":"validation","message":"invalid fields: email","fields":[{"field":"email","message":"must be a valid email address"}]}}`,
+		},
+		{
+			name:   "TooLarge",
+			err:    New(TooLarge, "request body must be at most 16 bytes"),
+			status: http.StatusRequestEntityTooLarge,
+			body:   `{"error":{"code":"too_large","message":"request body must be at most 16 bytes"}}`,
+		},
+		{
+			name:   "Internal",
+			err:    errors.New("pq: relation \"users\" does not exist"),
+			status: http.StatusInternalServerError,
+			body:   `{"error":{"code":"internal","message":"internal error"}}`,
+		},
+		{
+			name:   "Classified",
+			err:    fmt.Errorf("failed to check session: %w", status.Error(codes.Unavailable, "dial tcp 10.0.0.3:8081")),
+			status: http.StatusServiceUnavailable,
+			body:   `{"error":{"code":"unavailable","message":"service is temporarily unavailable"}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			assert.Equal(t, test.status, WriteHTTP(w, test.err))
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.JSONEq(t, test.body, w.Body.String())
+		})
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	assert.Equal(t, http.StatusForbidden, HTTPStatus(New(Forbidden, "not owner")))
+	assert.Equal(t, http.StatusConflict, HTTPStatus(New(Conflict, "already enabled")))
+	assert.Equal(t, http.StatusServiceUnavailable, HTTPStatus(New(Unavailable, "down")))
+}
@@ -2,6 +2,7 @@ package delivery
 
 import (
 	"encoding/json"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
@@ -30,10 +31,9 @@ func (h *ArtistHandler) GetFullArtistInfo(w http.ResponseWriter, r *http.Request
 		user = models.User{Id: ""}
 	}
 
-	artistInfo, err := h.ArtistUC.GetArtistById(varId, user.Id)
+	artistInfo, err := h.ArtistUC.GetArtistById(r.Context(), varId, user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "cant get artistInfo:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "cant get artistInfo:"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -67,10 +67,9 @@ func (h *ArtistHandler) GetBoundedArtists(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	artists, err := h.ArtistUC.GetBoundedArtists(uStart, uEnd)
+	artists, err := h.ArtistUC.GetBoundedArtists(r.Context(), uStart, uEnd)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get artists"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get artists"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -88,6 +87,38 @@ func (h *ArtistHandler) GetBoundedArtists(w http.ResponseWriter, r *http.Request
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *ArtistHandler) GetBoundedArtistsByGenre(w http.ResponseWriter, r *http.Request) {
+	id, okId := r.Context().Value(middleware.Id).(string)
+	start, okStart := r.Context().Value(middleware.Start).(uint64)
+	end, okEnd := r.Context().Value(middleware.End).(uint64)
+
+	if !okId || !okStart || !okEnd {
+		h.Log.LogWarning(r.Context(), "artist delivery", "GetBoundedArtistsByGenre", "failed to get vars")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	artists, err := h.ArtistUC.GetBoundedArtistsByGenre(r.Context(), id, start, end)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get artists"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Id      string          `json:"id"`
+		Artists []models.Artist `json:"artists"`
+	}{id, artists})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "artist delivery", "GetBoundedArtistsByGenre", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
 func (h *ArtistHandler) GetArtistStat(w http.ResponseWriter, r *http.Request) {
 	id, ok := mux.Vars(r)["id"]
 	if !ok {
@@ -95,10 +126,9 @@ func (h *ArtistHandler) GetArtistStat(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	artistStat, err := h.ArtistUC.GetArtistStat(id)
+	artistStat, err := h.ArtistUC.GetArtistStat(r.Context(), id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get artist's stat"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get artist's stat"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -126,10 +156,10 @@ func (h *ArtistHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.ArtistUC.Subscription(id, user.Id)
+	err := h.ArtistUC.Subscription(r.Context(), id, user.Id)
 	if err != nil {
 		h.Log.LogWarning(r.Context(), "artist delivery", "Subscription", "failed to subscribe on artist")
-		w.WriteHeader(http.StatusInternalServerError)
+		apperrors.WriteHTTP(w, err)
 		return
 	}
 
@@ -144,10 +174,9 @@ func (h *ArtistHandler) SubscriptionList(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	subscriptions, err := h.ArtistUC.SubscriptionList(user.Id)
+	subscriptions, err := h.ArtistUC.SubscriptionList(r.Context(), user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get user's subscriptions"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get user's subscriptions"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
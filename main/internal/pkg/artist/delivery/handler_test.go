@@ -60,7 +60,7 @@ func TestGetFullArtistInfo(t *testing.T) {
 		assert.NoError(t, err)
 
 		m.EXPECT().
-			GetArtistById(id, "").
+			GetArtistById(gomock.Any(), id, "").
 			Return(artist1, nil)
 
 		apitest.New("GetFullArtistInfo-OK").
@@ -96,7 +96,7 @@ func TestGetFullArtistInfo(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetArtistById(id, "").
+			GetArtistById(gomock.Any(), id, "").
 			Return(models.Artist{}, testError)
 
 		apitest.New("GetFullArtistInfo-GetArtistByIdError").
@@ -104,7 +104,7 @@ func TestGetFullArtistInfo(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/artists/").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -134,7 +134,7 @@ func TestGetBoundedArtists(t *testing.T) {
 		var endUint uint64 = 50
 
 		m.EXPECT().
-			GetBoundedArtists(startUint, endUint).
+			GetBoundedArtists(gomock.Any(), startUint, endUint).
 			Return(artistsArray, nil)
 
 		apitest.New("GetBoundedArtists-OK").
@@ -189,7 +189,7 @@ func TestGetBoundedArtists(t *testing.T) {
 		testError := errors.New("asdjhaoi")
 
 		m.EXPECT().
-			GetBoundedArtists(startUint, endUint).
+			GetBoundedArtists(gomock.Any(), startUint, endUint).
 			Return([]models.Artist{}, testError)
 
 		apitest.New("GetBoundedArtists-Error").
@@ -197,7 +197,7 @@ func TestGetBoundedArtists(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/artists/0/50").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -224,7 +224,7 @@ func TestGetArtistStat(t *testing.T) {
 		assert.NoError(t, err)
 
 		m.EXPECT().
-			GetArtistStat(id).
+			GetArtistStat(gomock.Any(), id).
 			Return(stat, nil)
 
 		apitest.New("GetArtistStat-OK").
@@ -258,7 +258,7 @@ func TestGetArtistStat(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetArtistStat(id).
+			GetArtistStat(gomock.Any(), id).
 			Return(models.ArtistStat{}, testError)
 
 		apitest.New("GetArtistStat-Error").
@@ -266,7 +266,7 @@ func TestGetArtistStat(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/artists/stat").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -289,7 +289,7 @@ func TestSubscribe(t *testing.T) {
 		artistHandler.ArtistUC = m
 
 		m.EXPECT().
-			Subscription(id, testUser.Id).
+			Subscription(gomock.Any(), id, testUser.Id).
 			Return(nil)
 
 		apitest.New("Subscribe-OK").
@@ -341,7 +341,7 @@ func TestSubscribe(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			Subscription(id, testUser.Id).
+			Subscription(gomock.Any(), id, testUser.Id).
 			Return(testError)
 
 		apitest.New("Subscribe-Error").
@@ -382,7 +382,7 @@ func TestSubscriptionList(t *testing.T) {
 		}
 
 		m.EXPECT().
-			SubscriptionList(testUser.Id).
+			SubscriptionList(gomock.Any(), testUser.Id).
 			Return(subList, nil)
 
 		jsonData, err := json.Marshal(subList)
@@ -423,14 +423,14 @@ func TestSubscriptionList(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			SubscriptionList(testUser.Id).
+			SubscriptionList(gomock.Any(), testUser.Id).
 			Return(nil, testError)
 
 		apitest.New("SubscriptionList-Error").
 			Handler(handler).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
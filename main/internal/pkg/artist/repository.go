@@ -1,13 +1,20 @@
 package artist
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type Repository interface {
-	GetArtist(id string) (models.Artist, error)
-	GetBoundedArtists(start, end uint64) ([]models.Artist, error)
-	GetArtistStat(id string) (models.ArtistStat, error)
-	Search(text string, count uint) ([]models.ArtistSearch, error)
-	IsSubscribed(uID string, aID string) bool
-	Subscription(aID string, uID string) error
-	SubscriptionsList(uID string) ([]models.ArtistSearch, error)
+	GetArtist(ctx context.Context, id string) (models.Artist, error)
+	GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error)
+	GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error)
+	GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error)
+	Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error)
+	IsSubscribed(ctx context.Context, uID string, aID string) bool
+	Subscription(ctx context.Context, aID string, uID string) error
+	SubscriptionsList(ctx context.Context, uID string) ([]models.ArtistSearch, error)
+	CreateArtist(ctx context.Context, artist models.Artist) (string, error)
+	UpdateArtist(ctx context.Context, artist models.Artist) error
+	DeleteArtist(ctx context.Context, id string) error
 }
@@ -1,17 +1,23 @@
 package repository
 
 import (
+	"context"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/jinzhu/gorm"
 	"strconv"
+	"time"
 )
 
+// Artists is a row of the artists table, with DeletedAt gorm skips soft-deleted artists in every read
 type Artists struct {
-	Id    uint64 `gorm:"column:id"`
-	Name  string `gorm:"column:name"`
-	Image string `gorm:"column:image"`
-	Genre string `gorm:"column:genre"`
+	Id        uint64     `gorm:"column:id"`
+	Name      string     `gorm:"column:name"`
+	Image     string     `gorm:"column:image"`
+	Genre     string     `gorm:"column:genre"`
+	DeletedAt *time.Time `gorm:"column:deleted_at"`
 }
 
 type LikedArtists struct {
@@ -46,10 +52,10 @@ func toModel(artist Artists) models.Artist {
 	}
 }
 
-func (ar *DbArtistRepository) GetArtist(id string) (models.Artist, error) {
+func (ar *DbArtistRepository) GetArtist(ctx context.Context, id string) (models.Artist, error) {
 	var dbArtist Artists
 
-	db := ar.db.Where("id = ?", id).Find(&dbArtist)
+	db := database.WithContext(ctx, ar.db).Where("id = ?", id).Find(&dbArtist)
 	err := db.Error
 	if err != nil {
 		return models.Artist{}, err
@@ -57,11 +63,11 @@ func (ar *DbArtistRepository) GetArtist(id string) (models.Artist, error) {
 	return toModel(dbArtist), nil
 }
 
-func (ar *DbArtistRepository) GetBoundedArtists(start, end uint64) ([]models.Artist, error) {
+func (ar *DbArtistRepository) GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error) {
 	var artists []Artists
 	limit := end - start
 
-	db := ar.db.Order("name").Limit(limit).Offset(start).Find(&artists)
+	db := database.WithContext(ctx, ar.db).Order("name").Limit(limit).Offset(start).Find(&artists)
 	err := db.Error
 	if err != nil {
 		return []models.Artist{}, err
@@ -74,10 +80,33 @@ func (ar *DbArtistRepository) GetBoundedArtists(start, end uint64) ([]models.Art
 	return modArtists, nil
 }
 
-func (ar *DbArtistRepository) GetArtistStat(id string) (models.ArtistStat, error) {
+// GetBoundedArtistsByGenre includes artists of all subgenres
+func (ar *DbArtistRepository) GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error) {
+	var artists []Artists
+	limit := end - start
+
+	db := database.WithContext(ctx, ar.db).
+		Where("id IN (SELECT artist_id FROM genre_artists WHERE genre_id = ?)", gID).
+		Order("name").
+		Limit(limit).
+		Offset(start).
+		Find(&artists)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get genre artists: %w", err)
+	}
+
+	modArtists := make([]models.Artist, len(artists))
+	for i, elem := range artists {
+		modArtists[i] = toModel(elem)
+	}
+	return modArtists, nil
+}
+
+func (ar *DbArtistRepository) GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error) {
 	var stat models.ArtistStat
 
-	db := ar.db.Table("artist_stat").Where("artist_id = ?", id).Find(&stat)
+	db := database.WithContext(ctx, ar.db).Table("artist_stat").Where("artist_id = ?", id).Find(&stat)
 	err := db.Error
 	if err != nil {
 		return models.ArtistStat{}, err
@@ -85,17 +114,17 @@ func (ar *DbArtistRepository) GetArtistStat(id string) (models.ArtistStat, error
 	return stat, nil
 }
 
-func (ar *DbArtistRepository) Search(text string, count uint) ([]models.ArtistSearch, error) {
+func (ar *DbArtistRepository) Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error) {
 	var artists []Artists
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Table("artists").
 		Where("name ILIKE ?", "%"+text+"%").
 		Limit(count).
 		Find(&artists)
 
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to search artists: %v", err)
+		return nil, fmt.Errorf("failed to search artists: %w", err)
 	}
 
 	artistSearch := make([]models.ArtistSearch, len(artists))
@@ -105,7 +134,7 @@ func (ar *DbArtistRepository) Search(text string, count uint) ([]models.ArtistSe
 	return artistSearch, nil
 }
 
-func (ar *DbArtistRepository) IsSubscribed(aID string, uID string) bool {
+func (ar *DbArtistRepository) IsSubscribed(ctx context.Context, aID string, uID string) bool {
 	var likedArtists LikedArtists
 
 	artistID, err1 := strconv.ParseUint(aID, 10, 64)
@@ -114,14 +143,14 @@ func (ar *DbArtistRepository) IsSubscribed(aID string, uID string) bool {
 		return false
 	}
 
-	db := ar.db.Where("user_id = ? and artist_id = ?", userID, artistID).Find(&likedArtists)
+	db := database.WithContext(ctx, ar.db).Where("user_id = ? and artist_id = ?", userID, artistID).Find(&likedArtists)
 	if err := db.Error; err != nil {
 		return false
 	}
 	return true
 }
 
-func (ar *DbArtistRepository) Subscription(aID string, uID string) error {
+func (ar *DbArtistRepository) Subscription(ctx context.Context, aID string, uID string) error {
 	var likedArtists LikedArtists
 
 	artistID, err1 := strconv.ParseInt(aID, 10, 64)
@@ -133,36 +162,104 @@ func (ar *DbArtistRepository) Subscription(aID string, uID string) error {
 	likedArtists.ArtistID = artistID
 	likedArtists.UserID = userID
 
-	db := ar.db.Table("liked_artists").Where("user_id = ? and artist_id = ?", userID, artistID).Find(&likedArtists)
+	db := database.WithContext(ctx, ar.db).Table("liked_artists").Where("user_id = ? and artist_id = ?", userID, artistID).Find(&likedArtists)
 	switch db.Error {
 	case gorm.ErrRecordNotFound:
-		db := ar.db.Exec("insert into liked_artists (artist_id, user_id) values (?, ?)", artistID, userID)
+		db := database.WithContext(ctx, ar.db).Exec("insert into liked_artists (artist_id, user_id) values (?, ?)", artistID, userID)
 		if err := db.Error; err != nil {
-			return fmt.Errorf("failed to insert in liked_artists: %v", err)
+			return fmt.Errorf("failed to insert in liked_artists: %w", err)
 		}
 	case nil:
-		db := ar.db.Table("liked_artists").Where("user_id = ? and artist_id = ?", userID, artistID).Delete(&likedArtists)
+		db := database.WithContext(ctx, ar.db).Table("liked_artists").Where("user_id = ? and artist_id = ?", userID, artistID).Delete(&likedArtists)
 		if err := db.Error; err != nil {
-			return fmt.Errorf("failed to delete in liked_artists: %v", err)
+			return fmt.Errorf("failed to delete in liked_artists: %w", err)
 		}
 	default:
-		return fmt.Errorf("failed to check liked_artists: %v", db.Error)
+		return fmt.Errorf("failed to check liked_artists: %w", db.Error)
 	}
 
 	return nil
 }
 
-func (ar *DbArtistRepository) SubscriptionsList(uID string) ([]models.ArtistSearch, error) {
+func (ar *DbArtistRepository) SubscriptionsList(ctx context.Context, uID string) ([]models.ArtistSearch, error) {
 	var artists []models.ArtistSearch
 
-	db := ar.db.
+	db := database.WithContext(ctx, ar.db).
 		Table("sub_artists").
 		Where("user_id = ?", uID).
 		Find(&artists)
 
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to get subscribed artists: %v", err)
+		return nil, fmt.Errorf("failed to get subscribed artists: %w", err)
 	}
 
 	return artists, nil
 }
+
+func (ar *DbArtistRepository) CreateArtist(ctx context.Context, artist models.Artist) (string, error) {
+	dbArtist := Artists{
+		Name:  artist.Name,
+		Image: artist.Image,
+		Genre: artist.Genre,
+	}
+
+	db := database.WithContext(ctx, ar.db)
+	if dbArtist.Image == "" {
+		db = db.Omit("image")
+	}
+	if err := db.Create(&dbArtist).Error; err != nil {
+		return "", fmt.Errorf("failed to create artist: %w", err)
+	}
+	return strconv.FormatUint(dbArtist.Id, 10), nil
+}
+
+func (ar *DbArtistRepository) UpdateArtist(ctx context.Context, artist models.Artist) error {
+	db := database.WithContext(ctx, ar.db).Exec("update artists set name = ?, image = coalesce(nullif(?, ''), image), genre = ? "+
+		"where id = ? and deleted_at is null", artist.Name, artist.Image, artist.Genre, artist.Id)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to update artist: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "artist not found")
+	}
+	return nil
+}
+
+func (ar *DbArtistRepository) DeleteArtist(ctx context.Context, id string) error {
+	tx := database.WithContext(ctx, ar.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	db := tx.Exec("update artists set deleted_at = now() where id = ? and deleted_at is null", id)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete artist: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.NotFound, "artist not found")
+	}
+
+	// the artist is only soft-deleted, so the foreign key of users.artist_id never restricts it,
+	// an artist account would be left pointing at an artist that is gone
+	db = tx.Exec("select id from users where artist_id = ? limit 1", id)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to check artist accounts: %w", err)
+	}
+	if db.RowsAffected > 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.Conflict, "artist is linked to an artist account")
+	}
+
+	for _, table := range []string{"albums", "tracks"} {
+		db = tx.Exec("update "+table+" set deleted_at = now() where artist_id = ? and deleted_at is null", id)
+		if err := db.Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete artist %s: %w", table, err)
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-test/deep"
@@ -70,12 +72,12 @@ func TestInit(t *testing.T) {
 func (s *Suite) TestGetArtist() {
 	testArtist := s.artists[0]
 
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" WHERE (id = $1)`)).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" WHERE "artists"."deleted_at" IS NULL AND ((id = $1))`)).
 		WithArgs(testArtist.Id).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "genre"}).
 			AddRow(testArtist.Id, testArtist.Name, testArtist.Image, testArtist.Genre))
 
-	res, err := s.repository.GetArtist(testArtist.Id)
+	res, err := s.repository.GetArtist(context.Background(), testArtist.Id)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(models.Artist{
@@ -90,7 +92,7 @@ func (s *Suite) TestGetArtist() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(testArtist.Id).WillReturnError(dbError)
 
-	_, err = s.repository.GetArtist(testArtist.Id)
+	_, err = s.repository.GetArtist(context.Background(), testArtist.Id)
 
 	require.Error(s.T(), err)
 }
@@ -99,12 +101,12 @@ func (s *Suite) TestGetBoundedArtists() {
 	testArtist := s.artists[0]
 	testArtist2 := s.artists[1]
 
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" ORDER BY "name" LIMIT 5 OFFSET 0`)).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" WHERE "artists"."deleted_at" IS NULL ORDER BY "name" LIMIT 5 OFFSET 0`)).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "genre"}).
 			AddRow(testArtist.Id, testArtist.Name, testArtist.Image, testArtist.Genre).
 			AddRow(testArtist2.Id, testArtist2.Name, testArtist2.Image, testArtist2.Genre))
 
-	res, err := s.repository.GetBoundedArtists(0, 5)
+	res, err := s.repository.GetBoundedArtists(context.Background(), 0, 5)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(s.artists[0:2], res))
@@ -114,7 +116,7 @@ func (s *Suite) TestGetBoundedArtists() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.GetBoundedArtists(0, 5)
+	_, err = s.repository.GetBoundedArtists(context.Background(), 0, 5)
 
 	require.Error(s.T(), err)
 }
@@ -129,12 +131,12 @@ func (s *Suite) TestSearch() {
 		},
 	}
 
-	s.mock.ExpectQuery(`SELECT * `).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" WHERE "artists"."deleted_at" IS NULL AND ((name ILIKE $1)) LIMIT 5`)).
 		WithArgs("%" + testArtist[0].Name + "%").
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image"}).
 			AddRow(testArtist[0].ArtistID, testArtist[0].Name, testArtist[0].Image))
 
-	res, err := s.repository.Search(testArtist[0].Name, 5)
+	res, err := s.repository.Search(context.Background(), testArtist[0].Name, 5)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(testArtist, res))
@@ -144,7 +146,7 @@ func (s *Suite) TestSearch() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.Search(testArtist[0].Name, 5)
+	_, err = s.repository.Search(context.Background(), testArtist[0].Name, 5)
 
 	require.Error(s.T(), err)
 }
@@ -165,7 +167,7 @@ func (s *Suite) TestGetArtistStat() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "tracks", "albums", "subscribers"}).
 			AddRow(stat.ArtistId, stat.Tracks, stat.Albums, stat.Subscribers))
 
-	res, err := s.repository.GetArtistStat(stat.ArtistId)
+	res, err := s.repository.GetArtistStat(context.Background(), stat.ArtistId)
 
 	stat.ArtistId = ""
 
@@ -177,7 +179,7 @@ func (s *Suite) TestGetArtistStat() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.GetArtistStat(stat.ArtistId)
+	_, err = s.repository.GetArtistStat(context.Background(), stat.ArtistId)
 
 	require.Error(s.T(), err)
 }
@@ -192,7 +194,7 @@ func (s *Suite) TestIsSubscribed() {
 		WillReturnRows(sqlmock.NewRows([]string{"artist_id", "user_id"}).
 			AddRow(aID, uID))
 
-	res := s.repository.IsSubscribed(fmt.Sprint(aID), fmt.Sprint(uID))
+	res := s.repository.IsSubscribed(context.Background(), fmt.Sprint(aID), fmt.Sprint(uID))
 
 	require.Equal(s.T(), true, res)
 
@@ -201,7 +203,7 @@ func (s *Suite) TestIsSubscribed() {
 		WithArgs(uID, aID).
 		WillReturnError(gorm.ErrRecordNotFound)
 
-	res = s.repository.IsSubscribed(fmt.Sprint(aID), fmt.Sprint(uID))
+	res = s.repository.IsSubscribed(context.Background(), fmt.Sprint(aID), fmt.Sprint(uID))
 
 	require.Equal(s.T(), false, res)
 }
@@ -223,7 +225,7 @@ func (s *Suite) TestSubscriptionsList() {
 		WillReturnRows(sqlmock.NewRows([]string{"artist_id", "name", "image"}).
 			AddRow(testArtist[0].ArtistID, testArtist[0].Name, testArtist[0].Image))
 
-	res, err := s.repository.SubscriptionsList(fmt.Sprint(uID))
+	res, err := s.repository.SubscriptionsList(context.Background(), fmt.Sprint(uID))
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(testArtist, res))
@@ -235,7 +237,147 @@ func (s *Suite) TestSubscriptionsList() {
 		WithArgs(uID).
 		WillReturnError(dbError)
 
-	_, err = s.repository.SubscriptionsList(fmt.Sprint(uID))
+	_, err = s.repository.SubscriptionsList(context.Background(), fmt.Sprint(uID))
+
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestCreateArtist() {
+	artist := s.artists[0]
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "artists"`).
+		WithArgs(artist.Name, artist.Image, artist.Genre, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artist.Id))
+	s.mock.ExpectCommit()
+
+	id, err := s.repository.CreateArtist(context.Background(), artist)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), artist.Id, id)
+
+	//test on default image
+	artist.Image = ""
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "artists" ("name","genre","deleted_at")`)).
+		WithArgs(artist.Name, artist.Genre, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artist.Id))
+	s.mock.ExpectCommit()
+
+	_, err = s.repository.CreateArtist(context.Background(), artist)
+	require.NoError(s.T(), err)
+
+	//test on db error
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "artists"`).
+		WillReturnError(errors.New("db_error"))
+	s.mock.ExpectRollback()
+
+	_, err = s.repository.CreateArtist(context.Background(), artist)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestUpdateArtist() {
+	artist := s.artists[1]
+
+	s.mock.ExpectExec("update artists set name").
+		WithArgs(artist.Name, artist.Image, artist.Genre, artist.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.UpdateArtist(context.Background(), artist))
+
+	//test on not found
+	s.mock.ExpectExec("update artists set name").
+		WithArgs(artist.Name, artist.Image, artist.Genre, artist.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.UpdateArtist(context.Background(), artist))
+
+	//test on db error
+	s.mock.ExpectExec("update artists set name").
+		WithArgs(artist.Name, artist.Image, artist.Genre, artist.Id).
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.UpdateArtist(context.Background(), artist))
+}
+
+func (s *Suite) TestDeleteArtist() {
+	id := s.artists[2].Id
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update artists set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("select id from users where artist_id = $1 limit 1")).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("update albums set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.mock.ExpectExec("update tracks set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 20))
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.DeleteArtist(context.Background(), id))
+
+	//test on already deleted
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update artists set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.DeleteArtist(context.Background(), id))
+
+	//test on artist linked to an artist account
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update artists set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("select id from users").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectRollback()
+
+	require.True(s.T(), apperrors.Is(s.repository.DeleteArtist(context.Background(), id), apperrors.Conflict))
+
+	//test on db error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update artists set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("select id from users").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("update albums set deleted_at").
+		WithArgs(id).
+		WillReturnError(errors.New("db_error"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.DeleteArtist(context.Background(), id))
+}
+
+func (s *Suite) TestGetBoundedArtistsByGenre() {
+	testArtist := s.artists[0]
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "artists" WHERE "artists"."deleted_at" IS NULL AND ` +
+		`((id IN (SELECT artist_id FROM genre_artists WHERE genre_id = $1))) ORDER BY "name" LIMIT 5 OFFSET 0`)).
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "genre"}).
+			AddRow(testArtist.Id, testArtist.Name, testArtist.Image, testArtist.Genre))
+
+	res, err := s.repository.GetBoundedArtistsByGenre(context.Background(), "3", 0, 5)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(s.artists[0:1], res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs("3").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetBoundedArtistsByGenre(context.Background(), "3", 0, 5)
 
 	require.Error(s.T(), err)
 }
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+// RedisArtistRepository caches catalog reads of the wrapped repository, reads of user data,
+// stats and search pass through. Writes invalidate the whole catalog cache
+type RedisArtistRepository struct {
+	artist.Repository
+	cache *cache.Cache
+	log   *logger.MainLogger
+}
+
+func NewRedisArtistRepository(repository artist.Repository, cache *cache.Cache, log *logger.MainLogger) RedisArtistRepository {
+	return RedisArtistRepository{
+		Repository: repository,
+		cache:      cache,
+		log:        log,
+	}
+}
+
+func (ar *RedisArtistRepository) GetArtist(ctx context.Context, id string) (models.Artist, error) {
+	var result models.Artist
+	err := ar.cache.Get(ctx, "artist:"+id, &result, func() (interface{}, error) {
+		return ar.Repository.GetArtist(ctx, id)
+	})
+	return result, err
+}
+
+func (ar *RedisArtistRepository) GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error) {
+	var result []models.Artist
+	err := ar.cache.Get(ctx, fmt.Sprintf("artists:%d:%d", start, end), &result, func() (interface{}, error) {
+		return ar.Repository.GetBoundedArtists(ctx, start, end)
+	})
+	return result, err
+}
+
+func (ar *RedisArtistRepository) GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error) {
+	var result []models.Artist
+	err := ar.cache.Get(ctx, fmt.Sprintf("genre:%s:%d:%d", gID, start, end), &result, func() (interface{}, error) {
+		return ar.Repository.GetBoundedArtistsByGenre(ctx, gID, start, end)
+	})
+	return result, err
+}
+
+func (ar *RedisArtistRepository) CreateArtist(ctx context.Context, artist models.Artist) (string, error) {
+	id, err := ar.Repository.CreateArtist(ctx, artist)
+	if err != nil {
+		return "", err
+	}
+	ar.invalidate(ctx)
+	return id, nil
+}
+
+func (ar *RedisArtistRepository) UpdateArtist(ctx context.Context, artist models.Artist) error {
+	if err := ar.Repository.UpdateArtist(ctx, artist); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+func (ar *RedisArtistRepository) DeleteArtist(ctx context.Context, id string) error {
+	if err := ar.Repository.DeleteArtist(ctx, id); err != nil {
+		return err
+	}
+	ar.invalidate(ctx)
+	return nil
+}
+
+// invalidate never fails the write, it is committed already. The stale entries expire after ttl
+func (ar *RedisArtistRepository) invalidate(ctx context.Context) {
+	if err := ar.cache.Invalidate(ctx); err != nil {
+		ar.log.LogWarning(ctx, "artist repository", "invalidate", "artist is saved, but the cache is stale: "+err.Error())
+	}
+}
@@ -5,6 +5,7 @@
 package artist
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,104 +35,162 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // GetArtist mocks base method
-func (m *MockRepository) GetArtist(id string) (models.Artist, error) {
+func (m *MockRepository) GetArtist(ctx context.Context, id string) (models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArtist", id)
+	ret := m.ctrl.Call(m, "GetArtist", ctx, id)
 	ret0, _ := ret[0].(models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArtist indicates an expected call of GetArtist
-func (mr *MockRepositoryMockRecorder) GetArtist(id interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetArtist(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtist", reflect.TypeOf((*MockRepository)(nil).GetArtist), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtist", reflect.TypeOf((*MockRepository)(nil).GetArtist), ctx, id)
 }
 
 // GetBoundedArtists mocks base method
-func (m *MockRepository) GetBoundedArtists(start, end uint64) ([]models.Artist, error) {
+func (m *MockRepository) GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedArtists", start, end)
+	ret := m.ctrl.Call(m, "GetBoundedArtists", ctx, start, end)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedArtists indicates an expected call of GetBoundedArtists
-func (mr *MockRepositoryMockRecorder) GetBoundedArtists(start, end interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBoundedArtists(ctx, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtists", reflect.TypeOf((*MockRepository)(nil).GetBoundedArtists), start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtists", reflect.TypeOf((*MockRepository)(nil).GetBoundedArtists), ctx, start, end)
+}
+
+// GetBoundedArtistsByGenre mocks base method
+func (m *MockRepository) GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedArtistsByGenre", ctx, gID, start, end)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedArtistsByGenre indicates an expected call of GetBoundedArtistsByGenre
+func (mr *MockRepositoryMockRecorder) GetBoundedArtistsByGenre(ctx, gID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtistsByGenre", reflect.TypeOf((*MockRepository)(nil).GetBoundedArtistsByGenre), ctx, gID, start, end)
 }
 
 // GetArtistStat mocks base method
-func (m *MockRepository) GetArtistStat(id string) (models.ArtistStat, error) {
+func (m *MockRepository) GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArtistStat", id)
+	ret := m.ctrl.Call(m, "GetArtistStat", ctx, id)
 	ret0, _ := ret[0].(models.ArtistStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArtistStat indicates an expected call of GetArtistStat
-func (mr *MockRepositoryMockRecorder) GetArtistStat(id interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetArtistStat(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistStat", reflect.TypeOf((*MockRepository)(nil).GetArtistStat), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistStat", reflect.TypeOf((*MockRepository)(nil).GetArtistStat), ctx, id)
 }
 
 // Search mocks base method
-func (m *MockRepository) Search(text string, count uint) ([]models.ArtistSearch, error) {
+func (m *MockRepository) Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.ArtistSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockRepositoryMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), ctx, text, count)
 }
 
 // IsSubscribed mocks base method
-func (m *MockRepository) IsSubscribed(uID, aID string) bool {
+func (m *MockRepository) IsSubscribed(ctx context.Context, uID, aID string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSubscribed", uID, aID)
+	ret := m.ctrl.Call(m, "IsSubscribed", ctx, uID, aID)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // IsSubscribed indicates an expected call of IsSubscribed
-func (mr *MockRepositoryMockRecorder) IsSubscribed(uID, aID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) IsSubscribed(ctx, uID, aID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubscribed", reflect.TypeOf((*MockRepository)(nil).IsSubscribed), uID, aID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubscribed", reflect.TypeOf((*MockRepository)(nil).IsSubscribed), ctx, uID, aID)
 }
 
 // Subscription mocks base method
-func (m *MockRepository) Subscription(aID, uID string) error {
+func (m *MockRepository) Subscription(ctx context.Context, aID, uID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscription", aID, uID)
+	ret := m.ctrl.Call(m, "Subscription", ctx, aID, uID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Subscription indicates an expected call of Subscription
-func (mr *MockRepositoryMockRecorder) Subscription(aID, uID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Subscription(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockRepository)(nil).Subscription), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockRepository)(nil).Subscription), ctx, aID, uID)
 }
 
 // SubscriptionsList mocks base method
-func (m *MockRepository) SubscriptionsList(uID string) ([]models.ArtistSearch, error) {
+func (m *MockRepository) SubscriptionsList(ctx context.Context, uID string) ([]models.ArtistSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscriptionsList", uID)
+	ret := m.ctrl.Call(m, "SubscriptionsList", ctx, uID)
 	ret0, _ := ret[0].([]models.ArtistSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubscriptionsList indicates an expected call of SubscriptionsList
-func (mr *MockRepositoryMockRecorder) SubscriptionsList(uID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SubscriptionsList(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionsList", reflect.TypeOf((*MockRepository)(nil).SubscriptionsList), ctx, uID)
+}
+
+// CreateArtist mocks base method
+func (m *MockRepository) CreateArtist(ctx context.Context, artist models.Artist) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArtist", ctx, artist)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArtist indicates an expected call of CreateArtist
+func (mr *MockRepositoryMockRecorder) CreateArtist(ctx, artist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArtist", reflect.TypeOf((*MockRepository)(nil).CreateArtist), ctx, artist)
+}
+
+// UpdateArtist mocks base method
+func (m *MockRepository) UpdateArtist(ctx context.Context, artist models.Artist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArtist", ctx, artist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArtist indicates an expected call of UpdateArtist
+func (mr *MockRepositoryMockRecorder) UpdateArtist(ctx, artist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtist", reflect.TypeOf((*MockRepository)(nil).UpdateArtist), ctx, artist)
+}
+
+// DeleteArtist mocks base method
+func (m *MockRepository) DeleteArtist(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArtist indicates an expected call of DeleteArtist
+func (mr *MockRepositoryMockRecorder) DeleteArtist(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionsList", reflect.TypeOf((*MockRepository)(nil).SubscriptionsList), uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockRepository)(nil).DeleteArtist), ctx, id)
 }
@@ -1,12 +1,16 @@
 package artist
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type UseCase interface {
-	GetArtistById(aID, uID string) (models.Artist, error)
-	GetBoundedArtists(start, end uint64) ([]models.Artist, error)
-	GetArtistStat(id string) (models.ArtistStat, error)
-	Search(text string, count uint) ([]models.ArtistSearch, error)
-	Subscription(aID, uID string) error
-	SubscriptionList(uID string) ([]models.ArtistSearch, error)
+	GetArtistById(ctx context.Context, aID, uID string) (models.Artist, error)
+	GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error)
+	GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error)
+	GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error)
+	Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error)
+	Subscription(ctx context.Context, aID, uID string) error
+	SubscriptionList(ctx context.Context, uID string) ([]models.ArtistSearch, error)
 }
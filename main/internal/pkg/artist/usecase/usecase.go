@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 )
@@ -9,35 +10,39 @@ type ArtistUseCase struct {
 	ArtistRepository artist.Repository
 }
 
-func (uc *ArtistUseCase) GetArtistById(aID, uID string) (models.Artist, error) {
-	dbArtist, err := uc.ArtistRepository.GetArtist(aID)
+func (uc *ArtistUseCase) GetArtistById(ctx context.Context, aID, uID string) (models.Artist, error) {
+	dbArtist, err := uc.ArtistRepository.GetArtist(ctx, aID)
 	if err != nil {
 		return models.Artist{}, err
 	}
 
 	if uID != "" {
-		dbArtist.IsSubscribed = uc.ArtistRepository.IsSubscribed(aID, uID)
+		dbArtist.IsSubscribed = uc.ArtistRepository.IsSubscribed(ctx, aID, uID)
 	}
 
 	return dbArtist, nil
 }
 
-func (uc *ArtistUseCase) Subscription(aID, uID string) error {
-	return uc.ArtistRepository.Subscription(aID, uID)
+func (uc *ArtistUseCase) Subscription(ctx context.Context, aID, uID string) error {
+	return uc.ArtistRepository.Subscription(ctx, aID, uID)
 }
 
-func (uc *ArtistUseCase) SubscriptionList(uID string) ([]models.ArtistSearch, error) {
-	return uc.ArtistRepository.SubscriptionsList(uID)
+func (uc *ArtistUseCase) SubscriptionList(ctx context.Context, uID string) ([]models.ArtistSearch, error) {
+	return uc.ArtistRepository.SubscriptionsList(ctx, uID)
 }
 
-func (uc *ArtistUseCase) GetBoundedArtists(start, end uint64) ([]models.Artist, error) {
-	return uc.ArtistRepository.GetBoundedArtists(start, end)
+func (uc *ArtistUseCase) GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error) {
+	return uc.ArtistRepository.GetBoundedArtists(ctx, start, end)
 }
 
-func (uc *ArtistUseCase) GetArtistStat(id string) (models.ArtistStat, error) {
-	return uc.ArtistRepository.GetArtistStat(id)
+func (uc *ArtistUseCase) GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error) {
+	return uc.ArtistRepository.GetBoundedArtistsByGenre(ctx, gID, start, end)
 }
 
-func (uc *ArtistUseCase) Search(text string, count uint) ([]models.ArtistSearch, error) {
-	return uc.ArtistRepository.Search(text, count)
+func (uc *ArtistUseCase) GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error) {
+	return uc.ArtistRepository.GetArtistStat(ctx, id)
+}
+
+func (uc *ArtistUseCase) Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error) {
+	return uc.ArtistRepository.Search(ctx, text, count)
 }
@@ -5,6 +5,7 @@
 package artist
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,90 +35,105 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // GetArtistById mocks base method
-func (m *MockUseCase) GetArtistById(aID, uID string) (models.Artist, error) {
+func (m *MockUseCase) GetArtistById(ctx context.Context, aID, uID string) (models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArtistById", aID, uID)
+	ret := m.ctrl.Call(m, "GetArtistById", ctx, aID, uID)
 	ret0, _ := ret[0].(models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArtistById indicates an expected call of GetArtistById
-func (mr *MockUseCaseMockRecorder) GetArtistById(aID, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetArtistById(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistById", reflect.TypeOf((*MockUseCase)(nil).GetArtistById), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistById", reflect.TypeOf((*MockUseCase)(nil).GetArtistById), ctx, aID, uID)
 }
 
 // GetBoundedArtists mocks base method
-func (m *MockUseCase) GetBoundedArtists(start, end uint64) ([]models.Artist, error) {
+func (m *MockUseCase) GetBoundedArtists(ctx context.Context, start, end uint64) ([]models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedArtists", start, end)
+	ret := m.ctrl.Call(m, "GetBoundedArtists", ctx, start, end)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedArtists indicates an expected call of GetBoundedArtists
-func (mr *MockUseCaseMockRecorder) GetBoundedArtists(start, end interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetBoundedArtists(ctx, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtists", reflect.TypeOf((*MockUseCase)(nil).GetBoundedArtists), start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtists", reflect.TypeOf((*MockUseCase)(nil).GetBoundedArtists), ctx, start, end)
+}
+
+// GetBoundedArtistsByGenre mocks base method
+func (m *MockUseCase) GetBoundedArtistsByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedArtistsByGenre", ctx, gID, start, end)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedArtistsByGenre indicates an expected call of GetBoundedArtistsByGenre
+func (mr *MockUseCaseMockRecorder) GetBoundedArtistsByGenre(ctx, gID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedArtistsByGenre", reflect.TypeOf((*MockUseCase)(nil).GetBoundedArtistsByGenre), ctx, gID, start, end)
 }
 
 // GetArtistStat mocks base method
-func (m *MockUseCase) GetArtistStat(id string) (models.ArtistStat, error) {
+func (m *MockUseCase) GetArtistStat(ctx context.Context, id string) (models.ArtistStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArtistStat", id)
+	ret := m.ctrl.Call(m, "GetArtistStat", ctx, id)
 	ret0, _ := ret[0].(models.ArtistStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArtistStat indicates an expected call of GetArtistStat
-func (mr *MockUseCaseMockRecorder) GetArtistStat(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetArtistStat(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistStat", reflect.TypeOf((*MockUseCase)(nil).GetArtistStat), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistStat", reflect.TypeOf((*MockUseCase)(nil).GetArtistStat), ctx, id)
 }
 
 // Search mocks base method
-func (m *MockUseCase) Search(text string, count uint) ([]models.ArtistSearch, error) {
+func (m *MockUseCase) Search(ctx context.Context, text string, count uint) ([]models.ArtistSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.ArtistSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockUseCaseMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), ctx, text, count)
 }
 
 // Subscription mocks base method
-func (m *MockUseCase) Subscription(aID, uID string) error {
+func (m *MockUseCase) Subscription(ctx context.Context, aID, uID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscription", aID, uID)
+	ret := m.ctrl.Call(m, "Subscription", ctx, aID, uID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Subscription indicates an expected call of Subscription
-func (mr *MockUseCaseMockRecorder) Subscription(aID, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Subscription(ctx, aID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockUseCase)(nil).Subscription), aID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockUseCase)(nil).Subscription), ctx, aID, uID)
 }
 
 // SubscriptionList mocks base method
-func (m *MockUseCase) SubscriptionList(uID string) ([]models.ArtistSearch, error) {
+func (m *MockUseCase) SubscriptionList(ctx context.Context, uID string) ([]models.ArtistSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscriptionList", uID)
+	ret := m.ctrl.Call(m, "SubscriptionList", ctx, uID)
 	ret0, _ := ret[0].([]models.ArtistSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubscriptionList indicates an expected call of SubscriptionList
-func (mr *MockUseCaseMockRecorder) SubscriptionList(uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) SubscriptionList(ctx, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionList", reflect.TypeOf((*MockUseCase)(nil).SubscriptionList), uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionList", reflect.TypeOf((*MockUseCase)(nil).SubscriptionList), ctx, uID)
 }
@@ -0,0 +1,10 @@
+package attempts
+
+import "context"
+
+type Repository interface {
+	AddFail(ctx context.Context, key string, window int64) (int64, error)
+	Lock(ctx context.Context, key string, duration int64) error
+	LockTTL(ctx context.Context, key string) (int64, error)
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+)
+
+type AttemptsManager struct {
+	redisPool *redis.Pool
+}
+
+func NewRedisAttemptsManager(conn *redis.Pool) AttemptsManager {
+	return AttemptsManager{
+		redisPool: conn,
+	}
+}
+
+func failsKey(key string) string {
+	return "attempts:" + key
+}
+
+func lockKey(key string) string {
+	return "lockout:" + key
+}
+
+func (am *AttemptsManager) AddFail(ctx context.Context, key string, window int64) (int64, error) {
+	conn, err := tracing.RedisConn(ctx, am.redisPool)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	count, err := redis.Int64(conn.Do("INCR", failsKey(key)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment attempts: %w", err)
+	}
+	if _, err := conn.Do("EXPIRE", failsKey(key), window); err != nil {
+		return 0, fmt.Errorf("failed to set attempts expire: %w", err)
+	}
+	return count, nil
+}
+
+func (am *AttemptsManager) Lock(ctx context.Context, key string, duration int64) error {
+	conn, err := tracing.RedisConn(ctx, am.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	result, err := redis.String(conn.Do("SET", lockKey(key), 1, "EX", duration))
+	if err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	if result != "OK" {
+		return errors.New("result not OK")
+	}
+	return nil
+}
+
+func (am *AttemptsManager) LockTTL(ctx context.Context, key string) (int64, error) {
+	conn, err := tracing.RedisConn(ctx, am.redisPool)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	ttl, err := redis.Int64(conn.Do("TTL", lockKey(key)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get lock ttl: %w", err)
+	}
+	// -2 means no lock, -1 means lock without expire which we never set
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (am *AttemptsManager) Reset(ctx context.Context, key string) error {
+	conn, err := tracing.RedisConn(ctx, am.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", failsKey(key)); err != nil {
+		return fmt.Errorf("failed to reset attempts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type Suite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	attempts    AttemptsManager
+}
+
+func (s *Suite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	redisConn := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	s.attempts = NewRedisAttemptsManager(redisConn)
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *Suite) AfterTest(_, _ string) {
+	s.SetupSuite()
+}
+
+func (s *Suite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestAttempts(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestAddFail() {
+	key := "login:test"
+
+	count, err := s.attempts.AddFail(context.Background(), key, 60)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(1), count)
+
+	count, err = s.attempts.AddFail(context.Background(), key, 60)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), count)
+
+	//test on window expire
+	s.redisServer.FastForward(time.Second * 61)
+
+	count, err = s.attempts.AddFail(context.Background(), key, 60)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(1), count)
+
+	//test on reset
+	require.NoError(s.T(), s.attempts.Reset(context.Background(), key))
+	require.False(s.T(), s.redisServer.Exists(failsKey(key)))
+
+	//test on closed connection
+	s.redisServer.Close()
+
+	_, err = s.attempts.AddFail(context.Background(), key, 60)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestLock() {
+	key := "ip:127.0.0.1"
+
+	ttl, err := s.attempts.LockTTL(context.Background(), key)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(0), ttl)
+
+	require.NoError(s.T(), s.attempts.Lock(context.Background(), key, 30))
+
+	ttl, err = s.attempts.LockTTL(context.Background(), key)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30), ttl)
+
+	//test on lock expire
+	s.redisServer.FastForward(time.Second * 31)
+
+	ttl, err = s.attempts.LockTTL(context.Background(), key)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(0), ttl)
+
+	//test on closed connection
+	s.redisServer.Close()
+
+	require.Error(s.T(), s.attempts.Lock(context.Background(), key, 30))
+	_, err = s.attempts.LockTTL(context.Background(), key)
+	require.Error(s.T(), err)
+}
@@ -0,0 +1,9 @@
+package attempts
+
+import "context"
+
+type UseCase interface {
+	Check(ctx context.Context, login string, ip string) (retryAfter int64, err error)
+	Fail(ctx context.Context, login string, ip string, reason string) (retryAfter int64, err error)
+	Reset(ctx context.Context, login string) error
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts"
+	"github.com/prometheus/client_golang/prometheus"
+	"strings"
+)
+
+const (
+	loginScope = "login"
+	ipScope    = "ip"
+)
+
+var (
+	failedLogins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "failed_logins",
+		Help: "Failed login attempts by reason",
+	}, []string{"reason"})
+
+	lockouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "login_lockouts",
+		Help: "Temporary login lockouts by scope",
+	}, []string{"scope"})
+)
+
+func init() {
+	prometheus.MustRegister(failedLogins, lockouts)
+}
+
+type Limits struct {
+	Window      int64
+	FreeByLogin int64
+	FreeByIP    int64
+	BaseLock    int64
+	MaxLock     int64
+}
+
+type AttemptsUseCase struct {
+	Repository attempts.Repository
+	Limits     Limits
+}
+
+func NewAttemptsUseCase(repository attempts.Repository, limits Limits) AttemptsUseCase {
+	return AttemptsUseCase{
+		Repository: repository,
+		Limits:     limits,
+	}
+}
+
+func scopeKey(scope string, value string) string {
+	return scope + ":" + value
+}
+
+// loginKey makes attempts with the typed login and resets with the stored one count towards the same key
+func loginKey(login string) string {
+	return scopeKey(loginScope, strings.ToLower(strings.TrimSpace(login)))
+}
+
+func (uc *AttemptsUseCase) Check(ctx context.Context, login string, ip string) (int64, error) {
+	var retryAfter int64
+
+	for _, key := range []string{loginKey(login), scopeKey(ipScope, ip)} {
+		ttl, err := uc.Repository.LockTTL(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		if ttl > retryAfter {
+			retryAfter = ttl
+		}
+	}
+	return retryAfter, nil
+}
+
+func (uc *AttemptsUseCase) Fail(ctx context.Context, login string, ip string, reason string) (int64, error) {
+	failedLogins.WithLabelValues(reason).Inc()
+
+	loginLock, err := uc.fail(ctx, loginScope, loginKey(login), uc.Limits.FreeByLogin)
+	if err != nil {
+		return 0, err
+	}
+	ipLock, err := uc.fail(ctx, ipScope, scopeKey(ipScope, ip), uc.Limits.FreeByIP)
+	if err != nil {
+		return 0, err
+	}
+
+	if ipLock > loginLock {
+		return ipLock, nil
+	}
+	return loginLock, nil
+}
+
+func (uc *AttemptsUseCase) Reset(ctx context.Context, login string) error {
+	return uc.Repository.Reset(ctx, loginKey(login))
+}
+
+func (uc *AttemptsUseCase) fail(ctx context.Context, scope string, key string, free int64) (int64, error) {
+	count, err := uc.Repository.AddFail(ctx, key, uc.Limits.Window)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %s attempt: %w", scope, err)
+	}
+	if count <= free {
+		return 0, nil
+	}
+
+	duration := uc.lockDuration(count - free)
+	if err := uc.Repository.Lock(ctx, key, duration); err != nil {
+		return 0, fmt.Errorf("failed to lock %s: %w", scope, err)
+	}
+	lockouts.WithLabelValues(scope).Inc()
+
+	return duration, nil
+}
+
+// lockDuration doubles the lockout for every attempt over the free limit
+func (uc *AttemptsUseCase) lockDuration(over int64) int64 {
+	duration := uc.Limits.BaseLock
+	for i := int64(1); i < over; i++ {
+		duration *= 2
+		if duration >= uc.Limits.MaxLock {
+			return uc.Limits.MaxLock
+		}
+	}
+	if duration > uc.Limits.MaxLock {
+		return uc.Limits.MaxLock
+	}
+	return duration
+}
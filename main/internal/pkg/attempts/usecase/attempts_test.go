@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testLimits = Limits{
+	Window:      3600,
+	FreeByLogin: 3,
+	FreeByIP:    10,
+	BaseLock:    30,
+	MaxLock:     200,
+}
+
+const (
+	testLogin = "nnnagibator"
+	testIP    = "127.0.0.1"
+)
+
+func TestCheck(t *testing.T) {
+	t.Run("Check-NotLocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().LockTTL(gomock.Any(), "login:"+testLogin).Return(int64(0), nil)
+		m.EXPECT().LockTTL(gomock.Any(), "ip:"+testIP).Return(int64(0), nil)
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		retryAfter, err := useCase.Check(context.Background(), testLogin, testIP)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), retryAfter)
+	})
+
+	t.Run("Check-Locked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().LockTTL(gomock.Any(), "login:"+testLogin).Return(int64(10), nil)
+		m.EXPECT().LockTTL(gomock.Any(), "ip:"+testIP).Return(int64(45), nil)
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		retryAfter, err := useCase.Check(context.Background(), testLogin, testIP)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(45), retryAfter)
+	})
+
+	t.Run("Check-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().LockTTL(gomock.Any(), "login:"+testLogin).Return(int64(0), errors.New("test error"))
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		_, err := useCase.Check(context.Background(), testLogin, testIP)
+		assert.Error(t, err)
+	})
+}
+
+func TestFail(t *testing.T) {
+	t.Run("Fail-UnderLimit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().AddFail(gomock.Any(), "login:"+testLogin, testLimits.Window).Return(int64(2), nil)
+		m.EXPECT().AddFail(gomock.Any(), "ip:"+testIP, testLimits.Window).Return(int64(2), nil)
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		retryAfter, err := useCase.Fail(context.Background(), testLogin, testIP, "password")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), retryAfter)
+	})
+
+	t.Run("Fail-LoginLocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().AddFail(gomock.Any(), "login:"+testLogin, testLimits.Window).Return(int64(6), nil)
+		m.EXPECT().Lock(gomock.Any(), "login:"+testLogin, int64(120)).Return(nil)
+		m.EXPECT().AddFail(gomock.Any(), "ip:"+testIP, testLimits.Window).Return(int64(5), nil)
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		retryAfter, err := useCase.Fail(context.Background(), testLogin, testIP, "password")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(120), retryAfter)
+	})
+
+	t.Run("Fail-IPLocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().AddFail(gomock.Any(), "login:"+testLogin, testLimits.Window).Return(int64(1), nil)
+		m.EXPECT().AddFail(gomock.Any(), "ip:"+testIP, testLimits.Window).Return(int64(11), nil)
+		m.EXPECT().Lock(gomock.Any(), "ip:"+testIP, int64(30)).Return(nil)
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		retryAfter, err := useCase.Fail(context.Background(), testLogin, testIP, "two_factor")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(30), retryAfter)
+	})
+
+	t.Run("Fail-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := attempts.NewMockRepository(ctrl)
+		m.EXPECT().AddFail(gomock.Any(), "login:"+testLogin, testLimits.Window).Return(int64(0), errors.New("test error"))
+
+		useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+		_, err := useCase.Fail(context.Background(), testLogin, testIP, "password")
+		assert.Error(t, err)
+	})
+}
+
+func TestLockDuration(t *testing.T) {
+	useCase := AttemptsUseCase{Limits: testLimits}
+
+	assert.Equal(t, int64(30), useCase.lockDuration(1))
+	assert.Equal(t, int64(60), useCase.lockDuration(2))
+	assert.Equal(t, int64(120), useCase.lockDuration(3))
+	assert.Equal(t, int64(200), useCase.lockDuration(4))
+	assert.Equal(t, int64(200), useCase.lockDuration(100))
+}
+
+func TestReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := attempts.NewMockRepository(ctrl)
+	m.EXPECT().Reset(gomock.Any(), "login:"+testLogin).Return(nil)
+
+	useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+	assert.NoError(t, useCase.Reset(context.Background(), testLogin))
+}
+
+func TestLoginKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := attempts.NewMockRepository(ctrl)
+	m.EXPECT().LockTTL(gomock.Any(), "login:"+testLogin).Return(int64(0), nil)
+	m.EXPECT().LockTTL(gomock.Any(), "ip:"+testIP).Return(int64(0), nil)
+	m.EXPECT().Reset(gomock.Any(), "login:"+testLogin).Return(nil)
+
+	useCase := AttemptsUseCase{Repository: m, Limits: testLimits}
+
+	//typed login and the stored one share the lockout
+	_, err := useCase.Check(context.Background(), " NNNagibator ", testIP)
+	assert.NoError(t, err)
+	assert.NoError(t, useCase.Reset(context.Background(), testLogin))
+}
+
+func TestNewAttemptsUseCase(t *testing.T) {
+	//the metrics are registered once, so more use cases must not panic
+	assert.NotPanics(t, func() {
+		NewAttemptsUseCase(nil, testLimits)
+		NewAttemptsUseCase(nil, testLimits)
+	})
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/metrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+	"golang.org/x/sync/singleflight"
+)
+
+// versionKey is a part of every entry key, bumping it drops the entries of all caches at once.
+// Catalog entities are duplicated in each other, e.g. artist names in albums and tracks,
+// so a write can't tell which cached pages it changed
+const versionKey = "cache:version"
+
+const (
+	// lockTTL frees the lock of a replica that died while loading an entry,
+	// the others stop waiting for it after lockWait and load the entry themselves
+	lockTTL      = 2 * time.Second
+	lockWait     = 500 * time.Millisecond
+	lockInterval = 25 * time.Millisecond
+)
+
+// Cache is a read-through cache of json encoded values in redis
+type Cache struct {
+	redisPool *redis.Pool
+	name      string
+	ttl       time.Duration
+	// loads collapses concurrent misses of a key on this replica into one load,
+	// the lock in redis does the same across replicas
+	loads *singleflight.Group
+}
+
+// New creates a cache with entries prefixed by name, name is also the label of its metrics
+func New(conn *redis.Pool, name string, ttl time.Duration) *Cache {
+	return &Cache{
+		redisPool: conn,
+		name:      name,
+		ttl:       ttl,
+		loads:     &singleflight.Group{},
+	}
+}
+
+// Get decodes the entry of key into dst, a missing entry is loaded with load and stored for ttl.
+// Errors of load are returned as is and never cached. Redis failures only cost the cache, the value is still loaded
+func (c *Cache) Get(ctx context.Context, key string, dst interface{}, load func() (interface{}, error)) error {
+	conn, err := tracing.RedisConn(ctx, c.redisPool)
+	if err != nil {
+		metrics.ObserveCache(c.name, metrics.CacheError)
+		return loadInto(dst, load)
+	}
+	defer conn.Close()
+
+	version, err := redis.Int64(conn.Do("GET", versionKey))
+	if err != nil && err != redis.ErrNil {
+		metrics.ObserveCache(c.name, metrics.CacheError)
+		return loadInto(dst, load)
+	}
+	entryKey := fmt.Sprintf("cache:%s:%d:%s", c.name, version, key)
+
+	if data, err := redis.Bytes(conn.Do("GET", entryKey)); err == nil {
+		if err := json.Unmarshal(data, dst); err == nil {
+			metrics.ObserveCache(c.name, metrics.CacheHit)
+			return nil
+		}
+	}
+	metrics.ObserveCache(c.name, metrics.CacheMiss)
+
+	data, err, _ := c.loads.Do(entryKey, func() (interface{}, error) {
+		return c.fill(ctx, conn, entryKey, load)
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data.([]byte), dst)
+}
+
+// Invalidate drops the entries of every cache, old entries are left to expire
+func (c *Cache) Invalidate(ctx context.Context) error {
+	conn, err := tracing.RedisConn(ctx, c.redisPool)
+	if err != nil {
+		metrics.ObserveCache(c.name, metrics.CacheError)
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("INCR", versionKey); err != nil {
+		metrics.ObserveCache(c.name, metrics.CacheError)
+		return fmt.Errorf("failed to bump cache version: %w", err)
+	}
+	return nil
+}
+
+// fill loads the entry if no other replica is loading it, otherwise it waits for their result for a while
+func (c *Cache) fill(ctx context.Context, conn redis.Conn, entryKey string, load func() (interface{}, error)) ([]byte, error) {
+	lockKey := entryKey + ":lock"
+	_, err := redis.String(conn.Do("SET", lockKey, 1, "NX", "PX", lockTTL.Milliseconds()))
+	locked := err == nil
+	if locked {
+		defer func() { _, _ = conn.Do("DEL", lockKey) }()
+	} else if data, ok := c.wait(ctx, conn, entryKey); ok {
+		return data, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	_, _ = conn.Do("SET", entryKey, data, "PX", c.expire().Milliseconds())
+	return data, nil
+}
+
+func (c *Cache) wait(ctx context.Context, conn redis.Conn, entryKey string) ([]byte, bool) {
+	timer := time.NewTimer(lockWait)
+	defer timer.Stop()
+	ticker := time.NewTicker(lockInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-timer.C:
+			return nil, false
+		case <-ticker.C:
+			if data, err := redis.Bytes(conn.Do("GET", entryKey)); err == nil {
+				return data, true
+			}
+		}
+	}
+}
+
+// expire spreads entries over the last tenth of ttl, so entries filled together don't expire together
+func (c *Cache) expire() time.Duration {
+	jitter := int64(c.ttl / 10)
+	if jitter <= 0 {
+		return c.ttl
+	}
+	return c.ttl - time.Duration(rand.Int63n(jitter))
+}
+
+func loadInto(dst interface{}, load func() (interface{}, error)) error {
+	value, err := load()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode value: %w", err)
+	}
+	return json.Unmarshal(data, dst)
+}
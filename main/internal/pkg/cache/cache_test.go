@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type item struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type CacheSuite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	redisConn   *redis.Pool
+}
+
+func (s *CacheSuite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	s.redisConn = &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *CacheSuite) AfterTest(_, _ string) {
+	s.redisServer.Close()
+	s.SetupSuite()
+}
+
+func (s *CacheSuite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestCache(t *testing.T) {
+	suite.Run(t, new(CacheSuite))
+}
+
+func counted(loads *int32, value interface{}, err error) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		atomic.AddInt32(loads, 1)
+		return value, err
+	}
+}
+
+func (s *CacheSuite) TestGet() {
+	c := New(s.redisConn, "items", time.Minute)
+	expected := item{Id: "1", Name: "first"}
+	var loads int32
+
+	var result item
+	require.NoError(s.T(), c.Get(context.Background(), "1", &result, counted(&loads, expected, nil)))
+	require.Equal(s.T(), expected, result)
+
+	//second call is served from cache
+	result = item{}
+	require.NoError(s.T(), c.Get(context.Background(), "1", &result, counted(&loads, expected, nil)))
+	require.Equal(s.T(), expected, result)
+	require.Equal(s.T(), int32(1), loads)
+
+	data, err := s.redisServer.Get("cache:items:0:1")
+	require.NoError(s.T(), err)
+	require.JSONEq(s.T(), `{"id":"1","name":"first"}`, data)
+	require.False(s.T(), s.redisServer.Exists("cache:items:0:1:lock"))
+
+	//test TTL
+	s.redisServer.FastForward(time.Minute)
+	require.False(s.T(), s.redisServer.Exists("cache:items:0:1"))
+}
+
+func (s *CacheSuite) TestGetLoadError() {
+	c := New(s.redisConn, "items", time.Minute)
+	expected := errors.New("not found")
+	var loads int32
+
+	var result item
+	require.Equal(s.T(), expected, c.Get(context.Background(), "1", &result, counted(&loads, nil, expected)))
+	require.Equal(s.T(), expected, c.Get(context.Background(), "1", &result, counted(&loads, nil, expected)))
+	require.Equal(s.T(), int32(2), loads)
+	require.False(s.T(), s.redisServer.Exists("cache:items:0:1"))
+}
+
+func (s *CacheSuite) TestInvalidate() {
+	items := New(s.redisConn, "items", time.Minute)
+	others := New(s.redisConn, "others", time.Minute)
+	var loads int32
+
+	var result item
+	require.NoError(s.T(), items.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1"}, nil)))
+	require.NoError(s.T(), others.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1"}, nil)))
+
+	//a write to one cache drops entries of all of them
+	require.NoError(s.T(), items.Invalidate(context.Background()))
+
+	require.NoError(s.T(), items.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1", Name: "new"}, nil)))
+	require.Equal(s.T(), "new", result.Name)
+	require.NoError(s.T(), others.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1", Name: "new"}, nil)))
+	require.Equal(s.T(), "new", result.Name)
+	require.Equal(s.T(), int32(4), loads)
+	require.True(s.T(), s.redisServer.Exists("cache:items:1:1"))
+}
+
+func (s *CacheSuite) TestGetConcurrent() {
+	c := New(s.redisConn, "items", time.Minute)
+	var loads int32
+	load := func() (interface{}, error) {
+		atomic.AddInt32(&loads, 1)
+		time.Sleep(50 * time.Millisecond)
+		return item{Id: "1"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result item
+			s.NoError(c.Get(context.Background(), "1", &result, load))
+			s.Equal("1", result.Id)
+		}()
+	}
+	wg.Wait()
+	require.Equal(s.T(), int32(1), loads)
+}
+
+func (s *CacheSuite) TestGetLocked() {
+	c := New(s.redisConn, "items", time.Minute)
+	var loads int32
+
+	//another replica is loading the entry and stores it while we wait
+	require.NoError(s.T(), s.redisServer.Set("cache:items:0:1:lock", "1"))
+	go func() {
+		time.Sleep(2 * lockInterval)
+		_ = s.redisServer.Set("cache:items:0:1", `{"id":"1","name":"other"}`)
+	}()
+
+	var result item
+	require.NoError(s.T(), c.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1"}, nil)))
+	require.Equal(s.T(), "other", result.Name)
+	require.Equal(s.T(), int32(0), loads)
+}
+
+func (s *CacheSuite) TestGetLockedTimeout() {
+	c := New(s.redisConn, "items", time.Minute)
+	var loads int32
+
+	require.NoError(s.T(), s.redisServer.Set("cache:items:0:1:lock", "1"))
+
+	var result item
+	require.NoError(s.T(), c.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1"}, nil)))
+	require.Equal(s.T(), "1", result.Id)
+	require.Equal(s.T(), int32(1), loads)
+}
+
+func (s *CacheSuite) TestRedisDown() {
+	c := New(s.redisConn, "items", time.Minute)
+	var loads int32
+	s.redisServer.Close()
+
+	var result item
+	require.NoError(s.T(), c.Get(context.Background(), "1", &result, counted(&loads, item{Id: "1"}, nil)))
+	require.Equal(s.T(), "1", result.Id)
+	require.Equal(s.T(), int32(1), loads)
+
+	require.Error(s.T(), c.Invalidate(context.Background()))
+}
@@ -0,0 +1,71 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+)
+
+type ChartHandler struct {
+	ChartUC chart.UseCase
+	Log     *logger.MainLogger
+}
+
+func (h *ChartHandler) GetChart(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chartType, okType := vars["type"]
+	window, okWindow := vars["window"]
+
+	if !okType || !okWindow {
+		h.Log.HttpInfo(r.Context(), "no data in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.ChartUC.GetChart(r.Context(), chartType, window, r.URL.Query().Get("genre"))
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get chart: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.Log.LogWarning(r.Context(), "chart delivery", "GetChart", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *ChartHandler) AddPlay(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "chart delivery", "AddPlay", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.ChartUC.AddPlay(r.Context(), user.Id, id); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to add play: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
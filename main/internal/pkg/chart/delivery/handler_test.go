@@ -0,0 +1,139 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var chartHandler ChartHandler
+
+func init() {
+	chartHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func chartVars(next http.HandlerFunc, chartType, window string) http.HandlerFunc {
+	return middleware.SetUnlimitedVars(next,
+		middleware.VarsPair{Key: "type", Value: chartType},
+		middleware.VarsPair{Key: "window", Value: window})
+}
+
+func TestGetChart(t *testing.T) {
+	t.Run("GetChart-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockUseCase(ctrl)
+		chartHandler.ChartUC = m
+
+		m.EXPECT().
+			GetChart(gomock.Any(), models.ChartTracks, models.ChartWeekly, "4").
+			Return(models.Chart{
+				Type:    models.ChartTracks,
+				Window:  models.ChartWeekly,
+				GenreId: "4",
+				Period:  "2020-05-11",
+				Entries: []models.ChartEntry{
+					{Position: 1, PreviousPosition: 3, Id: "7", Name: "song", ArtistId: "1", ArtistName: "band", Plays: 10, Likes: 2, Score: 20},
+				},
+			}, nil)
+
+		apitest.New("GetChart-OK").
+			Handler(chartVars(chartHandler.GetChart, models.ChartTracks, models.ChartWeekly)).
+			Method("Get").
+			URL("/charts/tracks/weekly").
+			Query("genre", "4").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"type":"tracks","window":"weekly","genre_id":"4","period":"2020-05-11","entries":[` +
+				`{"position":1,"previous_position":3,"id":"7","name":"song","artist_id":"1","artist_name":"band","plays":10,"likes":2,"score":20}]}`).
+			End()
+	})
+
+	t.Run("GetChart-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockUseCase(ctrl)
+		chartHandler.ChartUC = m
+
+		m.EXPECT().
+			GetChart(gomock.Any(), models.ChartTracks, models.ChartDaily, "").
+			Return(models.Chart{}, errors.New("db error"))
+
+		apitest.New("GetChart-Error").
+			Handler(chartVars(chartHandler.GetChart, models.ChartTracks, models.ChartDaily)).
+			Method("Get").
+			URL("/charts/tracks/daily").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetChart-NoVars", func(t *testing.T) {
+		apitest.New("GetChart-NoVars").
+			Handler(http.HandlerFunc(chartHandler.GetChart)).
+			Method("Get").
+			URL("/charts").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestAddPlay(t *testing.T) {
+	user := models.User{Id: "1"}
+
+	t.Run("AddPlay-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockUseCase(ctrl)
+		chartHandler.ChartUC = m
+
+		m.EXPECT().AddPlay(gomock.Any(), "1", "5").Return(nil)
+
+		apitest.New("AddPlay-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(chartHandler.AddPlay, "id", "5"), true, user, "")).
+			Method("Post").
+			URL("/tracks/5/plays").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("AddPlay-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockUseCase(ctrl)
+		chartHandler.ChartUC = m
+
+		m.EXPECT().AddPlay(gomock.Any(), "1", "5").Return(errors.New("no such track"))
+
+		apitest.New("AddPlay-Error").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(chartHandler.AddPlay, "id", "5"), true, user, "")).
+			Method("Post").
+			URL("/tracks/5/plays").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("AddPlay-NoCSRF", func(t *testing.T) {
+		apitest.New("AddPlay-NoCSRF").
+			Handler(middleware.SetMuxVars(chartHandler.AddPlay, "id", "5")).
+			Method("Post").
+			URL("/tracks/5/plays").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
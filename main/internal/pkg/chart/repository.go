@@ -0,0 +1,15 @@
+package chart
+
+import (
+	"context"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	AddPlay(ctx context.Context, uID string, tID string) error
+	Aggregate(ctx context.Context, chartType string, genreID string, since time.Time, count uint64) ([]models.ChartEntry, error)
+	SaveSnapshot(ctx context.Context, chart models.Chart) error
+	GetChart(ctx context.Context, chartType string, window string, genreID string) (models.Chart, error)
+}
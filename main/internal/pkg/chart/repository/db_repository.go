@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+)
+
+// a like weighs as much as several plays
+const likeWeight = 5
+
+const periodLayout = "2006-01-02"
+
+// genreViews keeps the view matching entities of a chart type to genres and its entity column
+var genreViews = map[string][2]string{
+	models.ChartTracks:  {"genre_tracks", "track_id"},
+	models.ChartAlbums:  {"genre_albums", "album_id"},
+	models.ChartArtists: {"genre_artists", "artist_id"},
+}
+
+type Scores struct {
+	EntityId uint64 `gorm:"column:entity_id"`
+	Plays    int64  `gorm:"column:plays"`
+	Likes    int64  `gorm:"column:likes"`
+	Score    int64  `gorm:"column:score"`
+}
+
+type Entries struct {
+	Period           time.Time `gorm:"column:period"`
+	Position         int       `gorm:"column:position"`
+	PreviousPosition *int      `gorm:"column:previous_position"`
+	EntityId         uint64    `gorm:"column:entity_id"`
+	Plays            int64     `gorm:"column:plays"`
+	Likes            int64     `gorm:"column:likes"`
+	Score            int64     `gorm:"column:score"`
+	Name             string    `gorm:"column:name"`
+	Image            string    `gorm:"column:image"`
+	ArtistId         *uint64   `gorm:"column:artist_id"`
+	ArtistName       *string   `gorm:"column:artist_name"`
+}
+
+type DbChartRepository struct {
+	db *gorm.DB
+}
+
+func NewDbChartRepository(database *gorm.DB) DbChartRepository {
+	return DbChartRepository{
+		db: database,
+	}
+}
+
+// genreKey converts genre id to the snapshot key, the global chart is stored under 0
+func genreKey(genreID string) (uint64, error) {
+	if genreID == "" {
+		return 0, nil
+	}
+	key, err := strconv.ParseUint(genreID, 10, 64)
+	if err != nil {
+		return 0, apperrors.Errorf(apperrors.Validation, "failed to parse genre id: %w", err)
+	}
+	return key, nil
+}
+
+func (cr *DbChartRepository) AddPlay(ctx context.Context, uID string, tID string) error {
+	db := database.WithContext(ctx, cr.db).Exec("insert into track_plays (user_id, track_id) values (?, ?)", uID, tID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to add play: %w", err)
+	}
+	return nil
+}
+
+// Aggregate ranks entities of the chart type by plays and likes which happened after since
+func (cr *DbChartRepository) Aggregate(ctx context.Context, chartType string, genreID string, since time.Time, count uint64) ([]models.ChartEntry, error) {
+	var scores []Scores
+
+	db := database.WithContext(ctx, cr.db).
+		Table("chart_events").
+		Select("entity_id, sum(plays) as plays, sum(likes) as likes, sum(plays) + ? * sum(likes) as score", likeWeight).
+		Where("chart_type = ? AND happened_at > ?", chartType, since).
+		Where("entity_id IN (SELECT id FROM chart_items WHERE chart_type = ?)", chartType)
+
+	if genreID != "" {
+		view, ok := genreViews[chartType]
+		if !ok {
+			return nil, apperrors.Errorf(apperrors.Validation, "unknown chart type: %s", chartType)
+		}
+		db = db.Where("entity_id IN (SELECT "+view[1]+" FROM "+view[0]+" WHERE genre_id = ?)", genreID)
+	}
+
+	db = db.
+		Group("entity_id").
+		Order("score desc, entity_id").
+		Limit(count).
+		Scan(&scores)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate chart: %w", err)
+	}
+
+	result := make([]models.ChartEntry, len(scores))
+	for i, elem := range scores {
+		result[i] = models.ChartEntry{
+			Position: i + 1,
+			Id:       strconv.FormatUint(elem.EntityId, 10),
+			Plays:    elem.Plays,
+			Likes:    elem.Likes,
+			Score:    elem.Score,
+		}
+	}
+	return result, nil
+}
+
+// SaveSnapshot replaces the snapshot of the chart for its period
+func (cr *DbChartRepository) SaveSnapshot(ctx context.Context, chart models.Chart) error {
+	gKey, err := genreKey(chart.GenreId)
+	if err != nil {
+		return err
+	}
+
+	tx := database.WithContext(ctx, cr.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	db := tx.Exec("delete from chart_snapshots where chart_type = ? and time_window = ? and genre_id = ? and period = ?",
+		chart.Type, chart.Window, gKey, chart.Period)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear snapshot: %w", err)
+	}
+
+	for _, elem := range chart.Entries {
+		db = tx.Exec("insert into chart_snapshots "+
+			"(chart_type, time_window, genre_id, period, position, entity_id, plays, likes, score) "+
+			"values (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			chart.Type, chart.Window, gKey, chart.Period, elem.Position, elem.Id, elem.Plays, elem.Likes, elem.Score)
+		if err := db.Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to insert snapshot entry: %w", err)
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// GetChart returns the latest snapshot of the chart
+func (cr *DbChartRepository) GetChart(ctx context.Context, chartType string, window string, genreID string) (models.Chart, error) {
+	gKey, err := genreKey(genreID)
+	if err != nil {
+		return models.Chart{}, err
+	}
+	var entries []Entries
+
+	db := database.WithContext(ctx, cr.db).
+		Table("chart_entries e").
+		Select("e.period, e.position, e.previous_position, e.entity_id, e.plays, e.likes, e.score, "+
+			"i.name, i.image, i.artist_id, i.artist_name").
+		Joins("JOIN chart_items i ON i.chart_type = e.chart_type AND i.id = e.entity_id").
+		Where("e.chart_type = ? AND e.time_window = ? AND e.genre_id = ?", chartType, window, gKey).
+		Where("e.period = (SELECT max(period) FROM chart_snapshots "+
+			"WHERE chart_type = ? AND time_window = ? AND genre_id = ?)", chartType, window, gKey).
+		Order("e.position").
+		Scan(&entries)
+
+	if err := db.Error; err != nil {
+		return models.Chart{}, fmt.Errorf("failed to get chart: %w", err)
+	}
+
+	result := models.Chart{
+		Type:    chartType,
+		Window:  window,
+		GenreId: genreID,
+		Entries: make([]models.ChartEntry, len(entries)),
+	}
+	for i, elem := range entries {
+		result.Entries[i] = toModel(elem)
+	}
+	if len(entries) > 0 {
+		result.Period = entries[0].Period.Format(periodLayout)
+	}
+	return result, nil
+}
+
+func toModel(entry Entries) models.ChartEntry {
+	result := models.ChartEntry{
+		Position: entry.Position,
+		Id:       strconv.FormatUint(entry.EntityId, 10),
+		Name:     entry.Name,
+		Image:    entry.Image,
+		Plays:    entry.Plays,
+		Likes:    entry.Likes,
+		Score:    entry.Score,
+	}
+	if entry.PreviousPosition != nil {
+		result.PreviousPosition = *entry.PreviousPosition
+	}
+	if entry.ArtistId != nil {
+		result.ArtistId = strconv.FormatUint(*entry.ArtistId, 10)
+	}
+	if entry.ArtistName != nil {
+		result.ArtistName = *entry.ArtistName
+	}
+	return result
+}
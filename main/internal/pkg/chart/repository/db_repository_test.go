@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbChartRepository
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.repository = NewDbChartRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestAddPlay() {
+	s.mock.ExpectExec(regexp.QuoteMeta("insert into track_plays (user_id, track_id) values ($1, $2)")).
+		WithArgs("1", "2").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.AddPlay(context.Background(), "1", "2"))
+
+	//test on db error
+	s.mock.ExpectExec("insert into track_plays").
+		WithArgs("1", "2").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.AddPlay(context.Background(), "1", "2"))
+}
+
+func (s *Suite) TestAggregate() {
+	since := time.Date(2020, 5, 10, 12, 0, 0, 0, time.UTC)
+	columns := []string{"entity_id", "plays", "likes", "score"}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_id, sum(plays) as plays, sum(likes) as likes, sum(plays) + $1 * sum(likes) as score `+
+		`FROM "chart_events" WHERE (chart_type = $2 AND happened_at > $3) `+
+		`AND (entity_id IN (SELECT id FROM chart_items WHERE chart_type = $4)) `+
+		`GROUP BY entity_id ORDER BY score desc, entity_id LIMIT 50`)).
+		WithArgs(likeWeight, models.ChartTracks, since, models.ChartTracks).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(7, 10, 2, 20).
+			AddRow(3, 12, 0, 12))
+
+	res, err := s.repository.Aggregate(context.Background(), models.ChartTracks, "", since, 50)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.ChartEntry{
+		{Position: 1, Id: "7", Plays: 10, Likes: 2, Score: 20},
+		{Position: 2, Id: "3", Plays: 12, Likes: 0, Score: 12},
+	}, res))
+
+	//test on genre chart
+	s.mock.ExpectQuery(regexp.QuoteMeta(`AND (entity_id IN (SELECT album_id FROM genre_albums WHERE genre_id = $5))`)).
+		WithArgs(likeWeight, models.ChartAlbums, since, models.ChartAlbums, "4").
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	res, err = s.repository.Aggregate(context.Background(), models.ChartAlbums, "4", since, 50)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), res)
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.Aggregate(context.Background(), models.ChartArtists, "", since, 50)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestSaveSnapshot() {
+	chart := models.Chart{
+		Type:    models.ChartTracks,
+		Window:  models.ChartWeekly,
+		GenreId: "4",
+		Period:  "2020-05-11",
+		Entries: []models.ChartEntry{
+			{Position: 1, Id: "7", Plays: 10, Likes: 2, Score: 20},
+			{Position: 2, Id: "3", Plays: 12, Likes: 0, Score: 12},
+		},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from chart_snapshots").
+		WithArgs(models.ChartTracks, models.ChartWeekly, uint64(4), "2020-05-11").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.mock.ExpectExec("insert into chart_snapshots").
+		WithArgs(models.ChartTracks, models.ChartWeekly, uint64(4), "2020-05-11", 1, "7", int64(10), int64(2), int64(20)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("insert into chart_snapshots").
+		WithArgs(models.ChartTracks, models.ChartWeekly, uint64(4), "2020-05-11", 2, "3", int64(12), int64(0), int64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.SaveSnapshot(context.Background(), chart))
+
+	//test on global chart and insert error
+	chart.GenreId = ""
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from chart_snapshots").
+		WithArgs(models.ChartTracks, models.ChartWeekly, uint64(0), "2020-05-11").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("insert into chart_snapshots").
+		WillReturnError(errors.New("db_error"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.SaveSnapshot(context.Background(), chart))
+
+	//test on wrong genre id
+	chart.GenreId = "rock"
+	require.Error(s.T(), s.repository.SaveSnapshot(context.Background(), chart))
+}
+
+func (s *Suite) TestGetChart() {
+	period := time.Date(2020, 5, 11, 0, 0, 0, 0, time.UTC)
+	columns := []string{"period", "position", "previous_position", "entity_id", "plays", "likes", "score",
+		"name", "image", "artist_id", "artist_name"}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT e.period, e.position, e.previous_position, e.entity_id, e.plays, e.likes, e.score, `+
+		`i.name, i.image, i.artist_id, i.artist_name FROM chart_entries e `+
+		`JOIN chart_items i ON i.chart_type = e.chart_type AND i.id = e.entity_id `+
+		`WHERE (e.chart_type = $1 AND e.time_window = $2 AND e.genre_id = $3) `+
+		`AND (e.period = (SELECT max(period) FROM chart_snapshots WHERE chart_type = $4 AND time_window = $5 AND genre_id = $6)) `+
+		`ORDER BY "e"."position"`)).
+		WithArgs(models.ChartTracks, models.ChartWeekly, uint64(0), models.ChartTracks, models.ChartWeekly, uint64(0)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(period, 1, 3, 7, 10, 2, 20, "song", "/img.png", 1, "band").
+			AddRow(period, 2, nil, 3, 12, 0, 12, "new song", "/img2.png", 2, "other band"))
+
+	res, err := s.repository.GetChart(context.Background(), models.ChartTracks, models.ChartWeekly, "")
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(models.Chart{
+		Type:   models.ChartTracks,
+		Window: models.ChartWeekly,
+		Period: "2020-05-11",
+		Entries: []models.ChartEntry{
+			{Position: 1, PreviousPosition: 3, Id: "7", Name: "song", Image: "/img.png", ArtistId: "1", ArtistName: "band",
+				Plays: 10, Likes: 2, Score: 20},
+			{Position: 2, Id: "3", Name: "new song", Image: "/img2.png", ArtistId: "2", ArtistName: "other band",
+				Plays: 12, Score: 12},
+		},
+	}, res))
+
+	//test on empty chart
+	s.mock.ExpectQuery("SELECT").
+		WithArgs(models.ChartArtists, models.ChartDaily, uint64(4), models.ChartArtists, models.ChartDaily, uint64(4)).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	res, err = s.repository.GetChart(context.Background(), models.ChartArtists, models.ChartDaily, "4")
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(models.Chart{
+		Type:    models.ChartArtists,
+		Window:  models.ChartDaily,
+		GenreId: "4",
+		Entries: []models.ChartEntry{},
+	}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetChart(context.Background(), models.ChartArtists, models.ChartDaily, "4")
+	require.Error(s.T(), err)
+}
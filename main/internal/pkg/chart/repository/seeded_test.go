@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// seededDBEnv names the connection string of a database with the migrations applied,
+// the test is skipped when it is not set
+const seededDBEnv = "CHARTS_TEST_DB"
+
+type seedID struct {
+	Id uint64 `gorm:"column:id"`
+}
+
+func insertReturning(t *testing.T, db *gorm.DB, query string, args ...interface{}) string {
+	var row seedID
+	require.NoError(t, db.Raw(query+" returning id", args...).Scan(&row).Error)
+	return strconv.FormatUint(row.Id, 10)
+}
+
+func filterEntries(entries []models.ChartEntry, ids ...string) []models.ChartEntry {
+	var result []models.ChartEntry
+	for _, elem := range entries {
+		for _, id := range ids {
+			if elem.Id == id {
+				result = append(result, elem)
+			}
+		}
+	}
+	return result
+}
+
+func TestSeededCharts(t *testing.T) {
+	dsn := os.Getenv(seededDBEnv)
+	if dsn == "" {
+		t.Skip(seededDBEnv + " is not set")
+	}
+	db, err := gorm.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	db.LogMode(false)
+	db.DB().SetMaxOpenConns(1)
+	require.NoError(t, db.Exec("set time zone 'UTC'").Error)
+
+	now := time.Now().UTC()
+	suffix := strconv.FormatInt(now.UnixNano(), 36)
+
+	artist := insertReturning(t, db, "insert into artists (name) values (?)", "chart artist "+suffix)
+	user := insertReturning(t, db, "insert into users (login, password, name, email, sex) values (?, ?, ?, ?, ?)",
+		"c"+suffix, []byte("password"), "chart user", suffix+"@chart.test", "male")
+	defer func() {
+		db.Exec("delete from chart_snapshots where period >= '2100-01-01'")
+		db.Exec("delete from users where id = ?", user)
+		db.Exec("delete from artists where id = ?", artist)
+	}()
+
+	addTrack := func(name string) string {
+		return insertReturning(t, db, "insert into tracks (name, duration, link, artist_id) values (?, ?, ?, ?)",
+			name, 200, "/"+name+".mp3", artist)
+	}
+	popular, liked, old := addTrack("popular"), addTrack("liked"), addTrack("old")
+	album := insertReturning(t, db, "insert into albums (name, release, artist_id) values (?, ?, ?)",
+		"chart album", now, artist)
+	require.NoError(t, db.Exec("insert into album_tracks (track_id, album_id) values (?, ?), (?, ?)",
+		popular, album, liked, album).Error)
+
+	play := func(track string, at time.Time, count int) {
+		for i := 0; i < count; i++ {
+			require.NoError(t, db.Exec("insert into track_plays (user_id, track_id, played_at) values (?, ?, ?)",
+				user, track, at).Error)
+		}
+	}
+	play(popular, now.Add(-time.Hour), 3)
+	play(liked, now.Add(-time.Hour), 1)
+	play(old, now.Add(-48*time.Hour), 10)
+	require.NoError(t, db.Exec("insert into liked_tracks (user_ID, track_ID) values (?, ?)", user, liked).Error)
+
+	repository := NewDbChartRepository(db)
+
+	daily, err := repository.Aggregate(context.Background(), models.ChartTracks, "", now.Add(-24*time.Hour), 1000)
+	require.NoError(t, err)
+	daily = filterEntries(daily, popular, liked, old)
+	require.Len(t, daily, 2)
+	require.Equal(t, liked, daily[0].Id)
+	require.Equal(t, models.ChartEntry{Position: daily[0].Position, Id: liked, Plays: 1, Likes: 1, Score: 1 + likeWeight}, daily[0])
+	require.Equal(t, popular, daily[1].Id)
+	require.Equal(t, int64(3), daily[1].Score)
+
+	weekly, err := repository.Aggregate(context.Background(), models.ChartTracks, "", now.Add(-7*24*time.Hour), 1000)
+	require.NoError(t, err)
+	weekly = filterEntries(weekly, popular, liked, old)
+	require.Len(t, weekly, 3)
+	require.Equal(t, old, weekly[0].Id)
+
+	albums, err := repository.Aggregate(context.Background(), models.ChartAlbums, "", now.Add(-24*time.Hour), 1000)
+	require.NoError(t, err)
+	albums = filterEntries(albums, album)
+	require.Len(t, albums, 1)
+	require.Equal(t, int64(4), albums[0].Plays)
+
+	artists, err := repository.Aggregate(context.Background(), models.ChartArtists, "", now.Add(-7*24*time.Hour), 1000)
+	require.NoError(t, err)
+	artists = filterEntries(artists, artist)
+	require.Len(t, artists, 1)
+	require.Equal(t, int64(14), artists[0].Plays)
+
+	snapshot := func(period string, ids ...string) models.Chart {
+		chart := models.Chart{Type: models.ChartTracks, Window: models.ChartDaily, Period: period}
+		for i, id := range ids {
+			chart.Entries = append(chart.Entries, models.ChartEntry{Position: i + 1, Id: id})
+		}
+		return chart
+	}
+	require.NoError(t, repository.SaveSnapshot(context.Background(), snapshot("2100-01-01", popular, liked)))
+	require.NoError(t, repository.SaveSnapshot(context.Background(), snapshot("2100-01-02", liked, popular, old)))
+
+	chart, err := repository.GetChart(context.Background(), models.ChartTracks, models.ChartDaily, "")
+	require.NoError(t, err)
+	require.Equal(t, "2100-01-02", chart.Period)
+	require.Len(t, chart.Entries, 3)
+	require.Equal(t, []int{2, 1, 0}, []int{
+		chart.Entries[0].PreviousPosition,
+		chart.Entries[1].PreviousPosition,
+		chart.Entries[2].PreviousPosition,
+	})
+	require.Equal(t, "chart artist "+suffix, chart.Entries[0].ArtistName)
+}
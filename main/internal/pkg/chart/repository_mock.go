@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package chart is a generated GoMock package.
+package chart
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockRepository is a mock of Repository interface
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPlay mocks base method
+func (m *MockRepository) AddPlay(ctx context.Context, uID, tID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlay", ctx, uID, tID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlay indicates an expected call of AddPlay
+func (mr *MockRepositoryMockRecorder) AddPlay(ctx, uID, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlay", reflect.TypeOf((*MockRepository)(nil).AddPlay), ctx, uID, tID)
+}
+
+// Aggregate mocks base method
+func (m *MockRepository) Aggregate(ctx context.Context, chartType, genreID string, since time.Time, count uint64) ([]models.ChartEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, chartType, genreID, since, count)
+	ret0, _ := ret[0].([]models.ChartEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate
+func (mr *MockRepositoryMockRecorder) Aggregate(ctx, chartType, genreID, since, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockRepository)(nil).Aggregate), ctx, chartType, genreID, since, count)
+}
+
+// SaveSnapshot mocks base method
+func (m *MockRepository) SaveSnapshot(ctx context.Context, chart models.Chart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSnapshot", ctx, chart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSnapshot indicates an expected call of SaveSnapshot
+func (mr *MockRepositoryMockRecorder) SaveSnapshot(ctx, chart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSnapshot", reflect.TypeOf((*MockRepository)(nil).SaveSnapshot), ctx, chart)
+}
+
+// GetChart mocks base method
+func (m *MockRepository) GetChart(ctx context.Context, chartType, window, genreID string) (models.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChart", ctx, chartType, window, genreID)
+	ret0, _ := ret[0].(models.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChart indicates an expected call of GetChart
+func (mr *MockRepositoryMockRecorder) GetChart(ctx, chartType, window, genreID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChart", reflect.TypeOf((*MockRepository)(nil).GetChart), ctx, chartType, window, genreID)
+}
@@ -0,0 +1,14 @@
+package chart
+
+import (
+	"context"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type UseCase interface {
+	GetChart(ctx context.Context, chartType string, window string, genreID string) (models.Chart, error)
+	AddPlay(ctx context.Context, uID string, tID string) error
+	Refresh(ctx context.Context, now time.Time) error
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+const periodLayout = "2006-01-02"
+
+var chartTypes = []string{models.ChartTracks, models.ChartAlbums, models.ChartArtists}
+
+var chartWindows = []string{models.ChartDaily, models.ChartWeekly, models.ChartMonthly}
+
+// windowLength is how far back plays and likes are counted for a window
+var windowLength = map[string]time.Duration{
+	models.ChartDaily:   24 * time.Hour,
+	models.ChartWeekly:  7 * 24 * time.Hour,
+	models.ChartMonthly: 30 * 24 * time.Hour,
+}
+
+type ChartUseCase struct {
+	Repository      chart.Repository
+	GenreRepository genre.Repository
+	Size            uint64
+}
+
+func (uc *ChartUseCase) GetChart(ctx context.Context, chartType string, window string, genreID string) (models.Chart, error) {
+	if err := validateChart(chartType, window, genreID); err != nil {
+		return models.Chart{}, err
+	}
+	return uc.Repository.GetChart(ctx, chartType, window, genreID)
+}
+
+func (uc *ChartUseCase) AddPlay(ctx context.Context, uID string, tID string) error {
+	if _, err := strconv.ParseUint(tID, 10, 64); err != nil {
+		return apperrors.New(apperrors.Validation, "invalid track id")
+	}
+	return uc.Repository.AddPlay(ctx, uID, tID)
+}
+
+// Refresh aggregates every chart, global and per genre, and stores it as the snapshot of the current period
+func (uc *ChartUseCase) Refresh(ctx context.Context, now time.Time) error {
+	genres, err := uc.GenreRepository.GetGenres(ctx)
+	if err != nil {
+		return err
+	}
+	genreIDs := make([]string, 0, len(genres)+1)
+	genreIDs = append(genreIDs, "")
+	for _, elem := range genres {
+		genreIDs = append(genreIDs, elem.Id)
+	}
+
+	for _, window := range chartWindows {
+		since := now.Add(-windowLength[window])
+		period := periodStart(now, window).Format(periodLayout)
+
+		for _, chartType := range chartTypes {
+			for _, gID := range genreIDs {
+				entries, err := uc.Repository.Aggregate(ctx, chartType, gID, since, uc.Size)
+				if err != nil {
+					return err
+				}
+				err = uc.Repository.SaveSnapshot(ctx, models.Chart{
+					Type:    chartType,
+					Window:  window,
+					GenreId: gID,
+					Period:  period,
+					Entries: entries,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// periodStart returns the day, the monday of the week or the first day of the month the moment belongs to
+func periodStart(now time.Time, window string) time.Time {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch window {
+	case models.ChartWeekly:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case models.ChartMonthly:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+func validateChart(chartType string, window string, genreID string) error {
+	switch chartType {
+	case models.ChartTracks, models.ChartAlbums, models.ChartArtists:
+	default:
+		return errors.New("unknown chart type: " + chartType)
+	}
+	if _, ok := windowLength[window]; !ok {
+		return errors.New("unknown chart window: " + window)
+	}
+	if genreID != "" {
+		if _, err := strconv.ParseUint(genreID, 10, 64); err != nil {
+			return apperrors.New(apperrors.Validation, "invalid genre id")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/chart"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestGetChart(t *testing.T) {
+	t.Run("GetChart-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := models.Chart{
+			Type:    models.ChartAlbums,
+			Window:  models.ChartWeekly,
+			GenreId: "4",
+			Entries: []models.ChartEntry{{Position: 1, Id: "2"}},
+		}
+
+		m := chart.NewMockRepository(ctrl)
+		m.EXPECT().GetChart(gomock.Any(), models.ChartAlbums, models.ChartWeekly, "4").Return(expected, nil)
+
+		useCase := ChartUseCase{Repository: m}
+
+		result, err := useCase.GetChart(context.Background(), models.ChartAlbums, models.ChartWeekly, "4")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("GetChart-Invalid", func(t *testing.T) {
+		useCase := ChartUseCase{}
+
+		_, err := useCase.GetChart(context.Background(), "playlists", models.ChartWeekly, "")
+		assert.Error(t, err)
+		_, err = useCase.GetChart(context.Background(), models.ChartTracks, "yearly", "")
+		assert.Error(t, err)
+		_, err = useCase.GetChart(context.Background(), models.ChartTracks, models.ChartDaily, "rock")
+		assert.Error(t, err)
+	})
+}
+
+func TestAddPlay(t *testing.T) {
+	t.Run("AddPlay-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockRepository(ctrl)
+		m.EXPECT().AddPlay(gomock.Any(), "1", "5").Return(nil)
+
+		useCase := ChartUseCase{Repository: m}
+		assert.NoError(t, useCase.AddPlay(context.Background(), "1", "5"))
+	})
+
+	t.Run("AddPlay-InvalidTrack", func(t *testing.T) {
+		useCase := ChartUseCase{}
+		assert.Error(t, useCase.AddPlay(context.Background(), "1", "track"))
+	})
+}
+
+func TestRefresh(t *testing.T) {
+	// wednesday
+	now := time.Date(2020, 5, 13, 15, 30, 0, 0, time.UTC)
+
+	t.Run("Refresh-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockRepository(ctrl)
+		g := genre.NewMockRepository(ctrl)
+		g.EXPECT().GetGenres(gomock.Any()).Return([]models.Genre{{Id: "4", Name: "rock"}}, nil)
+
+		entries := []models.ChartEntry{{Position: 1, Id: "7", Plays: 3, Score: 3}}
+		periods := map[string]string{
+			models.ChartDaily:   "2020-05-13",
+			models.ChartWeekly:  "2020-05-11",
+			models.ChartMonthly: "2020-05-01",
+		}
+		for _, window := range chartWindows {
+			since := now.Add(-windowLength[window])
+			for _, chartType := range chartTypes {
+				for _, gID := range []string{"", "4"} {
+					m.EXPECT().Aggregate(gomock.Any(), chartType, gID, since, uint64(50)).Return(entries, nil)
+					m.EXPECT().SaveSnapshot(gomock.Any(), models.Chart{
+						Type:    chartType,
+						Window:  window,
+						GenreId: gID,
+						Period:  periods[window],
+						Entries: entries,
+					}).Return(nil)
+				}
+			}
+		}
+
+		useCase := ChartUseCase{Repository: m, GenreRepository: g, Size: 50}
+		assert.NoError(t, useCase.Refresh(context.Background(), now))
+	})
+
+	t.Run("Refresh-AggregateError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := chart.NewMockRepository(ctrl)
+		g := genre.NewMockRepository(ctrl)
+		g.EXPECT().GetGenres(gomock.Any()).Return(nil, nil)
+		m.EXPECT().Aggregate(gomock.Any(), models.ChartTracks, "", now.Add(-24*time.Hour), uint64(50)).Return(nil, errors.New("db error"))
+
+		useCase := ChartUseCase{Repository: m, GenreRepository: g, Size: 50}
+		assert.Error(t, useCase.Refresh(context.Background(), now))
+	})
+
+	t.Run("Refresh-GenresError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		g := genre.NewMockRepository(ctrl)
+		g.EXPECT().GetGenres(gomock.Any()).Return(nil, errors.New("db error"))
+
+		useCase := ChartUseCase{GenreRepository: g, Size: 50}
+		assert.Error(t, useCase.Refresh(context.Background(), now))
+	})
+}
+
+func TestPeriodStart(t *testing.T) {
+	sunday := time.Date(2020, 5, 17, 23, 59, 0, 0, time.UTC)
+	monday := time.Date(2020, 5, 11, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC), periodStart(sunday, models.ChartDaily))
+	assert.Equal(t, monday, periodStart(sunday, models.ChartWeekly))
+	assert.Equal(t, monday, periodStart(monday, models.ChartWeekly))
+	assert.Equal(t, time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC), periodStart(sunday, models.ChartMonthly))
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package chart is a generated GoMock package.
+package chart
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockUseCase is a mock of UseCase interface
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetChart mocks base method
+func (m *MockUseCase) GetChart(ctx context.Context, chartType, window, genreID string) (models.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChart", ctx, chartType, window, genreID)
+	ret0, _ := ret[0].(models.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChart indicates an expected call of GetChart
+func (mr *MockUseCaseMockRecorder) GetChart(ctx, chartType, window, genreID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChart", reflect.TypeOf((*MockUseCase)(nil).GetChart), ctx, chartType, window, genreID)
+}
+
+// AddPlay mocks base method
+func (m *MockUseCase) AddPlay(ctx context.Context, uID, tID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlay", ctx, uID, tID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlay indicates an expected call of AddPlay
+func (mr *MockUseCaseMockRecorder) AddPlay(ctx, uID, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlay", reflect.TypeOf((*MockUseCase)(nil).AddPlay), ctx, uID, tID)
+}
+
+// Refresh mocks base method
+func (m *MockUseCase) Refresh(ctx context.Context, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh
+func (mr *MockUseCaseMockRecorder) Refresh(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockUseCase)(nil).Refresh), ctx, now)
+}
@@ -1,6 +1,8 @@
 package csrf
 
+import "context"
+
 type Repository interface {
-	Add(token string, expire int64) error
-	Check(token string) error
+	Add(ctx context.Context, token string, expire int64) error
+	Check(ctx context.Context, token string) error
 }
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
 	"github.com/gomodule/redigo/redis"
 )
 
@@ -16,11 +18,16 @@ func NewRedisTokenManager(conn *redis.Pool) TokenManager {
 	}
 }
 
-func (sr *TokenManager) Add(token string, expire int64) error {
-	conn := sr.redisPool.Get()
+func (sr *TokenManager) Add(ctx context.Context, token string, expire int64) error {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
 	result, err := redis.String(conn.Do("SET", token, 1, "EX", expire))
 	if err != nil {
-		return errors.New("failed to write key: " + err.Error())
+		return fmt.Errorf("failed to write key: %w", err)
 	}
 	if result != "OK" {
 		return errors.New("result not OK")
@@ -28,9 +35,14 @@ func (sr *TokenManager) Add(token string, expire int64) error {
 	return nil
 }
 
-func (sr *TokenManager) Check(token string) error {
-	conn := sr.redisPool.Get()
-	_, err := redis.String(conn.Do("GET", token))
+func (sr *TokenManager) Check(ctx context.Context, token string) error {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = redis.String(conn.Do("GET", token))
 
 	if err != nil {
 		if err == redis.ErrNil {
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/alicebob/miniredis/v2"
 	"github.com/gomodule/redigo/redis"
@@ -50,7 +51,7 @@ func (s *Suite) TestAdd() {
 	token := "testToken"
 	expire := 3600
 
-	err := s.session.Add(token, int64(expire))
+	err := s.session.Add(context.Background(), token, int64(expire))
 	require.NoError(s.T(), err)
 
 	value, err := s.redisServer.Get(token)
@@ -66,7 +67,7 @@ func (s *Suite) TestAdd() {
 	//test on closed connection
 	s.redisServer.Close()
 
-	err = s.session.Add(token, int64(expire))
+	err = s.session.Add(context.Background(), token, int64(expire))
 	require.Error(s.T(), err)
 }
 
@@ -74,18 +75,18 @@ func (s *Suite) TestGetLoginBySessionID() {
 	token := "testToken"
 	require.NoError(s.T(), s.redisServer.Set(token, s.tokenValue))
 
-	err := s.session.Check(token)
+	err := s.session.Check(context.Background(), token)
 	require.Error(s.T(), err)
 
 	//test no token
 	newToken := "wer2v2v3"
 
-	err = s.session.Check(newToken)
+	err = s.session.Check(context.Background(), newToken)
 	require.NoError(s.T(), err)
 
 	//test on closed connection
 	s.redisServer.Close()
 
-	err = s.session.Check(token)
+	err = s.session.Check(context.Background(), token)
 	require.Error(s.T(), err)
 }
@@ -5,6 +5,7 @@
 package csrf
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -33,29 +34,29 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // Add mocks base method
-func (m *MockRepository) Add(token string, expire int64) error {
+func (m *MockRepository) Add(ctx context.Context, token string, expire int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", token, expire)
+	ret := m.ctrl.Call(m, "Add", ctx, token, expire)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Add indicates an expected call of Add
-func (mr *MockRepositoryMockRecorder) Add(token, expire interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Add(ctx, token, expire interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockRepository)(nil).Add), token, expire)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockRepository)(nil).Add), ctx, token, expire)
 }
 
 // Check mocks base method
-func (m *MockRepository) Check(token string) error {
+func (m *MockRepository) Check(ctx context.Context, token string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Check", token)
+	ret := m.ctrl.Call(m, "Check", ctx, token)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Check indicates an expected call of Check
-func (mr *MockRepositoryMockRecorder) Check(token interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Check(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockRepository)(nil).Check), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockRepository)(nil).Check), ctx, token)
 }
@@ -1,6 +1,8 @@
 package csrf
 
+import "context"
+
 type UseCase interface {
 	Create(sid string, timeStamp int64) (string, error)
-	Check(sid string, inputToken string) (bool, error)
+	Check(ctx context.Context, sid string, inputToken string) (bool, error)
 }
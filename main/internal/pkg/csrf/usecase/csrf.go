@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -28,7 +29,7 @@ func NewAesCryptHashToken(secret string, expireTime int64, blackList csrf.Reposi
 	key := []byte(secret)
 	_, err := aes.NewCipher(key)
 	if err != nil {
-		return CryptToken{}, fmt.Errorf("cipher problem %v", err)
+		return CryptToken{}, fmt.Errorf("cipher problem %w", err)
 	}
 	return CryptToken{Secret: key, ExpireTime: expireTime, BlackList: blackList}, nil
 }
@@ -61,7 +62,7 @@ func (tk *CryptToken) Create(sid string, timeStamp int64) (string, error) {
 	return token, nil
 }
 
-func (tk *CryptToken) Check(sid string, inputToken string) (bool, error) {
+func (tk *CryptToken) Check(ctx context.Context, sid string, inputToken string) (bool, error) {
 	block, err := aes.NewCipher(tk.Secret)
 	if err != nil {
 		return false, err
@@ -82,13 +83,13 @@ func (tk *CryptToken) Check(sid string, inputToken string) (bool, error) {
 	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
 	plaintext, err := aesgcm.Open(nil, nonce, ciphertext, nil)
 	if err != nil {
-		return false, fmt.Errorf("decrypt fail: %v", err)
+		return false, fmt.Errorf("decrypt fail: %w", err)
 	}
 
 	td := TokenData{}
 	err = json.Unmarshal(plaintext, &td)
 	if err != nil {
-		return false, fmt.Errorf("bad json: %v", err)
+		return false, fmt.Errorf("bad json: %w", err)
 	}
 
 	if time.Now().Unix()-td.TimeStamp > tk.ExpireTime {
@@ -97,13 +98,13 @@ func (tk *CryptToken) Check(sid string, inputToken string) (bool, error) {
 
 	expected := TokenData{SessionID: sid, TimeStamp: td.TimeStamp}
 
-	err = tk.BlackList.Check(inputToken)
+	err = tk.BlackList.Check(ctx, inputToken)
 
 	if td != expected || err != nil {
 		return false, nil
 	}
 
-	err = tk.BlackList.Add(inputToken, tk.ExpireTime)
+	err = tk.BlackList.Add(ctx, inputToken, tk.ExpireTime)
 	if err != nil {
 		return false, err
 	}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
@@ -36,14 +37,14 @@ func TestCSRF(t *testing.T) {
 		assert.NoError(t, err)
 
 		csrfRepo.EXPECT().
-			Check(token).
+			Check(gomock.Any(), token).
 			Return(nil)
 
 		csrfRepo.EXPECT().
-			Add(token, int64(expireTime)).
+			Add(gomock.Any(), token, int64(expireTime)).
 			Return(nil)
 
-		ok, err := cryptHashToken.Check(sID, token)
+		ok, err := cryptHashToken.Check(context.Background(), sID, token)
 		assert.NoError(t, err)
 		assert.Equal(t, ok, true)
 	})
@@ -61,10 +62,10 @@ func TestCSRF(t *testing.T) {
 		assert.NoError(t, err)
 
 		csrfRepo.EXPECT().
-			Check(token).
+			Check(gomock.Any(), token).
 			Return(testError)
 
-		ok, err := cryptHashToken.Check(sID, token)
+		ok, err := cryptHashToken.Check(context.Background(), sID, token)
 		assert.NoError(t, err)
 		assert.Equal(t, ok, false)
 	})
@@ -82,14 +83,14 @@ func TestCSRF(t *testing.T) {
 		assert.NoError(t, err)
 
 		csrfRepo.EXPECT().
-			Check(token).
+			Check(gomock.Any(), token).
 			Return(nil)
 
 		csrfRepo.EXPECT().
-			Add(token, int64(expireTime)).
+			Add(gomock.Any(), token, int64(expireTime)).
 			Return(testError)
 
-		ok, err := cryptHashToken.Check(sID, token)
+		ok, err := cryptHashToken.Check(context.Background(), sID, token)
 		assert.Error(t, err)
 		assert.Equal(t, ok, false)
 	})
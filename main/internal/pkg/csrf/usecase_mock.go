@@ -5,6 +5,7 @@
 package csrf
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -48,16 +49,16 @@ func (mr *MockUseCaseMockRecorder) Create(sid, timeStamp interface{}) *gomock.Ca
 }
 
 // Check mocks base method
-func (m *MockUseCase) Check(sid, inputToken string) (bool, error) {
+func (m *MockUseCase) Check(ctx context.Context, sid, inputToken string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Check", sid, inputToken)
+	ret := m.ctrl.Call(m, "Check", ctx, sid, inputToken)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Check indicates an expected call of Check
-func (mr *MockUseCaseMockRecorder) Check(sid, inputToken interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Check(ctx, sid, inputToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockUseCase)(nil).Check), sid, inputToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockUseCase)(nil).Check), ctx, sid, inputToken)
 }
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/jinzhu/gorm"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ctxDB binds every statement of gorm to ctx, gorm v1 itself has no context support
+type ctxDB struct {
+	ctx context.Context
+	db  *sql.DB
+}
+
+// startSpan traces a statement sent outside of a transaction, statements inside one
+// go straight to *sql.Tx and are covered by the span of the request only
+func (c ctxDB) startSpan(query string) (context.Context, trace.Span) {
+	operation := "SQL"
+	if fields := strings.Fields(query); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+	return tracing.Tracer().Start(c.ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationKey.String(operation),
+			semconv.DBStatementKey.String(query),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (c ctxDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := c.startSpan(query)
+	result, err := c.db.ExecContext(ctx, query, args...)
+	endSpan(span, err)
+	return result, err
+}
+
+func (c ctxDB) Prepare(query string) (*sql.Stmt, error) {
+	return c.db.PrepareContext(c.ctx, query)
+}
+
+func (c ctxDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := c.startSpan(query)
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	endSpan(span, err)
+	return rows, err
+}
+
+func (c ctxDB) QueryRow(query string, args ...interface{}) *sql.Row {
+	ctx, span := c.startSpan(query)
+	row := c.db.QueryRowContext(ctx, query, args...)
+	endSpan(span, nil)
+	return row
+}
+
+func (c ctxDB) Begin() (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, nil)
+}
+
+// BeginTx ignores the context passed by gorm.Begin, which is always context.Background
+func (c ctxDB) BeginTx(_ context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.db.BeginTx(c.ctx, opts)
+}
+
+// WithContext returns a handle to the same connection pool whose queries and transactions
+// are cancelled together with ctx and traced as children of its span
+func WithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	sqlDB, ok := db.CommonDB().(*sql.DB)
+	if !ok || ctx == nil {
+		return db
+	}
+	bound, err := gorm.Open(db.Dialect().GetName(), ctxDB{ctx: ctx, db: sqlDB})
+	if err != nil {
+		return db
+	}
+	return bound
+}
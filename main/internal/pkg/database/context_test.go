@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+func TestWithContext(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	gormDB, err := gorm.Open("postgres", db)
+	require.NoError(t, err)
+	gormDB.LogMode(false)
+
+	t.Run("WithContext-OK", func(t *testing.T) {
+		mock.ExpectExec("delete from tracks").
+			WithArgs("1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := WithContext(context.Background(), gormDB).Exec("delete from tracks where id = ?", "1").Error
+		require.NoError(t, err)
+	})
+
+	t.Run("WithContext-Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := WithContext(ctx, gormDB).Exec("delete from tracks where id = ?", "1").Error
+		require.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("WithContext-Transaction", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		tx := WithContext(ctx, gormDB).Begin()
+		require.True(t, errors.Is(tx.Error, context.Canceled))
+	})
+
+	t.Run("WithContext-InTransaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		tx := gormDB.Begin()
+		require.NoError(t, tx.Error)
+		require.Equal(t, tx, WithContext(context.Background(), tx))
+
+		mock.ExpectRollback()
+		require.NoError(t, tx.Rollback().Error)
+	})
+
+	t.Run("WithContext-Traced", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		prev := otel.GetTracerProvider()
+		otel.SetTracerProvider(tracing.NewProvider("test", sdktrace.WithSyncer(exporter)))
+		defer otel.SetTracerProvider(prev)
+
+		mock.ExpectExec("delete from tracks").
+			WithArgs("1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ctx, root := tracing.Tracer().Start(context.Background(), "root")
+		err := WithContext(ctx, gormDB).Exec("delete from tracks where id = ?", "1").Error
+		root.End()
+		require.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		require.Equal(t, "postgres DELETE", spans[0].Name)
+		require.Equal(t, root.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		require.Contains(t, spans[0].Attributes, semconv.DBStatementKey.String("delete from tracks where id = $1"))
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,53 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+type FeedHandler struct {
+	FeedUC feed.UseCase
+	Log    *logger.MainLogger
+}
+
+func (h *FeedHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "feed delivery", "GetFeed", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var count uint64
+	if countVar := r.URL.Query().Get("count"); countVar != "" {
+		var err error
+		count, err = strconv.ParseUint(countVar, 10, 32)
+		if err != nil {
+			h.Log.HttpInfo(r.Context(), "failed to parse count: "+err.Error(), http.StatusBadRequest)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	userFeed, err := h.FeedUC.GetFeed(r.Context(), user.Id, r.URL.Query().Get("cursor"), count)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get feed: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(userFeed); err != nil {
+		h.Log.LogWarning(r.Context(), "feed delivery", "GetFeed", "failed to encode json: "+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
@@ -0,0 +1,102 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var feedHandler FeedHandler
+
+var testUser = models.User{
+	Id:    "1",
+	Login: "test",
+}
+
+func init() {
+	feedHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Run("GetFeed-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockUseCase(ctrl)
+		feedHandler.FeedUC = m
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), testUser.Id, "abc", uint64(5)).
+			Return(models.Feed{
+				Items: []models.FeedItem{{
+					Type:      models.FeedAlbum,
+					Id:        "3",
+					Name:      "album",
+					Image:     "/img/3.png",
+					ActorId:   "4",
+					ActorName: "artist",
+					CreatedAt: "2020-05-12T10:00:00Z",
+				}},
+				NextCursor: "def",
+			}, nil)
+
+		apitest.New("GetFeed-OK").
+			Handler(middleware.AuthMiddlewareMock(feedHandler.GetFeed, true, testUser, "")).
+			Method("Get").
+			URL("/users/feed").
+			Query("cursor", "abc").
+			Query("count", "5").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"items":[{"type":"album","id":"3","name":"album","image":"/img/3.png","actor_id":"4","actor_name":"artist","created_at":"2020-05-12T10:00:00Z"}],"next_cursor":"def"}`).
+			End()
+	})
+
+	t.Run("GetFeed-WrongCount", func(t *testing.T) {
+		apitest.New("GetFeed-WrongCount").
+			Handler(middleware.AuthMiddlewareMock(feedHandler.GetFeed, true, testUser, "")).
+			Method("Get").
+			URL("/users/feed").
+			Query("count", "-1").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("GetFeed-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockUseCase(ctrl)
+		feedHandler.FeedUC = m
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), testUser.Id, "", uint64(0)).
+			Return(models.Feed{}, errors.New("wrong cursor"))
+
+		apitest.New("GetFeed-Error").
+			Handler(middleware.AuthMiddlewareMock(feedHandler.GetFeed, true, testUser, "")).
+			Method("Get").
+			URL("/users/feed").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetFeed-NoUser", func(t *testing.T) {
+		apitest.New("GetFeed-NoUser").
+			Handler(http.HandlerFunc(feedHandler.GetFeed)).
+			Method("Get").
+			URL("/users/feed").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
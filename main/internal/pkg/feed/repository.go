@@ -0,0 +1,27 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+// Cursor is the position of the last item of the previous page,
+// the zero Cursor points to the beginning of the feed
+// Cursor is the position of the last item of a page. Playlist items share the id of the playlist,
+// so the track id tells apart tracks added to one playlist at the same time, it is "0" for albums
+type Cursor struct {
+	CreatedAt time.Time
+	Type      string
+	Id        string
+	TrackId   string
+}
+
+func (c Cursor) IsZero() bool {
+	return c.CreatedAt.IsZero()
+}
+
+type Repository interface {
+	GetFeed(ctx context.Context, uID string, cursor Cursor, count uint64) ([]models.FeedItem, error)
+}
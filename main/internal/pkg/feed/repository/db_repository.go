@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+)
+
+type FeedItem struct {
+	Type      string    `gorm:"column:type"`
+	Id        uint64    `gorm:"column:id"`
+	Name      string    `gorm:"column:name"`
+	Image     string    `gorm:"column:image"`
+	ActorId   uint64    `gorm:"column:actor_id"`
+	ActorName string    `gorm:"column:actor_name"`
+	TrackId   *uint64   `gorm:"column:track_id"`
+	TrackName *string   `gorm:"column:track_name"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+type DbFeedRepository struct {
+	db *gorm.DB
+}
+
+func NewDbFeedRepository(database *gorm.DB) DbFeedRepository {
+	return DbFeedRepository{
+		db: database,
+	}
+}
+
+func (fr *DbFeedRepository) GetFeed(ctx context.Context, uID string, cursor feed.Cursor, count uint64) ([]models.FeedItem, error) {
+	var items []FeedItem
+
+	db := database.WithContext(ctx, fr.db).
+		Table("user_feed").
+		Where("user_id = ?", uID)
+
+	if !cursor.IsZero() {
+		db = db.Where("(created_at, type, id, coalesce(track_id, 0)) < (?, ?, ?, ?)",
+			cursor.CreatedAt, cursor.Type, cursor.Id, cursor.TrackId)
+	}
+
+	db = db.
+		Order("created_at desc, type desc, id desc, coalesce(track_id, 0) desc").
+		Limit(count).
+		Find(&items)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	result := make([]models.FeedItem, len(items))
+	for i, elem := range items {
+		result[i] = toModel(elem)
+	}
+	return result, nil
+}
+
+func toModel(item FeedItem) models.FeedItem {
+	result := models.FeedItem{
+		Type:      item.Type,
+		Id:        strconv.FormatUint(item.Id, 10),
+		Name:      item.Name,
+		Image:     item.Image,
+		ActorId:   strconv.FormatUint(item.ActorId, 10),
+		ActorName: item.ActorName,
+		CreatedAt: item.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+	if item.TrackId != nil {
+		result.TrackId = strconv.FormatUint(*item.TrackId, 10)
+	}
+	if item.TrackName != nil {
+		result.TrackName = *item.TrackName
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbFeedRepository
+	columns    []string
+	bdError    error
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.columns = []string{"user_id", "type", "id", "name", "image", "actor_id", "actor_name", "track_id", "track_name", "created_at"}
+	s.bdError = errors.New("some bd error")
+	s.repository = NewDbFeedRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestGetFeed() {
+	createdAt := time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	expected := []models.FeedItem{
+		{
+			Type:      models.FeedPlaylist,
+			Id:        "5",
+			Name:      "playlist",
+			Image:     "/img/5.png",
+			ActorId:   "2",
+			ActorName: "friend",
+			TrackId:   "7",
+			TrackName: "track",
+			CreatedAt: "2020-05-12T10:00:00Z",
+		},
+		{
+			Type:      models.FeedAlbum,
+			Id:        "3",
+			Name:      "album",
+			Image:     "/img/3.png",
+			ActorId:   "4",
+			ActorName: "artist",
+			CreatedAt: "2020-05-12T10:00:00Z",
+		},
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_feed" WHERE (user_id = $1) ORDER BY created_at desc, type desc, id desc, coalesce(track_id, 0) desc LIMIT 2`)).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows(s.columns).
+			AddRow(1, "playlist", 5, "playlist", "/img/5.png", 2, "friend", 7, "track", createdAt).
+			AddRow(1, "album", 3, "album", "/img/3.png", 4, "artist", nil, nil, createdAt))
+
+	items, err := s.repository.GetFeed(context.Background(), "1", feed.Cursor{}, 2)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(expected, items))
+}
+
+func (s *Suite) TestGetFeedAfterCursor() {
+	cursor := feed.Cursor{
+		CreatedAt: time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC),
+		Type:      models.FeedAlbum,
+		Id:        "3",
+		TrackId:   "0",
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_feed" WHERE (user_id = $1) AND ((created_at, type, id, coalesce(track_id, 0)) < ($2, $3, $4, $5)) `+
+		`ORDER BY created_at desc, type desc, id desc, coalesce(track_id, 0) desc LIMIT 2`)).
+		WithArgs("1", cursor.CreatedAt, cursor.Type, cursor.Id, cursor.TrackId).
+		WillReturnRows(sqlmock.NewRows(s.columns))
+
+	items, err := s.repository.GetFeed(context.Background(), "1", cursor, 2)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), items)
+
+	//test on bd error
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_feed"`)).
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.GetFeed(context.Background(), "1", cursor, 2)
+	require.Error(s.T(), err)
+}
+
+// tracks added to one playlist at the same time differ only by the track id
+func (s *Suite) TestGetFeedTiedPlaylistTracks() {
+	createdAt := time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC)
+	cursor := feed.Cursor{
+		CreatedAt: createdAt,
+		Type:      models.FeedPlaylist,
+		Id:        "5",
+		TrackId:   "9",
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`((created_at, type, id, coalesce(track_id, 0)) < ($2, $3, $4, $5))`)).
+		WithArgs("1", createdAt, models.FeedPlaylist, "5", "9").
+		WillReturnRows(sqlmock.NewRows(s.columns).
+			AddRow(1, "playlist", 5, "playlist", "/img/5.png", 2, "friend", 8, "eight", createdAt).
+			AddRow(1, "playlist", 5, "playlist", "/img/5.png", 2, "friend", 7, "seven", createdAt))
+
+	items, err := s.repository.GetFeed(context.Background(), "1", cursor, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 2)
+	require.Equal(s.T(), "8", items[0].TrackId)
+	require.Equal(s.T(), "7", items[1].TrackId)
+}
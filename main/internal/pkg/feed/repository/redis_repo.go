@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+)
+
+// RedisFeedRepository caches feed pages built by the wrapped repository for ttl seconds
+type RedisFeedRepository struct {
+	repository feed.Repository
+	redisPool  *redis.Pool
+	ttl        int64
+}
+
+func NewRedisFeedRepository(repository feed.Repository, conn *redis.Pool, ttl int64) RedisFeedRepository {
+	return RedisFeedRepository{
+		repository: repository,
+		redisPool:  conn,
+		ttl:        ttl,
+	}
+}
+
+func feedKey(uID string, cursor feed.Cursor, count uint64) string {
+	if cursor.IsZero() {
+		return fmt.Sprintf("feed:%s:first:%d", uID, count)
+	}
+	return fmt.Sprintf("feed:%s:%d:%s:%s:%s:%d", uID, cursor.CreatedAt.UnixNano(), cursor.Type, cursor.Id, cursor.TrackId, count)
+}
+
+// GetFeed falls back to the wrapped repository when redis is unavailable
+func (fr *RedisFeedRepository) GetFeed(ctx context.Context, uID string, cursor feed.Cursor, count uint64) ([]models.FeedItem, error) {
+	conn, err := tracing.RedisConn(ctx, fr.redisPool)
+	if err != nil {
+		return fr.repository.GetFeed(ctx, uID, cursor, count)
+	}
+	defer conn.Close()
+
+	key := feedKey(uID, cursor, count)
+
+	data, err := redis.Bytes(conn.Do("GET", key))
+	if err == nil {
+		var items []models.FeedItem
+		if err := json.Unmarshal(data, &items); err == nil {
+			return items, nil
+		}
+	}
+
+	items, err := fr.repository.GetFeed(ctx, uID, cursor, count)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(items); err == nil {
+		_, _ = conn.Do("SET", key, data, "EX", fr.ttl)
+	}
+	return items, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type RedisSuite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	redisConn   *redis.Pool
+	items       []models.FeedItem
+}
+
+func (s *RedisSuite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	s.redisConn = &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	s.items = []models.FeedItem{{Type: models.FeedTrack, Id: "1", Name: "track", CreatedAt: "2020-05-12T10:00:00Z"}}
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *RedisSuite) AfterTest(_, _ string) {
+	s.redisServer.Close()
+	s.SetupSuite()
+}
+
+func (s *RedisSuite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestRedisFeed(t *testing.T) {
+	suite.Run(t, new(RedisSuite))
+}
+
+func (s *RedisSuite) TestGetFeedCached() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	m := feed.NewMockRepository(ctrl)
+	repository := NewRedisFeedRepository(m, s.redisConn, 60)
+
+	m.EXPECT().GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(21)).Return(s.items, nil).Times(1)
+
+	items, err := repository.GetFeed(context.Background(), "1", feed.Cursor{}, 21)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), s.items, items)
+
+	//second call is served from cache
+	items, err = repository.GetFeed(context.Background(), "1", feed.Cursor{}, 21)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), s.items, items)
+
+	data, err := s.redisServer.Get("feed:1:first:21")
+	require.NoError(s.T(), err)
+	var cached []models.FeedItem
+	require.NoError(s.T(), json.Unmarshal([]byte(data), &cached))
+	require.Equal(s.T(), s.items, cached)
+
+	//test TTL
+	s.redisServer.FastForward(time.Minute)
+	require.False(s.T(), s.redisServer.Exists("feed:1:first:21"))
+}
+
+func (s *RedisSuite) TestGetFeedError() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	m := feed.NewMockRepository(ctrl)
+	repository := NewRedisFeedRepository(m, s.redisConn, 60)
+
+	cursor := feed.Cursor{CreatedAt: time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC), Type: models.FeedAlbum, Id: "3", TrackId: "0"}
+	m.EXPECT().GetFeed(gomock.Any(), "1", cursor, uint64(21)).Return(nil, errors.New("some bd error"))
+
+	_, err := repository.GetFeed(context.Background(), "1", cursor, 21)
+	require.Error(s.T(), err)
+	require.Empty(s.T(), s.redisServer.Keys())
+}
+
+func (s *RedisSuite) TestGetFeedRedisDown() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	m := feed.NewMockRepository(ctrl)
+	repository := NewRedisFeedRepository(m, s.redisConn, 60)
+
+	s.redisServer.Close()
+
+	m.EXPECT().GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(21)).Return(s.items, nil)
+
+	items, err := repository.GetFeed(context.Background(), "1", feed.Cursor{}, 21)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), s.items, items)
+}
@@ -0,0 +1,10 @@
+package feed
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type UseCase interface {
+	GetFeed(ctx context.Context, uID string, cursor string, count uint64) (models.Feed, error)
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+const (
+	DefaultCount = 20
+	MaxCount     = 100
+)
+
+type FeedUseCase struct {
+	Repository feed.Repository
+}
+
+// GetFeed returns count items after cursor, empty cursor means the first page
+func (uc *FeedUseCase) GetFeed(ctx context.Context, uID string, cursor string, count uint64) (models.Feed, error) {
+	if count == 0 {
+		count = DefaultCount
+	}
+	if count > MaxCount {
+		count = MaxCount
+	}
+
+	position, err := decodeCursor(cursor)
+	if err != nil {
+		return models.Feed{}, err
+	}
+
+	// one extra item tells if there is a next page
+	items, err := uc.Repository.GetFeed(ctx, uID, position, count+1)
+	if err != nil {
+		return models.Feed{}, err
+	}
+
+	result := models.Feed{Items: items}
+	if uint64(len(items)) > count {
+		result.Items = items[:count]
+		result.NextCursor = encodeCursor(items[count-1])
+	}
+	if result.Items == nil {
+		result.Items = []models.FeedItem{}
+	}
+	return result, nil
+}
+
+func encodeCursor(item models.FeedItem) string {
+	trackID := item.TrackId
+	if trackID == "" {
+		trackID = "0"
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(item.CreatedAt + "," + item.Type + "," + item.Id + "," + trackID))
+}
+
+func decodeCursor(cursor string) (feed.Cursor, error) {
+	if cursor == "" {
+		return feed.Cursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return feed.Cursor{}, apperrors.Errorf(apperrors.Validation, "wrong cursor: %w", err)
+	}
+	parts := strings.Split(string(data), ",")
+	if len(parts) == 3 {
+		// cursors issued before the track id was added
+		parts = append(parts, "0")
+	}
+	if len(parts) != 4 {
+		return feed.Cursor{}, apperrors.New(apperrors.Validation, "wrong cursor format")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return feed.Cursor{}, apperrors.Errorf(apperrors.Validation, "wrong cursor time: %w", err)
+	}
+
+	return feed.Cursor{
+		CreatedAt: createdAt,
+		Type:      parts[1],
+		Id:        parts[2],
+		TrackId:   parts[3],
+	}, nil
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/feed"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testItems(count int) []models.FeedItem {
+	items := make([]models.FeedItem, count)
+	for i := range items {
+		items[i] = models.FeedItem{
+			Type:      models.FeedTrack,
+			Id:        fmt.Sprint(count - i),
+			CreatedAt: time.Date(2020, 5, 12, 10, 0, count-i, 500, time.UTC).Format(time.RFC3339Nano),
+		}
+	}
+	return items
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Run("GetFeed-FirstPage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+		items := testItems(3)
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(3)).
+			Return(items, nil)
+
+		useCase := FeedUseCase{Repository: m}
+
+		result, err := useCase.GetFeed(context.Background(), "1", "", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, items[:2], result.Items)
+		assert.NotEmpty(t, result.NextCursor)
+
+		cursor, err := decodeCursor(result.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, feed.Cursor{
+			CreatedAt: time.Date(2020, 5, 12, 10, 0, 2, 500, time.UTC),
+			Type:      models.FeedTrack,
+			Id:        "2",
+			TrackId:   "0",
+		}, cursor)
+	})
+
+	t.Run("GetFeed-LastPage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+		items := testItems(1)
+		cursor := encodeCursor(models.FeedItem{Type: models.FeedAlbum, Id: "5", CreatedAt: "2020-05-12T10:00:00Z"})
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{
+				CreatedAt: time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC),
+				Type:      models.FeedAlbum,
+				Id:        "5",
+				TrackId:   "0",
+			}, uint64(DefaultCount+1)).
+			Return(items, nil)
+
+		useCase := FeedUseCase{Repository: m}
+
+		result, err := useCase.GetFeed(context.Background(), "1", cursor, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, items, result.Items)
+		assert.Empty(t, result.NextCursor)
+	})
+
+	t.Run("GetFeed-TiedPlaylistTracks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+		createdAt := "2020-05-12T10:00:00.5Z"
+		items := []models.FeedItem{
+			{Type: models.FeedPlaylist, Id: "5", TrackId: "9", CreatedAt: createdAt},
+			{Type: models.FeedPlaylist, Id: "5", TrackId: "8", CreatedAt: createdAt},
+			{Type: models.FeedPlaylist, Id: "5", TrackId: "7", CreatedAt: createdAt},
+		}
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(2)).
+			Return(items[:2], nil)
+		//the next page starts right after the track on the boundary, not after the whole playlist
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{
+				CreatedAt: time.Date(2020, 5, 12, 10, 0, 0, 5e8, time.UTC),
+				Type:      models.FeedPlaylist,
+				Id:        "5",
+				TrackId:   "9",
+			}, uint64(2)).
+			Return(items[1:], nil)
+
+		useCase := FeedUseCase{Repository: m}
+
+		first, err := useCase.GetFeed(context.Background(), "1", "", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, items[:1], first.Items)
+
+		second, err := useCase.GetFeed(context.Background(), "1", first.NextCursor, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, items[1:2], second.Items)
+	})
+
+	t.Run("GetFeed-OldCursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+		cursor := base64.RawURLEncoding.EncodeToString([]byte("2020-05-12T10:00:00Z,album,5"))
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{
+				CreatedAt: time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC),
+				Type:      models.FeedAlbum,
+				Id:        "5",
+				TrackId:   "0",
+			}, uint64(DefaultCount+1)).
+			Return(nil, nil)
+
+		useCase := FeedUseCase{Repository: m}
+
+		_, err := useCase.GetFeed(context.Background(), "1", cursor, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetFeed-Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(MaxCount+1)).
+			Return(nil, nil)
+
+		useCase := FeedUseCase{Repository: m}
+
+		result, err := useCase.GetFeed(context.Background(), "1", "", MaxCount+50)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.FeedItem{}, result.Items)
+	})
+
+	t.Run("GetFeed-WrongCursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+		useCase := FeedUseCase{Repository: m}
+
+		_, err := useCase.GetFeed(context.Background(), "1", "not a cursor!", 10)
+		assert.Error(t, err)
+
+		_, err = useCase.GetFeed(context.Background(), "1", encodeCursor(models.FeedItem{CreatedAt: "yesterday"}), 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("GetFeed-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := feed.NewMockRepository(ctrl)
+
+		m.EXPECT().
+			GetFeed(gomock.Any(), "1", feed.Cursor{}, uint64(11)).
+			Return(nil, errors.New("some bd error"))
+
+		useCase := FeedUseCase{Repository: m}
+
+		_, err := useCase.GetFeed(context.Background(), "1", "", 10)
+		assert.Error(t, err)
+	})
+}
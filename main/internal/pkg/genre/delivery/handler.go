@@ -0,0 +1,38 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+type GenreHandler struct {
+	GenreUC genre.UseCase
+	Log     *logger.MainLogger
+}
+
+func (h *GenreHandler) GetGenres(w http.ResponseWriter, r *http.Request) {
+	genres, err := h.GenreUC.GetGenres(r.Context())
+	if err != nil {
+		h.Log.LogError(r.Context(), "genre delivery", "GetGenres", err)
+		apperrors.WriteHTTP(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Genres []models.Genre `json:"genres"`
+	}{genres})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "genre delivery", "GetGenres", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
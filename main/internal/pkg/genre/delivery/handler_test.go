@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var genreHandler GenreHandler
+
+func init() {
+	genreHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestGetGenres(t *testing.T) {
+	t.Run("GetGenres-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := genre.NewMockUseCase(ctrl)
+		genreHandler.GenreUC = m
+
+		m.EXPECT().
+			GetGenres(gomock.Any()).
+			Return([]models.Genre{
+				{Id: "1", Name: "rock", Subgenres: []models.Genre{{Id: "4", Name: "grunge", ParentId: "1"}}},
+			}, nil)
+
+		apitest.New("GetGenres-OK").
+			Handler(http.HandlerFunc(genreHandler.GetGenres)).
+			Method("Get").
+			URL("/genres").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"genres":[{"id":"1","name":"rock","subgenres":[{"id":"4","name":"grunge","parent_id":"1"}]}]}`).
+			End()
+	})
+
+	t.Run("GetGenres-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := genre.NewMockUseCase(ctrl)
+		genreHandler.GenreUC = m
+
+		m.EXPECT().
+			GetGenres(gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		apitest.New("GetGenres-Error").
+			Handler(http.HandlerFunc(genreHandler.GetGenres)).
+			Method("Get").
+			URL("/genres").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
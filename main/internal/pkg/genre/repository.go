@@ -0,0 +1,13 @@
+package genre
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	GetGenres(ctx context.Context) ([]models.Genre, error)
+	CreateGenre(ctx context.Context, genre models.Genre) (string, error)
+	SetAlbumGenres(ctx context.Context, aID string, genres []string) error
+	SetTrackGenres(ctx context.Context, tID string, genres []string) error
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+)
+
+type Genres struct {
+	Id       uint64  `gorm:"column:id"`
+	Name     string  `gorm:"column:name"`
+	ParentId *uint64 `gorm:"column:parent_id"`
+}
+
+type DbGenreRepository struct {
+	db *gorm.DB
+}
+
+func NewDbGenreRepository(database *gorm.DB) DbGenreRepository {
+	return DbGenreRepository{
+		db: database,
+	}
+}
+
+func toModel(genre Genres) models.Genre {
+	result := models.Genre{
+		Id:   strconv.FormatUint(genre.Id, 10),
+		Name: genre.Name,
+	}
+	if genre.ParentId != nil {
+		result.ParentId = strconv.FormatUint(*genre.ParentId, 10)
+	}
+	return result
+}
+
+func (gr *DbGenreRepository) GetGenres(ctx context.Context) ([]models.Genre, error) {
+	var genres []Genres
+
+	db := database.WithContext(ctx, gr.db).
+		Table("genres").
+		Order("name").
+		Find(&genres)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get genres: %w", err)
+	}
+
+	result := make([]models.Genre, len(genres))
+	for i, elem := range genres {
+		result[i] = toModel(elem)
+	}
+	return result, nil
+}
+
+func (gr *DbGenreRepository) CreateGenre(ctx context.Context, genre models.Genre) (string, error) {
+	dbGenre := Genres{
+		Name: genre.Name,
+	}
+	if genre.ParentId != "" {
+		parentID, err := strconv.ParseUint(genre.ParentId, 10, 64)
+		if err != nil {
+			return "", apperrors.Errorf(apperrors.Validation, "failed to parse parent id: %w", err)
+		}
+		dbGenre.ParentId = &parentID
+	}
+
+	if err := database.WithContext(ctx, gr.db).Table("genres").Create(&dbGenre).Error; err != nil {
+		return "", fmt.Errorf("failed to create genre: %w", err)
+	}
+	return strconv.FormatUint(dbGenre.Id, 10), nil
+}
+
+func (gr *DbGenreRepository) SetAlbumGenres(ctx context.Context, aID string, genres []string) error {
+	return gr.setTags(ctx, "album_genres", "album_id", aID, genres)
+}
+
+func (gr *DbGenreRepository) SetTrackGenres(ctx context.Context, tID string, genres []string) error {
+	return gr.setTags(ctx, "track_genres", "track_id", tID, genres)
+}
+
+// setTags replaces all genre tags of an album or a track
+func (gr *DbGenreRepository) setTags(ctx context.Context, table string, column string, id string, genres []string) error {
+	tx := database.WithContext(ctx, gr.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	db := tx.Exec("delete from "+table+" where "+column+" = ?", id)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear %s: %w", table, err)
+	}
+
+	for _, gID := range genres {
+		db = tx.Exec("insert into "+table+" ("+column+", genre_id) values (?, ?)", id, gID)
+		if err := db.Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to insert into %s: %w", table, err)
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbGenreRepository
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.repository = NewDbGenreRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestGetGenres() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "genres" ORDER BY "name"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "parent_id"}).
+			AddRow(2, "grunge", 1).
+			AddRow(1, "rock", nil))
+
+	res, err := s.repository.GetGenres(context.Background())
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.Genre{
+		{Id: "2", Name: "grunge", ParentId: "1"},
+		{Id: "1", Name: "rock"},
+	}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetGenres(context.Background())
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestCreateGenre() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "genres"`).
+		WithArgs("grunge", uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	s.mock.ExpectCommit()
+
+	id, err := s.repository.CreateGenre(context.Background(), models.Genre{Name: "grunge", ParentId: "1"})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "2", id)
+
+	//test on duplicate name
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "genres"`).
+		WithArgs("rock", nil).
+		WillReturnError(errors.New("unique violation"))
+	s.mock.ExpectRollback()
+
+	_, err = s.repository.CreateGenre(context.Background(), models.Genre{Name: "rock"})
+	require.Error(s.T(), err)
+
+	//test on wrong parent id
+	_, err = s.repository.CreateGenre(context.Background(), models.Genre{Name: "rock", ParentId: "abc"})
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestSetAlbumGenres() {
+	aID := "5"
+	genres := []string{"1", "2"}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("delete from album_genres where album_id = $1")).
+		WithArgs(aID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, gID := range genres {
+		s.mock.ExpectExec(regexp.QuoteMeta("insert into album_genres (album_id, genre_id) values ($1, $2)")).
+			WithArgs(aID, gID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.SetAlbumGenres(context.Background(), aID, genres))
+}
+
+func (s *Suite) TestSetTrackGenres() {
+	tID := "7"
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("delete from track_genres where track_id = $1")).
+		WithArgs(tID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("insert into track_genres").
+		WithArgs(tID, "9").
+		WillReturnError(errors.New("fk violation"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.SetTrackGenres(context.Background(), tID, []string{"9"}))
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+// RedisGenreRepository keeps the catalog cache in step with genre tags, cached genre pages
+// of albums, tracks and artists are built from them. Genres themselves are read through
+type RedisGenreRepository struct {
+	genre.Repository
+	cache *cache.Cache
+	log   *logger.MainLogger
+}
+
+func NewRedisGenreRepository(repository genre.Repository, cache *cache.Cache, log *logger.MainLogger) RedisGenreRepository {
+	return RedisGenreRepository{
+		Repository: repository,
+		cache:      cache,
+		log:        log,
+	}
+}
+
+func (gr *RedisGenreRepository) SetAlbumGenres(ctx context.Context, aID string, genres []string) error {
+	if err := gr.Repository.SetAlbumGenres(ctx, aID, genres); err != nil {
+		return err
+	}
+	gr.invalidate(ctx)
+	return nil
+}
+
+func (gr *RedisGenreRepository) SetTrackGenres(ctx context.Context, tID string, genres []string) error {
+	if err := gr.Repository.SetTrackGenres(ctx, tID, genres); err != nil {
+		return err
+	}
+	gr.invalidate(ctx)
+	return nil
+}
+
+// invalidate never fails the write, it is committed already. The stale entries expire after ttl
+func (gr *RedisGenreRepository) invalidate(ctx context.Context) {
+	if err := gr.cache.Invalidate(ctx); err != nil {
+		gr.log.LogWarning(ctx, "genre repository", "invalidate", "genres are saved, but the cache is stale: "+err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisGenreRepository(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	defer redisServer.Close()
+
+	addr := redisServer.Addr()
+	redisConn := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := genre.NewMockRepository(ctrl)
+	albums := cache.New(redisConn, "albums", time.Minute)
+	repository := NewRedisGenreRepository(m, cache.New(redisConn, "genres", time.Minute), logger.NewLogger(os.Stdout))
+
+	loads := 0
+	load := func() (interface{}, error) {
+		loads++
+		return []string{"album"}, nil
+	}
+	var result []string
+	for i := 0; i < 2; i++ {
+		require.NoError(t, albums.Get(context.Background(), "genre:1:0:10", &result, load))
+	}
+	require.Equal(t, 1, loads)
+
+	//tagging an album drops cached genre pages of every catalog cache
+	m.EXPECT().SetAlbumGenres(gomock.Any(), "5", []string{"1"}).Return(nil)
+	require.NoError(t, repository.SetAlbumGenres(context.Background(), "5", []string{"1"}))
+	require.NoError(t, albums.Get(context.Background(), "genre:1:0:10", &result, load))
+	require.Equal(t, 2, loads)
+
+	m.EXPECT().SetTrackGenres(gomock.Any(), "7", []string{"1"}).Return(nil)
+	require.NoError(t, repository.SetTrackGenres(context.Background(), "7", []string{"1"}))
+	require.NoError(t, albums.Get(context.Background(), "genre:1:0:10", &result, load))
+	require.Equal(t, 3, loads)
+
+	//a failed invalidation doesn't fail the saved tags
+	redisServer.Close()
+	m.EXPECT().SetTrackGenres(gomock.Any(), "7", []string{"2"}).Return(nil)
+	require.NoError(t, repository.SetTrackGenres(context.Background(), "7", []string{"2"}))
+}
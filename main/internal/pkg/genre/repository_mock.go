@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package genre is a generated GoMock package.
+package genre
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRepository is a mock of Repository interface
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetGenres mocks base method
+func (m *MockRepository) GetGenres(ctx context.Context) ([]models.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenres", ctx)
+	ret0, _ := ret[0].([]models.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenres indicates an expected call of GetGenres
+func (mr *MockRepositoryMockRecorder) GetGenres(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenres", reflect.TypeOf((*MockRepository)(nil).GetGenres), ctx)
+}
+
+// CreateGenre mocks base method
+func (m *MockRepository) CreateGenre(ctx context.Context, genre models.Genre) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGenre", ctx, genre)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGenre indicates an expected call of CreateGenre
+func (mr *MockRepositoryMockRecorder) CreateGenre(ctx, genre interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockRepository)(nil).CreateGenre), ctx, genre)
+}
+
+// SetAlbumGenres mocks base method
+func (m *MockRepository) SetAlbumGenres(ctx context.Context, aID string, genres []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAlbumGenres", ctx, aID, genres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAlbumGenres indicates an expected call of SetAlbumGenres
+func (mr *MockRepositoryMockRecorder) SetAlbumGenres(ctx, aID, genres interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlbumGenres", reflect.TypeOf((*MockRepository)(nil).SetAlbumGenres), ctx, aID, genres)
+}
+
+// SetTrackGenres mocks base method
+func (m *MockRepository) SetTrackGenres(ctx context.Context, tID string, genres []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackGenres", ctx, tID, genres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrackGenres indicates an expected call of SetTrackGenres
+func (mr *MockRepositoryMockRecorder) SetTrackGenres(ctx, tID, genres interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackGenres", reflect.TypeOf((*MockRepository)(nil).SetTrackGenres), ctx, tID, genres)
+}
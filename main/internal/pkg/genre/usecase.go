@@ -0,0 +1,10 @@
+package genre
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type UseCase interface {
+	GetGenres(ctx context.Context) ([]models.Genre, error)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type GenreUseCase struct {
+	Repository genre.Repository
+}
+
+// GetGenres returns top level genres with subgenres nested into them
+func (uc *GenreUseCase) GetGenres(ctx context.Context) ([]models.Genre, error) {
+	genres, err := uc.Repository.GetGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]models.Genre)
+	for _, elem := range genres {
+		children[elem.ParentId] = append(children[elem.ParentId], elem)
+	}
+	return buildTree(children, ""), nil
+}
+
+func buildTree(children map[string][]models.Genre, parentID string) []models.Genre {
+	level := children[parentID]
+	result := make([]models.Genre, len(level))
+	for i, elem := range level {
+		elem.Subgenres = buildTree(children, elem.Id)
+		if len(elem.Subgenres) == 0 {
+			elem.Subgenres = nil
+		}
+		result[i] = elem
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/genre"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetGenres(t *testing.T) {
+	t.Run("GetGenres-Tree", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := genre.NewMockRepository(ctrl)
+		m.EXPECT().
+			GetGenres(gomock.Any()).
+			Return([]models.Genre{
+				{Id: "4", Name: "grunge", ParentId: "1"},
+				{Id: "3", Name: "jazz"},
+				{Id: "5", Name: "post-grunge", ParentId: "4"},
+				{Id: "1", Name: "rock"},
+			}, nil)
+
+		useCase := GenreUseCase{Repository: m}
+
+		result, err := useCase.GetGenres(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []models.Genre{
+			{Id: "3", Name: "jazz"},
+			{Id: "1", Name: "rock", Subgenres: []models.Genre{
+				{Id: "4", Name: "grunge", ParentId: "1", Subgenres: []models.Genre{
+					{Id: "5", Name: "post-grunge", ParentId: "4"},
+				}},
+			}},
+		}, result)
+	})
+
+	t.Run("GetGenres-Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := genre.NewMockRepository(ctrl)
+		m.EXPECT().
+			GetGenres(gomock.Any()).
+			Return([]models.Genre{}, nil)
+
+		useCase := GenreUseCase{Repository: m}
+
+		result, err := useCase.GetGenres(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []models.Genre{}, result)
+	})
+
+	t.Run("GetGenres-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := genre.NewMockRepository(ctrl)
+		m.EXPECT().
+			GetGenres(gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		useCase := GenreUseCase{Repository: m}
+
+		_, err := useCase.GetGenres(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a dependency is able to serve requests
+type Check func(ctx context.Context) error
+
+type Checker struct {
+	timeout  time.Duration
+	names    []string
+	checks   map[string]Check
+	draining int32
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+		checks:  make(map[string]Check),
+	}
+}
+
+// Add registers check of the dependency name, checks are added before serving and never removed
+func (c *Checker) Add(name string, check Check) {
+	c.names = append(c.names, name)
+	c.checks[name] = check
+}
+
+// Drain fails readiness from now on, so balancers stop sending requests while in-flight ones finish
+func (c *Checker) Drain() {
+	atomic.StoreInt32(&c.draining, 1)
+}
+
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func writeReport(w http.ResponseWriter, code int, rep report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(rep)
+}
+
+// Liveness answers as long as the process is able to handle requests, dependencies are not checked,
+// so an outage of postgres doesn't make the orchestrator restart every replica
+func (c *Checker) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, report{Status: "ok"})
+}
+
+// Readiness runs all checks concurrently within the timeout of the checker
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&c.draining) == 1 {
+		writeReport(w, http.StatusServiceUnavailable, report{Status: "draining"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), c.timeout)
+	defer cancel()
+
+	results := make(map[string]string, len(c.names))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, name := range c.names {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			result := "ok"
+			if err := check(ctx); err != nil {
+				result = err.Error()
+			}
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}(name, c.checks[name])
+	}
+	wg.Wait()
+
+	rep := report{Status: "ok", Checks: results}
+	code := http.StatusOK
+	for _, result := range results {
+		if result != "ok" {
+			rep.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+			break
+		}
+	}
+	writeReport(w, code, rep)
+}
@@ -0,0 +1,159 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/steinfletcher/apitest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func ok(context.Context) error {
+	return nil
+}
+
+func TestChecker(t *testing.T) {
+	t.Run("Liveness-OK", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", func(context.Context) error { return errors.New("down") })
+
+		apitest.New("Liveness-OK").
+			HandlerFunc(checker.Liveness).
+			Method("Get").
+			URL("/healthz").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"status":"ok"}`).
+			End()
+	})
+
+	t.Run("Readiness-OK", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", ok)
+		checker.Add("redis", ok)
+
+		apitest.New("Readiness-OK").
+			HandlerFunc(checker.Readiness).
+			Method("Get").
+			URL("/readyz").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"status":"ok","checks":{"postgres":"ok","redis":"ok"}}`).
+			End()
+	})
+
+	t.Run("Readiness-Unavailable", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", ok)
+		checker.Add("redis", func(context.Context) error { return errors.New("connection refused") })
+
+		apitest.New("Readiness-Unavailable").
+			HandlerFunc(checker.Readiness).
+			Method("Get").
+			URL("/readyz").
+			Expect(t).
+			Status(http.StatusServiceUnavailable).
+			Body(`{"status":"unavailable","checks":{"postgres":"ok","redis":"connection refused"}}`).
+			End()
+	})
+
+	t.Run("Readiness-Timeout", func(t *testing.T) {
+		checker := NewChecker(10 * time.Millisecond)
+		checker.Add("sessions", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		apitest.New("Readiness-Timeout").
+			HandlerFunc(checker.Readiness).
+			Method("Get").
+			URL("/readyz").
+			Expect(t).
+			Status(http.StatusServiceUnavailable).
+			Body(`{"status":"unavailable","checks":{"sessions":"context deadline exceeded"}}`).
+			End()
+	})
+
+	t.Run("Readiness-Draining", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", ok)
+		checker.Drain()
+
+		apitest.New("Readiness-Draining").
+			HandlerFunc(checker.Readiness).
+			Method("Get").
+			URL("/readyz").
+			Expect(t).
+			Status(http.StatusServiceUnavailable).
+			Body(`{"status":"draining"}`).
+			End()
+	})
+}
+
+func TestDB(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectPing()
+	assert.NoError(t, DB(db)(context.Background()))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	assert.Error(t, DB(db)(context.Background()))
+}
+
+func TestRedis(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := mr.Addr()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	assert.NoError(t, Redis(pool)(context.Background()))
+
+	mr.Close()
+	assert.Error(t, Redis(pool)(context.Background()))
+}
+
+func TestGRPC(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	assert.NoError(t, GRPC(conn)(context.Background()))
+
+	healthServer.Shutdown()
+	assert.EqualError(t, GRPC(conn)(context.Background()), "status NOT_SERVING")
+}
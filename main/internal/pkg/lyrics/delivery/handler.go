@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+)
+
+type LyricsHandler struct {
+	LyricsUC lyrics.UseCase
+	Log      *logger.MainLogger
+}
+
+func (h *LyricsHandler) GetLyrics(w http.ResponseWriter, r *http.Request) {
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.LyricsUC.GetLyrics(r.Context(), id, r.URL.Query().Get("plain") == "true")
+	if err != nil {
+		h.sendError(w, r, err, "failed to get lyrics: ")
+		return
+	}
+	h.sendLyrics(w, r, "GetLyrics", result)
+}
+
+func (h *LyricsHandler) SetLyrics(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.getUserAndID(w, r, "SetLyrics")
+	if !ok {
+		return
+	}
+	input := models.LyricsInput{}
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	result, err := h.LyricsUC.SetLyrics(r.Context(), user, id, input)
+	if err != nil {
+		h.sendError(w, r, err, "failed to set lyrics: ")
+		return
+	}
+	h.sendLyrics(w, r, "SetLyrics", result)
+}
+
+func (h *LyricsHandler) DeleteLyrics(w http.ResponseWriter, r *http.Request) {
+	user, id, ok := h.getUserAndID(w, r, "DeleteLyrics")
+	if !ok {
+		return
+	}
+
+	if err := h.LyricsUC.DeleteLyrics(r.Context(), user, id); err != nil {
+		h.sendError(w, r, err, "failed to delete lyrics: ")
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *LyricsHandler) getUserAndID(w http.ResponseWriter, r *http.Request, funcName string) (models.User, string, bool) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return models.User{}, "", false
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "lyrics delivery", funcName, "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return models.User{}, "", false
+	}
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return models.User{}, "", false
+	}
+	return user, id, true
+}
+
+func (h *LyricsHandler) sendError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	h.Log.HttpInfo(r.Context(), msg+err.Error(), apperrors.WriteHTTP(w, err))
+}
+
+func (h *LyricsHandler) sendLyrics(w http.ResponseWriter, r *http.Request, funcName string, result models.Lyrics) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.Log.LogWarning(r.Context(), "lyrics delivery", funcName, "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
@@ -0,0 +1,217 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var lyricsHandler LyricsHandler
+
+var testUser = models.User{Id: "2", Role: models.RoleArtist, ArtistId: "7"}
+
+func init() {
+	lyricsHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestGetLyrics(t *testing.T) {
+	t.Run("GetLyrics-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		start := uint64(0)
+		m.EXPECT().
+			GetLyrics(gomock.Any(), "5", false).
+			Return(models.Lyrics{
+				TrackId: "5",
+				Synced:  true,
+				Lines:   []models.LyricsLine{{TimeMs: &start, Text: "first"}},
+			}, nil)
+
+		apitest.New("GetLyrics-OK").
+			Handler(middleware.SetMuxVars(lyricsHandler.GetLyrics, "id", "5")).
+			Method("Get").
+			URL("/tracks/5/lyrics").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"track_id":"5","synced":true,"lines":[{"time_ms":0,"text":"first"}]}`).
+			End()
+	})
+
+	t.Run("GetLyrics-Plain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().
+			GetLyrics(gomock.Any(), "5", true).
+			Return(models.Lyrics{TrackId: "5", Lines: []models.LyricsLine{{Text: "first"}}}, nil)
+
+		apitest.New("GetLyrics-Plain").
+			Handler(middleware.SetMuxVars(lyricsHandler.GetLyrics, "id", "5")).
+			Method("Get").
+			URL("/tracks/5/lyrics").
+			Query("plain", "true").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"track_id":"5","synced":false,"lines":[{"text":"first"}]}`).
+			End()
+	})
+
+	t.Run("GetLyrics-NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().GetLyrics(gomock.Any(), "5", false).Return(models.Lyrics{}, lyrics.ErrNoLyrics)
+
+		apitest.New("GetLyrics-NotFound").
+			Handler(middleware.SetMuxVars(lyricsHandler.GetLyrics, "id", "5")).
+			Method("Get").
+			URL("/tracks/5/lyrics").
+			Expect(t).
+			Status(http.StatusNotFound).
+			End()
+	})
+}
+
+func TestSetLyrics(t *testing.T) {
+	t.Run("SetLyrics-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().
+			SetLyrics(gomock.Any(), testUser, "5", models.LyricsInput{Text: "first"}).
+			Return(models.Lyrics{TrackId: "5", Lines: []models.LyricsLine{{Text: "first"}}}, nil)
+
+		apitest.New("SetLyrics-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.SetLyrics, "id", "5"), true, testUser, "")).
+			Method("Put").
+			URL("/tracks/5/lyrics").
+			Body(`{"text":"first"}`).
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"track_id":"5","synced":false,"lines":[{"text":"first"}]}`).
+			End()
+	})
+
+	t.Run("SetLyrics-NotOwner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().
+			SetLyrics(gomock.Any(), testUser, "5", models.LyricsInput{Text: "first"}).
+			Return(models.Lyrics{}, lyrics.ErrNotOwner)
+
+		apitest.New("SetLyrics-NotOwner").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.SetLyrics, "id", "5"), true, testUser, "")).
+			Method("Put").
+			URL("/tracks/5/lyrics").
+			Body(`{"text":"first"}`).
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	t.Run("SetLyrics-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().
+			SetLyrics(gomock.Any(), testUser, "5", models.LyricsInput{Text: "first"}).
+			Return(models.Lyrics{}, apperrors.New(apperrors.Validation, "lyrics must be at most 20000 characters"))
+
+		apitest.New("SetLyrics-Invalid").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.SetLyrics, "id", "5"), true, testUser, "")).
+			Method("Put").
+			URL("/tracks/5/lyrics").
+			Body(`{"text":"first"}`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("SetLyrics-BadJSON", func(t *testing.T) {
+		apitest.New("SetLyrics-BadJSON").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.SetLyrics, "id", "5"), true, testUser, "")).
+			Method("Put").
+			URL("/tracks/5/lyrics").
+			Body(`{"text":`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("SetLyrics-NoCSRF", func(t *testing.T) {
+		apitest.New("SetLyrics-NoCSRF").
+			Handler(middleware.SetMuxVars(lyricsHandler.SetLyrics, "id", "5")).
+			Method("Put").
+			URL("/tracks/5/lyrics").
+			Body(`{"text":"first"}`).
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
+
+func TestDeleteLyrics(t *testing.T) {
+	t.Run("DeleteLyrics-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().DeleteLyrics(gomock.Any(), testUser, "5").Return(nil)
+
+		apitest.New("DeleteLyrics-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.DeleteLyrics, "id", "5"), true, testUser, "")).
+			Method("Delete").
+			URL("/tracks/5/lyrics").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("DeleteLyrics-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockUseCase(ctrl)
+		lyricsHandler.LyricsUC = m
+
+		m.EXPECT().DeleteLyrics(gomock.Any(), testUser, "5").Return(errors.New("db error"))
+
+		apitest.New("DeleteLyrics-Error").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(lyricsHandler.DeleteLyrics, "id", "5"), true, testUser, "")).
+			Method("Delete").
+			URL("/tracks/5/lyrics").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
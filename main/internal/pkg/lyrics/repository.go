@@ -0,0 +1,13 @@
+package lyrics
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	GetLyrics(ctx context.Context, tID string) (string, error)
+	SetLyrics(ctx context.Context, tID string, uID string, text string, plainText string) error
+	DeleteLyrics(ctx context.Context, tID string) error
+	Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+)
+
+type Lyrics struct {
+	TrackId uint64 `gorm:"column:track_id"`
+	Lyrics  string `gorm:"column:lyrics"`
+}
+
+type LyricsSearch struct {
+	Id        uint64 `gorm:"column:track_id"`
+	Name      string `gorm:"column:track_name"`
+	Artist    string `gorm:"column:artist_name"`
+	ArtistID  uint64 `gorm:"column:artist_id"`
+	Image     string `gorm:"column:track_image"`
+	PlainText string `gorm:"column:plain_text"`
+}
+
+type DbLyricsRepository struct {
+	db *gorm.DB
+}
+
+func NewDbLyricsRepository(database *gorm.DB) DbLyricsRepository {
+	return DbLyricsRepository{
+		db: database,
+	}
+}
+
+func (lr *DbLyricsRepository) GetLyrics(ctx context.Context, tID string) (string, error) {
+	var result Lyrics
+
+	db := database.WithContext(ctx, lr.db).
+		Table("track_lyrics").
+		Where("track_id = ?", tID).
+		First(&result)
+
+	if gorm.IsRecordNotFoundError(db.Error) {
+		return "", lyrics.ErrNoLyrics
+	}
+	if err := db.Error; err != nil {
+		return "", fmt.Errorf("failed to get lyrics: %w", err)
+	}
+	return result.Lyrics, nil
+}
+
+func (lr *DbLyricsRepository) SetLyrics(ctx context.Context, tID string, uID string, text string, plainText string) error {
+	db := database.WithContext(ctx, lr.db).Exec("insert into track_lyrics (track_id, lyrics, plain_text, updated_by) values (?, ?, ?, ?) "+
+		"on conflict (track_id) do update set lyrics = excluded.lyrics, plain_text = excluded.plain_text, "+
+		"updated_by = excluded.updated_by, updated_at = now()",
+		tID, text, plainText, uID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to set lyrics: %w", err)
+	}
+	return nil
+}
+
+func (lr *DbLyricsRepository) DeleteLyrics(ctx context.Context, tID string) error {
+	db := database.WithContext(ctx, lr.db).Exec("delete from track_lyrics where track_id = ?", tID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to delete lyrics: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return lyrics.ErrNoLyrics
+	}
+	return nil
+}
+
+// Search finds tracks whose lyrics contain the words of the text in the same order
+func (lr *DbLyricsRepository) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
+	var tracks []LyricsSearch
+
+	db := database.WithContext(ctx, lr.db).
+		Table("full_track_info f").
+		Select("f.track_id, f.track_name, f.artist_name, f.artist_id, f.track_image, l.plain_text").
+		Joins("JOIN track_lyrics l ON l.track_id = f.track_id").
+		Where("to_tsvector('simple', l.plain_text) @@ phraseto_tsquery('simple', ?)", text).
+		Limit(count).
+		Scan(&tracks)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to search lyrics: %w", err)
+	}
+
+	result := make([]models.TrackSearch, len(tracks))
+	for i, elem := range tracks {
+		result[i] = models.TrackSearch{
+			TrackID:    strconv.FormatUint(elem.Id, 10),
+			TrackName:  elem.Name,
+			ArtistName: elem.Artist,
+			ArtistID:   strconv.FormatUint(elem.ArtistID, 10),
+			Image:      elem.Image,
+			Lyrics:     matchedLine(elem.PlainText, text),
+		}
+	}
+	return result, nil
+}
+
+// matchedLine picks the line of lyrics to show next to a found track
+func matchedLine(plainText string, text string) string {
+	lines := strings.Split(plainText, "\n")
+	words := strings.Fields(strings.ToLower(text))
+
+	best, bestWords := "", 0
+	for _, line := range lines {
+		lower := strings.ToLower(line)
+		if strings.Contains(lower, strings.Join(words, " ")) {
+			return line
+		}
+		found := 0
+		for _, word := range words {
+			if strings.Contains(lower, word) {
+				found++
+			}
+		}
+		if found > bestWords {
+			best, bestWords = line, found
+		}
+	}
+	return best
+}
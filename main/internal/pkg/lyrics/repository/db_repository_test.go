@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbLyricsRepository
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.repository = NewDbLyricsRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestGetLyrics() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "track_lyrics" WHERE (track_id = $1) LIMIT 1`)).
+		WithArgs("5").
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "lyrics"}).AddRow(5, "[00:01.00]first"))
+
+	text, err := s.repository.GetLyrics(context.Background(), "5")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "[00:01.00]first", text)
+
+	//test on missing lyrics
+	s.mock.ExpectQuery("SELECT").
+		WithArgs("6").
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "lyrics"}))
+
+	_, err = s.repository.GetLyrics(context.Background(), "6")
+	require.Equal(s.T(), lyrics.ErrNoLyrics, err)
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetLyrics(context.Background(), "6")
+	require.Error(s.T(), err)
+	require.NotEqual(s.T(), lyrics.ErrNoLyrics, err)
+}
+
+func (s *Suite) TestSetLyrics() {
+	s.mock.ExpectExec(regexp.QuoteMeta("insert into track_lyrics (track_id, lyrics, plain_text, updated_by) values ($1, $2, $3, $4) on conflict (track_id) do update")).
+		WithArgs("5", "[00:01.00]first", "first", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.SetLyrics(context.Background(), "5", "1", "[00:01.00]first", "first"))
+
+	//test on db error
+	s.mock.ExpectExec("insert into track_lyrics").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.SetLyrics(context.Background(), "5", "1", "[00:01.00]first", "first"))
+}
+
+func (s *Suite) TestDeleteLyrics() {
+	s.mock.ExpectExec(regexp.QuoteMeta("delete from track_lyrics where track_id = $1")).
+		WithArgs("5").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.DeleteLyrics(context.Background(), "5"))
+
+	//test on missing lyrics
+	s.mock.ExpectExec("delete from track_lyrics").
+		WithArgs("6").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Equal(s.T(), lyrics.ErrNoLyrics, s.repository.DeleteLyrics(context.Background(), "6"))
+
+	//test on db error
+	s.mock.ExpectExec("delete from track_lyrics").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.DeleteLyrics(context.Background(), "6"))
+}
+
+func (s *Suite) TestSearch() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT f.track_id, f.track_name, f.artist_name, f.artist_id, f.track_image, l.plain_text ` +
+		`FROM full_track_info f JOIN track_lyrics l ON l.track_id = f.track_id ` +
+		`WHERE (to_tsvector('simple', l.plain_text) @@ phraseto_tsquery('simple', $1)) LIMIT 5`)).
+		WithArgs("yellow submarine").
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "artist_id", "track_image", "plain_text"}).
+			AddRow(5, "Yellow Submarine", "The Beatles", 2, "/img.png",
+				"In the town where I was born\nWe all live in a Yellow Submarine\nYellow submarine, yellow submarine"))
+
+	res, err := s.repository.Search(context.Background(), "yellow submarine", 5)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.TrackSearch{
+		{
+			TrackID:    "5",
+			TrackName:  "Yellow Submarine",
+			ArtistName: "The Beatles",
+			ArtistID:   "2",
+			Image:      "/img.png",
+			Lyrics:     "We all live in a Yellow Submarine",
+		},
+	}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.Search(context.Background(), "yellow submarine", 5)
+	require.Error(s.T(), err)
+}
+
+func TestMatchedLine(t *testing.T) {
+	text := "first line\nsecond Line here\nthird"
+
+	require.Equal(t, "second Line here", matchedLine(text, "line HERE"))
+	require.Equal(t, "second Line here", matchedLine(text, "here second"))
+	require.Equal(t, "", matchedLine(text, "missing"))
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package lyrics is a generated GoMock package.
+package lyrics
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRepository is a mock of Repository interface
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetLyrics mocks base method
+func (m *MockRepository) GetLyrics(ctx context.Context, tID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", ctx, tID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLyrics indicates an expected call of GetLyrics
+func (mr *MockRepositoryMockRecorder) GetLyrics(ctx, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockRepository)(nil).GetLyrics), ctx, tID)
+}
+
+// SetLyrics mocks base method
+func (m *MockRepository) SetLyrics(ctx context.Context, tID, uID, text, plainText string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLyrics", ctx, tID, uID, text, plainText)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLyrics indicates an expected call of SetLyrics
+func (mr *MockRepositoryMockRecorder) SetLyrics(ctx, tID, uID, text, plainText interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLyrics", reflect.TypeOf((*MockRepository)(nil).SetLyrics), ctx, tID, uID, text, plainText)
+}
+
+// DeleteLyrics mocks base method
+func (m *MockRepository) DeleteLyrics(ctx context.Context, tID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLyrics", ctx, tID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics
+func (mr *MockRepositoryMockRecorder) DeleteLyrics(ctx, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockRepository)(nil).DeleteLyrics), ctx, tID)
+}
+
+// Search mocks base method
+func (m *MockRepository) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
+	ret0, _ := ret[0].([]models.TrackSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockRepositoryMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), ctx, text, count)
+}
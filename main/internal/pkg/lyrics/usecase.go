@@ -0,0 +1,19 @@
+package lyrics
+
+import (
+	"context"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+var (
+	ErrNoLyrics = apperrors.New(apperrors.NotFound, "track has no lyrics")
+	ErrNotOwner = apperrors.New(apperrors.Forbidden, "track is not credited to the artist of the user")
+)
+
+type UseCase interface {
+	GetLyrics(ctx context.Context, tID string, plain bool) (models.Lyrics, error)
+	SetLyrics(ctx context.Context, user models.User, tID string, input models.LyricsInput) (models.Lyrics, error)
+	DeleteLyrics(ctx context.Context, user models.User, tID string) error
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+// [mm:ss], [mm:ss.x], [mm:ss.xx] or [mm:ss.xxx] at the start of a line
+var timeTag = regexp.MustCompile(`^\[(\d{1,3}):([0-5]?\d)(?:[.:](\d{1,3}))?\]`)
+
+// [ar:Artist], [ti:Title], [offset:+250] and the other LRC id tags
+var idTag = regexp.MustCompile(`^\[([a-z]+):(.*)\]$`)
+
+// parseLyrics splits lyrics into lines, when any line has a time tag
+// the lyrics are synced and lines without time tags are dropped
+func parseLyrics(text string) ([]models.LyricsLine, bool) {
+	var (
+		plain  []models.LyricsLine
+		synced []models.LyricsLine
+		offset int64
+	)
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		var times []int64
+		for {
+			match := timeTag.FindStringSubmatch(line)
+			if match == nil {
+				break
+			}
+			times = append(times, tagTime(match))
+			line = line[len(match[0]):]
+		}
+		line = strings.TrimSpace(line)
+
+		if len(times) == 0 {
+			if tag := idTag.FindStringSubmatch(line); tag != nil {
+				if tag[1] == "offset" {
+					offset, _ = strconv.ParseInt(strings.TrimSpace(tag[2]), 10, 64)
+				}
+				continue
+			}
+			plain = append(plain, models.LyricsLine{Text: line})
+			continue
+		}
+		for _, t := range times {
+			synced = append(synced, models.LyricsLine{TimeMs: shift(t, offset), Text: line})
+		}
+	}
+
+	if len(synced) == 0 {
+		return trimEmpty(plain), false
+	}
+	sort.SliceStable(synced, func(i, j int) bool {
+		return *synced[i].TimeMs < *synced[j].TimeMs
+	})
+	return synced, true
+}
+
+func tagTime(match []string) int64 {
+	minutes, _ := strconv.ParseInt(match[1], 10, 64)
+	seconds, _ := strconv.ParseInt(match[2], 10, 64)
+	var fraction int64
+	if match[3] != "" {
+		fraction, _ = strconv.ParseInt(match[3], 10, 64)
+		for i := len(match[3]); i < 3; i++ {
+			fraction *= 10
+		}
+	}
+	return (minutes*60+seconds)*1000 + fraction
+}
+
+// shift applies the offset tag, a positive offset makes lines appear sooner
+func shift(t int64, offset int64) *uint64 {
+	result := uint64(0)
+	if t > offset {
+		result = uint64(t - offset)
+	}
+	return &result
+}
+
+func trimEmpty(lines []models.LyricsLine) []models.LyricsLine {
+	for len(lines) > 0 && lines[0].Text == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1].Text == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// plainText joins lines without time tags, it is stored for search
+func plainText(lines []models.LyricsLine) string {
+	texts := make([]string, len(lines))
+	for i, elem := range lines {
+		texts[i] = elem.Text
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func at(ms uint64) *uint64 {
+	return &ms
+}
+
+func TestParseLyrics(t *testing.T) {
+	t.Run("Plain", func(t *testing.T) {
+		lines, synced := parseLyrics("\n[Chorus]\r\nfirst line\n\nsecond line\n\n")
+		assert.False(t, synced)
+		assert.Equal(t, []models.LyricsLine{
+			{Text: "[Chorus]"},
+			{Text: "first line"},
+			{Text: ""},
+			{Text: "second line"},
+		}, lines)
+	})
+
+	t.Run("Synced", func(t *testing.T) {
+		lines, synced := parseLyrics("[ti:Song]\n[ar:Band]\n" +
+			"[00:12.00]first line\n" +
+			"[00:17.5]second line\n" +
+			"untimed line\n" +
+			"[01:02.345][00:30.20]chorus\n")
+		assert.True(t, synced)
+		assert.Equal(t, []models.LyricsLine{
+			{TimeMs: at(12000), Text: "first line"},
+			{TimeMs: at(17500), Text: "second line"},
+			{TimeMs: at(30200), Text: "chorus"},
+			{TimeMs: at(62345), Text: "chorus"},
+		}, lines)
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		lines, synced := parseLyrics("[offset:+500]\n[00:00.20]intro\n[00:01]first line")
+		assert.True(t, synced)
+		assert.Equal(t, []models.LyricsLine{
+			{TimeMs: at(0), Text: "intro"},
+			{TimeMs: at(500), Text: "first line"},
+		}, lines)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		lines, synced := parseLyrics(" \n[ti:Song]\n")
+		assert.False(t, synced)
+		assert.Empty(t, lines)
+	})
+}
+
+func TestPlainText(t *testing.T) {
+	lines, _ := parseLyrics("[00:12.00]first line\n[00:17.50]second line")
+	assert.Equal(t, "first line\nsecond line", plainText(lines))
+}
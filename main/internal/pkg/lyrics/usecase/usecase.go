@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+)
+
+const maxLyricsLength = 20000
+
+type LyricsUseCase struct {
+	Repository      lyrics.Repository
+	TrackRepository track.Repository
+}
+
+// GetLyrics returns lyrics of the track, plain drops time tags of synced lyrics
+func (uc *LyricsUseCase) GetLyrics(ctx context.Context, tID string, plain bool) (models.Lyrics, error) {
+	text, err := uc.Repository.GetLyrics(ctx, tID)
+	if err != nil {
+		return models.Lyrics{}, err
+	}
+	lines, synced := parseLyrics(text)
+	if plain && synced {
+		for i := range lines {
+			lines[i].TimeMs = nil
+		}
+		synced = false
+	}
+	return models.Lyrics{
+		TrackId: tID,
+		Synced:  synced,
+		Lines:   lines,
+	}, nil
+}
+
+func (uc *LyricsUseCase) SetLyrics(ctx context.Context, user models.User, tID string, input models.LyricsInput) (models.Lyrics, error) {
+	if utf8.RuneCountInString(input.Text) > maxLyricsLength {
+		return models.Lyrics{}, apperrors.Errorf(apperrors.Validation, "lyrics must be at most %d characters", maxLyricsLength)
+	}
+	lines, synced := parseLyrics(input.Text)
+	if len(lines) == 0 {
+		return models.Lyrics{}, apperrors.New(apperrors.Validation, "lyrics are empty")
+	}
+	if err := uc.checkOwner(ctx, user, tID); err != nil {
+		return models.Lyrics{}, err
+	}
+
+	if err := uc.Repository.SetLyrics(ctx, tID, user.Id, input.Text, plainText(lines)); err != nil {
+		return models.Lyrics{}, err
+	}
+	return models.Lyrics{
+		TrackId: tID,
+		Synced:  synced,
+		Lines:   lines,
+	}, nil
+}
+
+func (uc *LyricsUseCase) DeleteLyrics(ctx context.Context, user models.User, tID string) error {
+	if err := uc.checkOwner(ctx, user, tID); err != nil {
+		return err
+	}
+	return uc.Repository.DeleteLyrics(ctx, tID)
+}
+
+// checkOwner lets admins edit any lyrics and artists only lyrics of the tracks they are credited on
+func (uc *LyricsUseCase) checkOwner(ctx context.Context, user models.User, tID string) error {
+	if user.Role == models.RoleAdmin {
+		return nil
+	}
+	if user.Role != models.RoleArtist || user.ArtistId == "" {
+		return lyrics.ErrNotOwner
+	}
+
+	track, err := uc.TrackRepository.GetTrackById(ctx, tID)
+	if err != nil {
+		return err
+	}
+	if track.ArtistID == user.ArtistId {
+		return nil
+	}
+	for _, elem := range track.Artists {
+		if elem.Id == user.ArtistId {
+			return nil
+		}
+	}
+	return lyrics.ErrNotOwner
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const syncedText = "[00:12.00]first line\n[00:17.50]second line"
+
+var (
+	admin    = models.User{Id: "1", Role: models.RoleAdmin}
+	artist   = models.User{Id: "2", Role: models.RoleArtist, ArtistId: "7"}
+	credited = models.Track{Id: "5", ArtistID: "3", Artists: []models.ArtistCredit{
+		{Id: "3", Role: models.CreditPrimary},
+		{Id: "7", Role: models.CreditFeatured},
+	}}
+)
+
+func TestGetLyrics(t *testing.T) {
+	t.Run("GetLyrics-Synced", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		m.EXPECT().GetLyrics(gomock.Any(), "5").Return(syncedText, nil)
+
+		useCase := LyricsUseCase{Repository: m}
+
+		result, err := useCase.GetLyrics(context.Background(), "5", false)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Lyrics{
+			TrackId: "5",
+			Synced:  true,
+			Lines: []models.LyricsLine{
+				{TimeMs: at(12000), Text: "first line"},
+				{TimeMs: at(17500), Text: "second line"},
+			},
+		}, result)
+	})
+
+	t.Run("GetLyrics-Plain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		m.EXPECT().GetLyrics(gomock.Any(), "5").Return(syncedText, nil)
+
+		useCase := LyricsUseCase{Repository: m}
+
+		result, err := useCase.GetLyrics(context.Background(), "5", true)
+		assert.NoError(t, err)
+		assert.Equal(t, models.Lyrics{
+			TrackId: "5",
+			Lines:   []models.LyricsLine{{Text: "first line"}, {Text: "second line"}},
+		}, result)
+	})
+
+	t.Run("GetLyrics-Missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		m.EXPECT().GetLyrics(gomock.Any(), "5").Return("", lyrics.ErrNoLyrics)
+
+		useCase := LyricsUseCase{Repository: m}
+
+		_, err := useCase.GetLyrics(context.Background(), "5", false)
+		assert.Equal(t, lyrics.ErrNoLyrics, err)
+	})
+}
+
+func TestSetLyrics(t *testing.T) {
+	t.Run("SetLyrics-Admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		m.EXPECT().SetLyrics(gomock.Any(), "5", "1", syncedText, "first line\nsecond line").Return(nil)
+
+		useCase := LyricsUseCase{Repository: m}
+
+		result, err := useCase.SetLyrics(context.Background(), admin, "5", models.LyricsInput{Text: syncedText})
+		assert.NoError(t, err)
+		assert.True(t, result.Synced)
+		assert.Len(t, result.Lines, 2)
+	})
+
+	t.Run("SetLyrics-CreditedArtist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		tr := track.NewMockRepository(ctrl)
+		tr.EXPECT().GetTrackById(gomock.Any(), "5").Return(credited, nil)
+		m.EXPECT().SetLyrics(gomock.Any(), "5", "2", "plain words", "plain words").Return(nil)
+
+		useCase := LyricsUseCase{Repository: m, TrackRepository: tr}
+
+		result, err := useCase.SetLyrics(context.Background(), artist, "5", models.LyricsInput{Text: "plain words"})
+		assert.NoError(t, err)
+		assert.False(t, result.Synced)
+	})
+
+	t.Run("SetLyrics-OtherArtist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tr := track.NewMockRepository(ctrl)
+		tr.EXPECT().GetTrackById(gomock.Any(), "5").Return(credited, nil)
+
+		useCase := LyricsUseCase{TrackRepository: tr}
+
+		other := models.User{Id: "3", Role: models.RoleArtist, ArtistId: "8"}
+		_, err := useCase.SetLyrics(context.Background(), other, "5", models.LyricsInput{Text: "plain words"})
+		assert.Equal(t, lyrics.ErrNotOwner, err)
+	})
+
+	t.Run("SetLyrics-Listener", func(t *testing.T) {
+		useCase := LyricsUseCase{}
+
+		_, err := useCase.SetLyrics(context.Background(), models.User{Id: "4", Role: models.RoleListener}, "5", models.LyricsInput{Text: "words"})
+		assert.Equal(t, lyrics.ErrNotOwner, err)
+	})
+
+	t.Run("SetLyrics-Invalid", func(t *testing.T) {
+		useCase := LyricsUseCase{}
+
+		_, err := useCase.SetLyrics(context.Background(), admin, "5", models.LyricsInput{Text: "\n[ti:Song]\n"})
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+		_, err = useCase.SetLyrics(context.Background(), admin, "5", models.LyricsInput{Text: strings.Repeat("a", maxLyricsLength+1)})
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+	})
+
+	t.Run("SetLyrics-TrackError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tr := track.NewMockRepository(ctrl)
+		tr.EXPECT().GetTrackById(gomock.Any(), "5").Return(models.Track{}, errors.New("not found"))
+
+		useCase := LyricsUseCase{TrackRepository: tr}
+
+		_, err := useCase.SetLyrics(context.Background(), artist, "5", models.LyricsInput{Text: "words"})
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteLyrics(t *testing.T) {
+	t.Run("DeleteLyrics-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := lyrics.NewMockRepository(ctrl)
+		m.EXPECT().DeleteLyrics(gomock.Any(), "5").Return(nil)
+
+		useCase := LyricsUseCase{Repository: m}
+		assert.NoError(t, useCase.DeleteLyrics(context.Background(), admin, "5"))
+	})
+
+	t.Run("DeleteLyrics-NotOwner", func(t *testing.T) {
+		useCase := LyricsUseCase{}
+		assert.Equal(t, lyrics.ErrNotOwner, useCase.DeleteLyrics(context.Background(), models.User{Id: "4"}, "5"))
+	})
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package lyrics is a generated GoMock package.
+package lyrics
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCase is a mock of UseCase interface
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetLyrics mocks base method
+func (m *MockUseCase) GetLyrics(ctx context.Context, tID string, plain bool) (models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", ctx, tID, plain)
+	ret0, _ := ret[0].(models.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLyrics indicates an expected call of GetLyrics
+func (mr *MockUseCaseMockRecorder) GetLyrics(ctx, tID, plain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockUseCase)(nil).GetLyrics), ctx, tID, plain)
+}
+
+// SetLyrics mocks base method
+func (m *MockUseCase) SetLyrics(ctx context.Context, user models.User, tID string, input models.LyricsInput) (models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLyrics", ctx, user, tID, input)
+	ret0, _ := ret[0].(models.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLyrics indicates an expected call of SetLyrics
+func (mr *MockUseCaseMockRecorder) SetLyrics(ctx, user, tID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLyrics", reflect.TypeOf((*MockUseCase)(nil).SetLyrics), ctx, user, tID, input)
+}
+
+// DeleteLyrics mocks base method
+func (m *MockUseCase) DeleteLyrics(ctx context.Context, user models.User, tID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLyrics", ctx, user, tID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics
+func (mr *MockUseCaseMockRecorder) DeleteLyrics(ctx, user, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockUseCase)(nil).DeleteLyrics), ctx, user, tID)
+}
@@ -0,0 +1,18 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheError = "error"
+)
+
+var cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "cache_requests",
+	Help: "Reads of redis caches by result, errors are reads served without the cache",
+}, []string{"cache", "result"})
+
+func ObserveCache(cache string, result string) {
+	cacheRequests.WithLabelValues(cache, result).Inc()
+}
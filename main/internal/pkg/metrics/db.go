@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dbOpenDesc = prometheus.NewDesc("db_open_connections",
+		"Established connections of the pool, both in use and idle", nil, nil)
+	dbInUseDesc = prometheus.NewDesc("db_in_use_connections",
+		"Connections currently in use", nil, nil)
+	dbIdleDesc = prometheus.NewDesc("db_idle_connections",
+		"Idle connections of the pool", nil, nil)
+	dbMaxOpenDesc = prometheus.NewDesc("db_max_open_connections",
+		"Maximum number of open connections, 0 means unlimited", nil, nil)
+	dbWaitCountDesc = prometheus.NewDesc("db_wait_count_total",
+		"Total number of connections waited for", nil, nil)
+	dbWaitDurationDesc = prometheus.NewDesc("db_wait_duration_seconds_total",
+		"Total time blocked waiting for a new connection", nil, nil)
+	dbClosedDesc = prometheus.NewDesc("db_closed_connections_total",
+		"Total number of connections closed by the pool limits", []string{"reason"}, nil)
+)
+
+// dbCollector reads the statistics of the pool on every scrape
+type dbCollector struct {
+	db *sql.DB
+}
+
+func NewDBCollector(db *sql.DB) prometheus.Collector {
+	return dbCollector{db: db}
+}
+
+func (c dbCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dbOpenDesc
+	ch <- dbInUseDesc
+	ch <- dbIdleDesc
+	ch <- dbMaxOpenDesc
+	ch <- dbWaitCountDesc
+	ch <- dbWaitDurationDesc
+	ch <- dbClosedDesc
+}
+
+func (c dbCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+	ch <- prometheus.MustNewConstMetric(dbOpenDesc, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(dbInUseDesc, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(dbIdleDesc, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(dbMaxOpenDesc, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(dbWaitCountDesc, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(dbWaitDurationDesc, prometheus.CounterValue, stats.WaitDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(dbClosedDesc, prometheus.CounterValue, float64(stats.MaxIdleClosed), "max_idle")
+	ch <- prometheus.MustNewConstMetric(dbClosedDesc, prometheus.CounterValue, float64(stats.MaxLifetimeClosed), "max_lifetime")
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/gomodule/redigo/redis"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Register exposes the statistics of the connection pools, the durations of redis commands,
+// cache hits and grpc client calls
+func Register(db *sql.DB, pool *redis.Pool) {
+	prometheus.MustRegister(
+		NewDBCollector(db),
+		NewRedisPoolCollector(pool),
+		redisCommands,
+		cacheRequests,
+	)
+	grpc_prometheus.EnableClientHandlingTimeHistogram()
+}
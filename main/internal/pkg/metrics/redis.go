@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	redisActiveDesc = prometheus.NewDesc("redis_pool_active_connections",
+		"Connections of the pool, both in use and idle", nil, nil)
+	redisIdleDesc = prometheus.NewDesc("redis_pool_idle_connections",
+		"Idle connections of the pool", nil, nil)
+
+	redisCommands = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "redis_command_duration_seconds",
+		Help:    "Duration of redis commands",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"command", "status"})
+)
+
+type redisPoolCollector struct {
+	pool *redis.Pool
+}
+
+func NewRedisPoolCollector(pool *redis.Pool) prometheus.Collector {
+	return redisPoolCollector{pool: pool}
+}
+
+func (c redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- redisActiveDesc
+	ch <- redisIdleDesc
+}
+
+func (c redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.pool.Stats()
+	ch <- prometheus.MustNewConstMetric(redisActiveDesc, prometheus.GaugeValue, float64(stats.ActiveCount))
+	ch <- prometheus.MustNewConstMetric(redisIdleDesc, prometheus.GaugeValue, float64(stats.IdleCount))
+}
+
+// ObserveRedisCommand records a command sent at start, status is "error" for failed ones
+// and for redis error replies alike
+func ObserveRedisCommand(command string, start time.Time, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	redisCommands.WithLabelValues(command, status).Observe(time.Since(start).Seconds())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/2020_1_no_homomorphism/no_homo_main/config"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 	"math/rand"
 	"net/http"
@@ -26,17 +27,46 @@ func (srw *statusResponseWriter) WriteHeader(code int) {
 	srw.ResponseWriter.WriteHeader(code)
 }
 
+// Flush lets streaming handlers push data through the wrapper
+func (srw *statusResponseWriter) Flush() {
+	if flusher, ok := srw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 var (
 	hits = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "hits",
+		Help: "Requests per route template",
 	}, []string{"status", "path", "method"})
 
 	timings = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Name: "method_timings",
-		Help: "Per method timing",
-	}, []string{"method"})
+		Help: "Per route template timing",
+	}, []string{"path", "method"})
 )
 
+// unmatchedRoute labels requests no route accepted, so scanners can't blow up the number of series
+const unmatchedRoute = "unmatched"
+
+// routeTemplate returns the path template of the route of next serving r,
+// e.g. /api/v1/tracks/{id:[0-9]+} for every track id
+func routeTemplate(next http.Handler, r *http.Request) string {
+	router, ok := next.(*mux.Router)
+	if !ok {
+		return unmatchedRoute
+	}
+	var match mux.RouteMatch
+	if !router.Match(r, &match) || match.Route == nil {
+		return unmatchedRoute
+	}
+	template, err := match.Route.GetPathTemplate()
+	if err != nil {
+		return unmatchedRoute
+	}
+	return template
+}
+
 func AccessLogMiddleware(next http.Handler, log *logger.MainLogger) http.Handler {
 	prometheus.MustRegister(hits, timings)
 
@@ -55,12 +85,13 @@ func AccessLogMiddleware(next http.Handler, log *logger.MainLogger) http.Handler
 		srw := NewStatusResponseWriter(w)
 		next.ServeHTTP(srw, r.WithContext(ctx))
 
+		path := routeTemplate(next, r)
 		hits.
-			WithLabelValues(strconv.Itoa(srw.statusCode), r.URL.String(), r.Method).
+			WithLabelValues(strconv.Itoa(srw.statusCode), path, r.Method).
 			Inc()
 
 		timings.
-			WithLabelValues(r.URL.String()).
+			WithLabelValues(path, r.Method).
 			Observe(time.Since(start).Seconds())
 
 		log.EndReq(start, ctx)
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	router := mux.NewRouter().PathPrefix("/api/v1").Subrouter()
+	router.HandleFunc("/tracks/{id:[0-9]+}", noop).Methods("GET")
+
+	t.Run("RouteTemplate-Matched", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/tracks/42", nil)
+		assert.Equal(t, "/api/v1/tracks/{id:[0-9]+}", routeTemplate(router, r))
+	})
+
+	t.Run("RouteTemplate-NotFound", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/wp-admin.php", nil)
+		assert.Equal(t, unmatchedRoute, routeTemplate(router, r))
+	})
+
+	t.Run("RouteTemplate-MethodMismatch", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/tracks/42", nil)
+		assert.Equal(t, unmatchedRoute, routeTemplate(router, r))
+	})
+
+	t.Run("RouteTemplate-NotRouter", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/tracks/42", nil)
+		assert.Equal(t, unmatchedRoute, routeTemplate(http.HandlerFunc(noop), r))
+	})
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/2020_1_no_homomorphism/no_homo_main/proto/session"
 	"net/http"
@@ -40,13 +41,18 @@ func (m *AuthMidleware) Auth(next http.HandlerFunc, passNext bool) http.Handler
 			m.passNext(passNext, next, w, r, ctx)
 			return
 		}
-		sess, err := m.SessionDelivery.Check(context.Background(), &session.SessionID{ID: cookie.Value})
+		sess, err := m.SessionDelivery.Check(r.Context(), &session.SessionID{ID: cookie.Value})
+		if err != nil && !passNext && apperrors.Is(err, apperrors.Unavailable) {
+			// a valid session may exist, so the client should retry instead of logging in again
+			m.Log.HttpInfo(r.Context(), "failed to check session: "+err.Error(), apperrors.WriteHTTP(w, err))
+			return
+		}
 		if err != nil {
 			ctx = context.WithValue(ctx, AuthKey, false)
 			m.passNext(passNext, next, w, r, ctx)
 			return
 		}
-		profile, err := m.UserUC.GetUserByLogin(sess.Login)
+		profile, err := m.UserUC.GetUserByLogin(r.Context(), sess.Login)
 		if err != nil {
 			ctx = context.WithValue(ctx, AuthKey, false)
 			m.passNext(passNext, next, w, r, ctx)
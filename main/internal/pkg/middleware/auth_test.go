@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/2020_1_no_homomorphism/no_homo_main/proto/session"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthSessionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		passNext bool
+		status   int
+	}{
+		{"Auth-NotFound", status.Error(codes.NotFound, "session not found"), false, http.StatusUnauthorized},
+		{"Auth-Unavailable", status.Error(codes.Unavailable, "redis is down"), false, http.StatusServiceUnavailable},
+		{"Auth-UnavailablePassNext", status.Error(codes.Unavailable, "redis is down"), true, http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := session.NewMockAuthCheckerClient(ctrl)
+			m.EXPECT().Check(gomock.Any(), &session.SessionID{ID: "sid"}).Return(nil, test.err)
+
+			auth := NewAuthMiddleware(m, nil, logger.NewLogger(os.Stdout))
+
+			apitest.New(test.name).
+				Handler(auth.Auth(okHandler, test.passNext)).
+				Method("Get").
+				Cookie("session_id", "sid").
+				Expect(t).
+				Status(test.status).
+				End()
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resolveIP(t *testing.T, remoteAddr string, headers map[string]string) string {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	var ip string
+	handler := RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip = ClientIP(r)
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/login", nil)
+	r.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	return ip
+}
+
+func TestRealIP(t *testing.T) {
+	t.Run("RealIP-NoProxy", func(t *testing.T) {
+		ip := resolveIP(t, "1.2.3.4:5000", nil)
+		assert.Equal(t, "1.2.3.4", ip)
+	})
+
+	t.Run("RealIP-UntrustedHeader", func(t *testing.T) {
+		ip := resolveIP(t, "1.2.3.4:5000", map[string]string{
+			"X-Forwarded-For": "5.6.7.8",
+			"X-Real-IP":       "5.6.7.8",
+		})
+		assert.Equal(t, "1.2.3.4", ip)
+	})
+
+	t.Run("RealIP-ForwardedFor", func(t *testing.T) {
+		ip := resolveIP(t, "10.0.0.2:5000", map[string]string{
+			"X-Forwarded-For": "6.6.6.6, 1.2.3.4, 192.168.1.1",
+		})
+		assert.Equal(t, "1.2.3.4", ip)
+	})
+
+	t.Run("RealIP-RealIPHeader", func(t *testing.T) {
+		ip := resolveIP(t, "10.0.0.2:5000", map[string]string{"X-Real-IP": "1.2.3.4"})
+		assert.Equal(t, "1.2.3.4", ip)
+	})
+
+	t.Run("RealIP-OnlyProxies", func(t *testing.T) {
+		ip := resolveIP(t, "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "192.168.1.1"})
+		assert.Equal(t, "192.168.1.1", ip)
+	})
+
+	t.Run("RealIP-BadHeader", func(t *testing.T) {
+		ip := resolveIP(t, "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "garbage"})
+		assert.Equal(t, "10.0.0.2", ip)
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/login", nil)
+	r.RemoteAddr = "1.2.3.4:5000"
+	assert.Equal(t, "1.2.3.4", ClientIP(r))
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	nets, err := ParseTrustedProxies([]string{"10.0.0.0/8", "::1", "127.0.0.1"})
+	require.NoError(t, err)
+	assert.Len(t, nets, 3)
+
+	_, err = ParseTrustedProxies([]string{"proxy"})
+	assert.Error(t, err)
+}
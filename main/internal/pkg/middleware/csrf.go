@@ -31,7 +31,7 @@ func (m *CsrfMiddleware) CSRFCheck(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 		CSRFToken := r.Header.Get("Csrf-Token")
-		ok, err := m.CSRF.Check(sid, CSRFToken)
+		ok, err := m.CSRF.Check(r.Context(), sid, CSRFToken)
 		if err != nil || !ok {
 			ctx = context.WithValue(ctx, CSRFTokenCorrect, false)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "throttled_requests",
+		Help: "Requests rejected by the rate limiter by route group and key type",
+	}, []string{"group", "key"})
+
+	rateLimitErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limit_errors",
+		Help: "Requests let through because the rate limiter failed",
+	}, []string{"group"})
+)
+
+func init() {
+	prometheus.MustRegister(throttledRequests, rateLimitErrors)
+}
+
+type RateLimitMiddleware struct {
+	Limiter ratelimit.Limiter
+	// Limits by route group, groups without a limit are not limited
+	Limits map[string]ratelimit.Limit
+	Log    *logger.MainLogger
+}
+
+func NewRateLimitMiddleware(limiter ratelimit.Limiter, limits map[string]ratelimit.Limit, log *logger.MainLogger) RateLimitMiddleware {
+	return RateLimitMiddleware{
+		Limiter: limiter,
+		Limits:  limits,
+		Log:     log,
+	}
+}
+
+// Limit takes a token from the bucket of the client in the group, authenticated users are limited by id
+// and the others by ip. Wrap it with Auth to limit users, Auth with passNext keeps the route public
+func (m *RateLimitMiddleware) Limit(group string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, ok := m.Limits[group]
+		if !ok {
+			next(w, r)
+			return
+		}
+
+		keyType, id := "ip", ClientIP(r)
+		if user, ok := r.Context().Value(UserKey).(models.User); ok {
+			keyType, id = "user", user.Id
+		}
+
+		result, err := m.Limiter.Take(r.Context(), group+":"+keyType+":"+id, limit)
+		if err != nil {
+			// a broken limiter must not take the api down with it
+			m.Log.LogWarning(r.Context(), "middleware", "Limit", "failed to check rate limit: "+err.Error())
+			rateLimitErrors.WithLabelValues(group).Inc()
+			next(w, r)
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", strconv.FormatInt(limit.Burst, 10))
+		w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(result.Remaining, 10))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.Reset), 10))
+		if !result.Allowed {
+			throttledRequests.WithLabelValues(group, keyType).Inc()
+			w.Header().Set("Retry-After", strconv.FormatInt(ceilSeconds(result.RetryAfter), 10))
+			err := apperrors.New(apperrors.RateLimited, "too many requests, retry later")
+			m.Log.HttpInfo(r.Context(), "rate limit exceeded in "+group, apperrors.WriteHTTP(w, err))
+			return
+		}
+		next(w, r)
+	}
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+)
+
+func TestRateLimit(t *testing.T) {
+	limit := ratelimit.Limit{Rate: 2, Burst: 20}
+
+	newMiddleware := func(limiter ratelimit.Limiter) RateLimitMiddleware {
+		return RateLimitMiddleware{
+			Limiter: limiter,
+			Limits:  map[string]ratelimit.Limit{"search": limit},
+			Log:     logger.NewLogger(os.Stdout),
+		}
+	}
+
+	t.Run("RateLimit-Allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		limiter := ratelimit.NewMockLimiter(ctrl)
+		limiter.EXPECT().
+			Take(gomock.Any(), "search:ip:", limit).
+			Return(ratelimit.Result{Allowed: true, Remaining: 19, Reset: 500 * time.Millisecond}, nil)
+
+		m := newMiddleware(limiter)
+
+		apitest.New("RateLimit-Allowed").
+			Handler(m.Limit("search", okHandler)).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusOK).
+			Header("X-RateLimit-Limit", "20").
+			Header("X-RateLimit-Remaining", "19").
+			Header("X-RateLimit-Reset", "1").
+			HeaderNotPresent("Retry-After").
+			End()
+	})
+
+	t.Run("RateLimit-Throttled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		limiter := ratelimit.NewMockLimiter(ctrl)
+		limiter.EXPECT().
+			Take(gomock.Any(), "search:user:7", limit).
+			Return(ratelimit.Result{Remaining: 0, RetryAfter: 300 * time.Millisecond, Reset: 10 * time.Second}, nil)
+
+		m := newMiddleware(limiter)
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), UserKey, models.User{Id: "7"})
+			m.Limit("search", okHandler)(w, r.WithContext(ctx))
+		}
+
+		apitest.New("RateLimit-Throttled").
+			HandlerFunc(handler).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusTooManyRequests).
+			Header("X-RateLimit-Remaining", "0").
+			Header("X-RateLimit-Reset", "10").
+			Header("Retry-After", "1").
+			Body(`{"error":{"code":"rate_limited","message":"too many requests, retry later"}}`).
+			End()
+	})
+
+	t.Run("RateLimit-NoLimit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := newMiddleware(ratelimit.NewMockLimiter(ctrl))
+
+		apitest.New("RateLimit-NoLimit").
+			Handler(m.Limit("playlists", okHandler)).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusOK).
+			HeaderNotPresent("X-RateLimit-Limit").
+			End()
+	})
+
+	t.Run("RateLimit-LimiterError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		limiter := ratelimit.NewMockLimiter(ctrl)
+		limiter.EXPECT().
+			Take(gomock.Any(), "search:ip:", limit).
+			Return(ratelimit.Result{}, errors.New("redis is down"))
+
+		m := newMiddleware(limiter)
+
+		apitest.New("RateLimit-LimiterError").
+			Handler(m.Limit("search", okHandler)).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusOK).
+			HeaderNotPresent("X-RateLimit-Limit").
+			End()
+	})
+}
+
+func TestNewRateLimitMiddleware(t *testing.T) {
+	//the metrics are registered once, so more middlewares must not panic
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("second middleware panicked: %v", r)
+		}
+	}()
+	NewRateLimitMiddleware(nil, nil, logger.NewLogger(os.Stdout))
+	NewRateLimitMiddleware(nil, nil, logger.NewLogger(os.Stdout))
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"net/http"
+)
+
+// Role must be wrapped by Auth: it relies on the user put into context there.
+// Admins pass every role check.
+func (m *AuthMidleware) Role(next http.HandlerFunc, roles ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, ok := r.Context().Value(UserKey).(models.User)
+		if !ok {
+			m.Log.HttpInfo(r.Context(), "permission denied: user is not auth", http.StatusUnauthorized)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !HasRole(user, roles...) {
+			m.Log.HttpInfo(r.Context(), "permission denied: user has role "+user.Role, http.StatusForbidden)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func HasRole(user models.User, roles ...string) bool {
+	if user.Role == models.RoleAdmin {
+		return true
+	}
+	for _, role := range roles {
+		if user.Role == role {
+			return true
+		}
+	}
+	return false
+}
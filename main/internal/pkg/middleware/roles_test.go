@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestRole(t *testing.T) {
+	auth := AuthMidleware{Log: logger.NewLogger(os.Stdout)}
+
+	t.Run("Role-OK", func(t *testing.T) {
+		user := models.User{Id: "1", Role: models.RoleModerator}
+		handler := AuthMiddlewareMock(auth.Role(okHandler, models.RoleModerator), true, user, "")
+
+		apitest.New("Role-OK").
+			Handler(handler).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("Role-Admin", func(t *testing.T) {
+		user := models.User{Id: "1", Role: models.RoleAdmin}
+		handler := AuthMiddlewareMock(auth.Role(okHandler, models.RoleArtist), true, user, "")
+
+		apitest.New("Role-Admin").
+			Handler(handler).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("Role-Forbidden", func(t *testing.T) {
+		user := models.User{Id: "1", Role: models.RoleListener}
+		handler := AuthMiddlewareMock(auth.Role(okHandler, models.RoleArtist, models.RoleModerator), true, user, "")
+
+		apitest.New("Role-Forbidden").
+			Handler(handler).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	t.Run("Role-NoUser", func(t *testing.T) {
+		apitest.New("Role-NoUser").
+			Handler(auth.Role(okHandler, models.RoleAdmin)).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// NoTimeout is the name of routes that live longer than a request deadline, like server sent events
+const NoTimeout = "no-timeout"
+
+// RequestTimeout cancels the request context after timeout, so database and grpc calls of slow requests
+// are aborted together with the ones of disconnected clients, zero timeout disables the deadline
+func RequestTimeout(timeout time.Duration) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if route := mux.CurrentRoute(r); timeout <= 0 || route != nil && route.GetName() == NoTimeout {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func deadlineHandler(w http.ResponseWriter, r *http.Request) {
+	if _, ok := r.Context().Deadline(); ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(RequestTimeout(time.Second))
+	router.HandleFunc("/tracks", deadlineHandler)
+	router.HandleFunc("/stream", deadlineHandler).Name(NoTimeout)
+
+	t.Run("RequestTimeout-Deadline", func(t *testing.T) {
+		apitest.New("RequestTimeout-Deadline").
+			Handler(router).
+			Method("Get").
+			URL("/tracks").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("RequestTimeout-NoTimeout", func(t *testing.T) {
+		apitest.New("RequestTimeout-NoTimeout").
+			Handler(router).
+			Method("Get").
+			URL("/stream").
+			Expect(t).
+			Status(http.StatusNoContent).
+			End()
+	})
+
+	t.Run("RequestTimeout-Disabled", func(t *testing.T) {
+		apitest.New("RequestTimeout-Disabled").
+			Handler(RequestTimeout(0)(http.HandlerFunc(deadlineHandler))).
+			Method("Get").
+			URL("/tracks").
+			Expect(t).
+			Status(http.StatusNoContent).
+			End()
+	})
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/config"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceRequestID correlates the request id of AccessLogMiddleware with the span started by otelmux:
+// the span gets the id as an attribute, the log and the client get the trace id
+func TraceRequestID(log *logger.MainLogger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if traceID := tracing.TraceID(r.Context()); traceID != "" {
+				if rid, ok := r.Context().Value(config.RequestID).(string); ok {
+					trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("request.id", rid))
+				}
+				w.Header().Set("X-Trace-Id", traceID)
+				log.TraceReq(r.Context())
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/config"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTraceRequestID(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider("test", sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(prev)
+
+	router := mux.NewRouter()
+	router.Use(otelmux.Middleware("test"), TraceRequestID(logger.NewLogger(ioutil.Discard)))
+	router.HandleFunc("/tracks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("TraceRequestID-Traced", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/tracks/1", nil)
+		req = req.WithContext(context.WithValue(req.Context(), config.RequestID, "abcde"))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		spans := exporter.GetSpans()
+		if !assert.Len(t, spans, 1) {
+			return
+		}
+		assert.Equal(t, "/tracks/{id}", spans[0].Name)
+		assert.Equal(t, spans[0].SpanContext.TraceID().String(), rec.Header().Get("X-Trace-Id"))
+		assert.Contains(t, spans[0].Attributes, attribute.String("request.id", "abcde"))
+	})
+
+	t.Run("TraceRequestID-NotTraced", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler := TraceRequestID(logger.NewLogger(ioutil.Discard))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tracks/1", nil))
+		assert.Empty(t, rec.Header().Get("X-Trace-Id"))
+	})
+}
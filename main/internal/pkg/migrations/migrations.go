@@ -0,0 +1,265 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// files are the schema of the main database, every NNNN_name.up.sql has a NNNN_name.down.sql undoing it
+//
+//go:embed sql/*.sql
+var files embed.FS
+
+// lockKey guards migrations run by replicas starting at the same time, it is an arbitrary constant
+const lockKey = 20200101
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load reads migrations from the root of fsys ordered by version. Versions must go
+// one after another starting from 1 and every migration needs both directions
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad version of %s: %w", entry.Name(), err)
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("version %d is used by %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(data)
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	for i, m := range migrations {
+		if m.Version != uint64(i+1) {
+			return nil, fmt.Errorf("migration %d is missing", i+1)
+		}
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", m.Version, m.Name)
+		}
+	}
+	return migrations, nil
+}
+
+// Embedded returns the migrations built into the binary
+func Embedded() ([]Migration, error) {
+	sub, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return Load(sub)
+}
+
+// Migrator applies migrations to a database and keeps applied versions in schema_migrations.
+// Every migration runs in its own transaction together with its version, so a failed one
+// leaves the schema at the previous version
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Version returns the last applied version, 0 for an empty database
+func (m *Migrator) Version(ctx context.Context) (uint64, error) {
+	var version uint64
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		var err error
+		version, err = currentVersion(ctx, conn)
+		return err
+	})
+	return version, err
+}
+
+// Up applies every migration newer than the database and returns the applied ones
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if version == 0 {
+			if err := checkEmpty(ctx, conn); err != nil {
+				return err
+			}
+		}
+		for _, elem := range m.migrations {
+			if elem.Version <= version {
+				continue
+			}
+			err := inTx(ctx, conn, elem.Up, "insert into schema_migrations (version, name) values ($1, $2)", elem.Version, elem.Name)
+			if err != nil {
+				return fmt.Errorf("failed to apply %d_%s: %w", elem.Version, elem.Name, err)
+			}
+			applied = append(applied, elem)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts at most steps migrations starting from the last applied one and returns the reverted ones
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if version > uint64(len(m.migrations)) {
+			return fmt.Errorf("database version %d is newer than the known migrations", version)
+		}
+		for ; version > 0 && len(reverted) < steps; version-- {
+			elem := m.migrations[version-1]
+			err := inTx(ctx, conn, elem.Down, "delete from schema_migrations where version = $1", elem.Version)
+			if err != nil {
+				return fmt.Errorf("failed to revert %d_%s: %w", elem.Version, elem.Name, err)
+			}
+			reverted = append(reverted, elem)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Force marks migrations up to version as applied without running them, it is meant for databases
+// created before the migrations from the schema they reproduce
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version > uint64(len(m.migrations)) {
+		return fmt.Errorf("unknown version %d", version)
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.ExecContext(ctx, "delete from schema_migrations"); err != nil {
+			return fmt.Errorf("failed to reset versions: %w", err)
+		}
+		for _, elem := range m.migrations[:version] {
+			_, err := tx.ExecContext(ctx, "insert into schema_migrations (version, name) values ($1, $2)", elem.Version, elem.Name)
+			if err != nil {
+				return fmt.Errorf("failed to mark %d_%s: %w", elem.Version, elem.Name, err)
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+// withLock runs f on a single connection holding the advisory lock, so the lock and
+// the migrations share a session
+func (m *Migrator) withLock(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "select pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "select pg_advisory_unlock($1)", lockKey)
+
+	_, err = conn.ExecContext(ctx, "create table if not exists schema_migrations ("+
+		"version BIGINT PRIMARY KEY, "+
+		"name VARCHAR(100) NOT NULL, "+
+		"applied_at TIMESTAMP NOT NULL DEFAULT now())")
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return f(conn)
+}
+
+func currentVersion(ctx context.Context, conn *sql.Conn) (uint64, error) {
+	var version uint64
+	if err := conn.QueryRowContext(ctx, "select coalesce(max(version), 0) from schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
+
+// checkEmpty keeps Up from running the first migrations over a schema created without them,
+// e.g. from the old create.sql, such a database has to be marked with Force first
+func checkEmpty(ctx context.Context, conn *sql.Conn) error {
+	var exists bool
+	err := conn.QueryRowContext(ctx, "select exists(select 1 from pg_class c join pg_namespace n on n.oid = c.relnamespace"+
+		" where n.nspname = current_schema() and c.relkind in ('r', 'v') and c.relname <> 'schema_migrations')").Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check existing schema: %w", err)
+	}
+	if exists {
+		return errors.New("database has tables but no applied migrations, " +
+			"mark the version its schema matches with migrate force before migrating up")
+	}
+	return nil
+}
+
+// inTx runs the script of a migration and the bookkeeping query in one transaction
+func inTx(ctx context.Context, conn *sql.Conn, script string, query string, args ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// without arguments lib/pq sends the script as a simple query, which may hold many statements
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,181 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func file(data string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(data)}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Ordered", func(t *testing.T) {
+		migrations, err := Load(fstest.MapFS{
+			"0002_second.up.sql":   file("create table b ();"),
+			"0002_second.down.sql": file("drop table b;"),
+			"0001_first.up.sql":    file("create table a ();"),
+			"0001_first.down.sql":  file("drop table a;"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []Migration{
+			{Version: 1, Name: "first", Up: "create table a ();", Down: "drop table a;"},
+			{Version: 2, Name: "second", Up: "create table b ();", Down: "drop table b;"},
+		}, migrations)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		cases := map[string]fstest.MapFS{
+			"no down": {"0001_first.up.sql": file("create table a ();")},
+			"gap": {
+				"0001_first.up.sql":   file("create table a ();"),
+				"0001_first.down.sql": file("drop table a;"),
+				"0003_third.up.sql":   file("create table c ();"),
+				"0003_third.down.sql": file("drop table c;"),
+			},
+			"same version": {
+				"0001_first.up.sql":   file("create table a ();"),
+				"0001_other.up.sql":   file("create table b ();"),
+				"0001_first.down.sql": file("drop table a;"),
+			},
+			"bad name": {"first.sql": file("create table a ();")},
+		}
+		for name, fsys := range cases {
+			_, err := Load(fsys)
+			require.Error(t, err, name)
+		}
+	})
+
+	t.Run("Embedded", func(t *testing.T) {
+		migrations, err := Embedded()
+		require.NoError(t, err)
+		require.NotEmpty(t, migrations)
+		require.Equal(t, "baseline", migrations[0].Name)
+	})
+}
+
+var testMigrations = []Migration{
+	{Version: 1, Name: "first", Up: "create table a ();", Down: "drop table a;"},
+	{Version: 2, Name: "second", Up: "create table b ();", Down: "drop table b;"},
+}
+
+func expectLock(mock sqlmock.Sqlmock, version uint64) {
+	mock.ExpectExec(regexp.QuoteMeta("select pg_advisory_lock($1)")).
+		WithArgs(lockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("create table if not exists schema_migrations").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("select coalesce(max(version), 0) from schema_migrations")).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(version))
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("select pg_advisory_unlock($1)")).
+		WithArgs(lockKey).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestUp(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := NewMigrator(db, testMigrations)
+
+	//only the migration newer than the database is applied
+	expectLock(mock, 1)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("create table b ();")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("insert into schema_migrations (version, name) values ($1, $2)")).
+		WithArgs(2, "second").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlock(mock)
+
+	applied, err := migrator.Up(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, testMigrations[1:], applied)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	//failed migration is rolled back with its version
+	expectLock(mock, 1)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("create table b ();")).
+		WillReturnError(errors.New("db_error"))
+	mock.ExpectRollback()
+	expectUnlock(mock)
+
+	applied, err = migrator.Up(context.Background())
+	require.Error(t, err)
+	require.Empty(t, applied)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	//an empty database gets every migration
+	expectLock(mock, 0)
+	mock.ExpectQuery("select exists").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	for _, elem := range testMigrations {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(elem.Up)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("insert into schema_migrations (version, name) values ($1, $2)")).
+			WithArgs(elem.Version, elem.Name).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	expectUnlock(mock)
+
+	applied, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, testMigrations, applied)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	//tables without versions are left alone
+	expectLock(mock, 0)
+	mock.ExpectQuery("select exists").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	expectUnlock(mock)
+
+	applied, err = migrator.Up(context.Background())
+	require.Error(t, err)
+	require.Empty(t, applied)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDown(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := NewMigrator(db, testMigrations)
+
+	expectLock(mock, 2)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("drop table b;")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("delete from schema_migrations where version = $1")).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlock(mock)
+
+	reverted, err := migrator.Down(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, testMigrations[1:], reverted)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	//database from a newer release can't be reverted by this one
+	expectLock(mock, 3)
+	expectUnlock(mock)
+
+	_, err = migrator.Down(context.Background(), 1)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
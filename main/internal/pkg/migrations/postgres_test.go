@@ -0,0 +1,148 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// emptyDBEnv names the connection string of an empty database, the test is skipped when it is not set
+const emptyDBEnv = "MIGRATIONS_TEST_DB"
+
+// relations lists what the migrations left in the public schema besides schema_migrations
+func relations(t *testing.T, db *sql.DB) []string {
+	rows, err := db.Query("select c.relname from pg_class c join pg_namespace n on n.oid = c.relnamespace " +
+		"where n.nspname = 'public' and c.relname not like 'schema_migrations%' order by c.relname")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	return names
+}
+
+func functions(t *testing.T, db *sql.DB) []string {
+	rows, err := db.Query("select p.proname from pg_proc p join pg_namespace n on n.oid = p.pronamespace " +
+		"where n.nspname = 'public' order by p.proname")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	return names
+}
+
+func TestMigrateEmptyDB(t *testing.T) {
+	dsn := os.Getenv(emptyDBEnv)
+	if dsn == "" {
+		t.Skip(emptyDBEnv + " is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrations, err := Embedded()
+	require.NoError(t, err)
+	migrator := NewMigrator(db, migrations)
+	ctx := context.Background()
+	latest := migrations[len(migrations)-1].Version
+
+	require.Empty(t, relations(t, db), "database must be empty")
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, len(migrations))
+	version, err := migrator.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, latest, version)
+
+	//the schema is usable: triggers fill stats and likes count towards charts
+	var userID, artistID, trackID uint64
+	require.NoError(t, db.QueryRow("insert into users (login, password, name, email, sex) "+
+		"values ('migrations', 'password', 'user', 'migrations@test.com', 'male') returning id").Scan(&userID))
+	require.NoError(t, db.QueryRow("insert into artists (name) values ('artist') returning id").Scan(&artistID))
+	require.NoError(t, db.QueryRow("insert into tracks (name, duration, link, artist_id) "+
+		"values ('track', 100, 'link', $1) returning id", artistID).Scan(&trackID))
+	_, err = db.Exec("insert into liked_tracks (user_id, track_id) values ($1, $2)", userID, trackID)
+	require.NoError(t, err)
+
+	var liked, likes int
+	require.NoError(t, db.QueryRow("select tracks from user_stat where user_id = $1", userID).Scan(&liked))
+	require.Equal(t, 1, liked)
+	require.NoError(t, db.QueryRow("select count(*) from chart_events where chart_type = 'tracks' and likes = 1").Scan(&likes))
+	require.Equal(t, 1, likes)
+
+	//albums of a deleted artist are hidden, genre browsing included
+	var albumID, genreID uint64
+	var albums, genreAlbums int
+	require.NoError(t, db.QueryRow("insert into albums (name, release, artist_id) values ('album', now(), $1) returning id",
+		artistID).Scan(&albumID))
+	require.NoError(t, db.QueryRow("insert into genres (name) values ('rock') returning id").Scan(&genreID))
+	_, err = db.Exec("insert into album_genres (album_id, genre_id) values ($1, $2)", albumID, genreID)
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow("select count(*) from album_info").Scan(&albums))
+	require.Equal(t, 1, albums)
+	require.NoError(t, db.QueryRow("select count(*) from genre_albums where genre_id = $1", genreID).Scan(&genreAlbums))
+	require.Equal(t, 1, genreAlbums)
+	_, err = db.Exec("update artists set deleted_at = now() where id = $1", artistID)
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow("select count(*) from album_info").Scan(&albums))
+	require.Zero(t, albums)
+	require.NoError(t, db.QueryRow("select count(*) from genre_albums where genre_id = $1", genreID).Scan(&genreAlbums))
+	require.Zero(t, genreAlbums)
+
+	//likes survive the step back to the array and the step forward again
+	const likedTracksVersion = 13
+	steps := int(latest - likedTracksVersion + 1)
+	reverted, err := migrator.Down(ctx, steps)
+	require.NoError(t, err)
+	require.Len(t, reverted, steps)
+	var likedTracks string
+	require.NoError(t, db.QueryRow("select liked_tracks::text from users where id = $1", userID).Scan(&likedTracks))
+	require.Equal(t, "{"+strconv.FormatUint(trackID, 10)+"}", likedTracks)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow("select tracks from user_stat where user_id = $1", userID).Scan(&liked))
+	require.Equal(t, 1, liked)
+
+	//a database at the baseline schema, as created by the old create.sql, keeps its catalog when migrated up
+	reverted, err = migrator.Down(ctx, int(latest-1))
+	require.NoError(t, err)
+	require.Len(t, reverted, int(latest-1))
+	var baselineTrackID uint64
+	require.NoError(t, db.QueryRow("insert into tracks (name, duration, link, artist_id) "+
+		"values ('baseline', 100, 'link', $1) returning id", artistID).Scan(&baselineTrackID))
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	var credits int
+	require.NoError(t, db.QueryRow("select count(*) from track_credits where track_id = $1 and role = 'primary'",
+		baselineTrackID).Scan(&credits))
+	require.Equal(t, 1, credits)
+	require.NoError(t, db.QueryRow("select tracks from user_stat where user_id = $1", userID).Scan(&liked))
+	require.Equal(t, 1, liked)
+
+	reverted, err = migrator.Down(ctx, len(migrations))
+	require.NoError(t, err)
+	require.Len(t, reverted, len(migrations))
+	require.Empty(t, relations(t, db))
+	require.Empty(t, functions(t, db))
+	version, err = migrator.Version(ctx)
+	require.NoError(t, err)
+	require.Zero(t, version)
+}
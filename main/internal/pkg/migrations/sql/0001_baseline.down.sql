@@ -0,0 +1,31 @@
+DROP VIEW artist_tracks;
+DROP VIEW tracks_in_album;
+DROP VIEW full_album_info;
+DROP VIEW sub_artists;
+DROP VIEW user_artists;
+DROP VIEW user_albums;
+DROP VIEW tracks_in_playlist;
+DROP VIEW full_track_info;
+
+DROP TABLE artist_stat;
+DROP TABLE user_stat;
+DROP TABLE playlist_tracks;
+DROP TABLE playlists;
+DROP TABLE liked_albums;
+DROP TABLE liked_artists;
+DROP TABLE users;
+DROP TABLE album_tracks;
+DROP TABLE tracks;
+DROP TABLE albums;
+DROP TABLE artists;
+
+DROP FUNCTION before_playlist_track_insert_func();
+DROP FUNCTION after_playlist_delete_func();
+DROP FUNCTION before_playlist_insert_func();
+DROP FUNCTION after_liked_albums_func();
+DROP FUNCTION after_liked_artists_func();
+DROP FUNCTION after_user_insert_func();
+DROP FUNCTION after_user_update_func();
+DROP FUNCTION tracks_trigger_func();
+DROP FUNCTION albums_trigger_func();
+DROP FUNCTION artists_trigger_func();
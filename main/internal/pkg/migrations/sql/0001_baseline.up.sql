@@ -1,3 +1,5 @@
+-- the schema of the former configs/sql/create.sql, databases created from it are marked with migrate force 1
+
 CREATE TABLE artists
 (
     ID    BIGSERIAL PRIMARY KEY,
@@ -59,7 +61,6 @@ CREATE TRIGGER albums_trigger
     FOR EACH ROW
 EXECUTE PROCEDURE albums_trigger_func();
 
-
 CREATE TABLE tracks
 (
     ID        BIGSERIAL PRIMARY KEY,
@@ -92,7 +93,6 @@ CREATE TRIGGER tracks_trigger
     FOR EACH ROW
 EXECUTE PROCEDURE tracks_trigger_func();
 
-
 CREATE TABLE album_tracks
 (
     track_id BIGSERIAL   NOT NULL,
@@ -107,7 +107,6 @@ CREATE TABLE album_tracks
     PRIMARY KEY (track_id, album_id)
 );
 
-
 CREATE TABLE users
 (
     ID           BIGSERIAL PRIMARY KEY,
@@ -120,35 +119,6 @@ CREATE TABLE users
     liked_tracks integer[]    DEFAULT '{}'
 );
 
-CREATE OR REPLACE VIEW user_liked_tracks AS
-with liked as (
-    select unnest(liked_tracks) as liked_id
-    from users
-    where id = ?
-)
-select row_number() over () as index,
-       t.ID                 as id,
-       t.name               as name,
-       a.name               as artist,
-       t.duration           as duration,
-       t.image              as image,
-       artist_id            as artist_id,
-       t.link               as link
-from liked
-         join tracks as t on liked.liked_id = t.ID
-         join artists as a on t.artist_id = a.ID
-order by index desc;
-
--- select *
--- from user_liked_tracks;
---
--- select unnest(liked_tracks) as liked_id
---     from users
---     where id = ?;
---
--- update users set liked_tracks = liked_tracks || '{555}' where id = 1;
--- update users set liked_tracks = array_remove(liked_tracks, 555) where id = 1;
-
 CREATE OR REPLACE FUNCTION after_user_update_func() RETURNS TRIGGER AS
 $after_user_update$
 declare
@@ -168,8 +138,6 @@ CREATE TRIGGER after_user_update
     for each row
 EXECUTE PROCEDURE after_user_update_func();
 
-
-
 CREATE OR REPLACE FUNCTION after_user_insert_func() RETURNS TRIGGER AS
 $after_user_insert$
 BEGIN
@@ -191,7 +159,6 @@ CREATE TRIGGER after_user_insert
     FOR EACH ROW
 EXECUTE PROCEDURE after_user_insert_func();
 
-
 CREATE TABLE liked_artists
 (
     user_ID   BIGSERIAL NOT NULL,
@@ -228,7 +195,6 @@ CREATE TRIGGER after_liked_artists
     FOR EACH ROW
 EXECUTE PROCEDURE after_liked_artists_func();
 
-
 CREATE TABLE liked_albums
 (
     user_ID  BIGSERIAL NOT NULL,
@@ -242,7 +208,6 @@ CREATE TABLE liked_albums
     PRIMARY KEY (user_ID, album_ID)
 );
 
-
 CREATE OR REPLACE FUNCTION after_liked_albums_func() RETURNS TRIGGER AS
 $after_liked_albums$
 BEGIN
@@ -312,7 +277,6 @@ CREATE TRIGGER after_playlist_delete
     FOR EACH ROW
 EXECUTE PROCEDURE after_playlist_delete_func();
 
-
 CREATE TABLE playlist_tracks
 (
     playlist_ID BIGSERIAL   NOT NULL,
@@ -357,7 +321,6 @@ CREATE TRIGGER before_playlist_track_insert
     FOR EACH ROW
 EXECUTE PROCEDURE before_playlist_track_insert_func();
 
-
 CREATE TABLE user_stat
 (
     user_id   BIGINT NOT NULL PRIMARY KEY,
@@ -368,7 +331,6 @@ CREATE TABLE user_stat
     FOREIGN KEY (user_id) REFERENCES users (id)
 );
 
-
 CREATE TABLE artist_stat
 (
     artist_id   BIGINT NOT NULL PRIMARY KEY,
@@ -378,7 +340,6 @@ CREATE TABLE artist_stat
     FOREIGN KEY (artist_id) REFERENCES artists (id)
 );
 
-
 CREATE OR REPLACE VIEW full_track_info AS
 SELECT t.ID    as track_id,
        a.ID    as artist_id,
@@ -391,7 +352,6 @@ FROM tracks t,
      artists a
 WHERE a.ID = t.artist_id;
 
-
 CREATE VIEW tracks_in_playlist AS
 SELECT p.ID       as playlist_id,
        p.name     as playlist_name,
@@ -410,7 +370,6 @@ FROM playlists p,
 WHERE p.ID = pt.playlist_ID
   AND t.track_id = pt.track_ID;
 
-
 CREATE VIEW user_albums AS
 SELECT u.id           as user_ID,
        al.id          as album_id,
@@ -424,7 +383,6 @@ FROM users u,
 WHERE liked.user_id = u.id
   AND liked.album_id = al.id;
 
-
 CREATE or replace VIEW user_artists AS
 SELECT u.id    as user_id,
        a.ID    as artist_id,
@@ -445,18 +403,6 @@ FROM artists a,
      liked_artists liked
 WHERE a.id = liked.artist_id;
 
-select *
-from liked_artists
-where user_id = 5
-  and artist_id = 4;
-insert into liked_artists (artist_id, user_id)
-values (5, 5);
-
-select *
-from sub_artists
-where user_id = 5;
-
-
 CREATE VIEW full_album_info AS
 SELECT al.id    as album_id,
        al.name  as album_name,
@@ -468,7 +414,6 @@ SELECT al.id    as album_id,
 FROM albums as al
          JOIN artists as ar ON al.artist_ID = ar.ID;
 
-
 CREATE VIEW tracks_in_album AS
 SELECT a.ID    as album_id,
        t.track_id,
@@ -495,10 +440,3 @@ SELECT a.ID as atrist_Id,
 FROM artists a,
      tracks t
 WHERE a.ID = t.artist_id;
-
-update playlists
-set private = not private
-where id = 32;
-select private
-from playlists
-where id = 32;
@@ -0,0 +1,20 @@
+CREATE TABLE user_two_factor
+(
+    user_ID BIGINT      NOT NULL PRIMARY KEY,
+    secret  VARCHAR(32) NOT NULL,
+    enabled bool DEFAULT FALSE,
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE TABLE user_recovery_codes
+(
+    ID      BIGSERIAL PRIMARY KEY,
+    user_ID BIGINT NOT NULL,
+    code    BYTEA  NOT NULL,
+    used    bool DEFAULT FALSE,
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
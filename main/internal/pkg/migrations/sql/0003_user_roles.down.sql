@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN artist_id,
+    DROP COLUMN role;
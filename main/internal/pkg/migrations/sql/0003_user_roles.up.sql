@@ -0,0 +1,8 @@
+ALTER TABLE users
+    ADD COLUMN role      VARCHAR(10) NOT NULL DEFAULT 'listener'
+        CHECK (role IN ('listener', 'artist', 'moderator', 'admin')),
+    ADD COLUMN artist_id BIGINT
+        REFERENCES artists (ID)
+            ON DELETE SET NULL
+            ON UPDATE CASCADE,
+    ADD CHECK (role <> 'artist' OR artist_id IS NOT NULL);
@@ -0,0 +1,50 @@
+DROP TABLE catalog_audit;
+
+CREATE OR REPLACE VIEW sub_artists AS
+SELECT liked.user_ID as user_id,
+       a.ID          as artist_id,
+       a.name        as name,
+       a.image       as image
+FROM artists a,
+     liked_artists liked
+WHERE a.id = liked.artist_id;
+
+CREATE OR REPLACE VIEW user_artists AS
+SELECT u.id    as user_id,
+       a.ID    as artist_id,
+       a.name  as name,
+       a.image as image
+FROM users u,
+     artists a,
+     liked_artists liked
+WHERE u.id = liked.user_id
+  AND a.id = liked.artist_id;
+
+CREATE OR REPLACE VIEW user_albums AS
+SELECT u.id           as user_ID,
+       al.id          as album_id,
+       al.name        as album_name,
+       al.image       as album_image,
+       al.artist_name as artist_name,
+       al.artist_ID   as artist_id
+FROM users u,
+     albums al,
+     liked_albums liked
+WHERE liked.user_id = u.id
+  AND liked.album_id = al.id;
+
+CREATE OR REPLACE VIEW full_track_info AS
+SELECT t.ID    as track_id,
+       a.ID    as artist_id,
+       t.name  as track_name,
+       a.name     artist_name,
+       t.duration,
+       t.link,
+       t.image as track_image
+FROM tracks t,
+     artists a
+WHERE a.ID = t.artist_id;
+
+ALTER TABLE tracks DROP COLUMN deleted_at;
+ALTER TABLE albums DROP COLUMN deleted_at;
+ALTER TABLE artists DROP COLUMN deleted_at;
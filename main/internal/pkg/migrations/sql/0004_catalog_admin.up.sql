@@ -0,0 +1,67 @@
+ALTER TABLE artists ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE albums ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE tracks ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE OR REPLACE VIEW full_track_info AS
+SELECT t.ID    as track_id,
+       a.ID    as artist_id,
+       t.name  as track_name,
+       a.name     artist_name,
+       t.duration,
+       t.link,
+       t.image as track_image
+FROM tracks t,
+     artists a
+WHERE a.ID = t.artist_id
+  AND t.deleted_at IS NULL
+  AND a.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW user_albums AS
+SELECT u.id           as user_ID,
+       al.id          as album_id,
+       al.name        as album_name,
+       al.image       as album_image,
+       al.artist_name as artist_name,
+       al.artist_ID   as artist_id
+FROM users u,
+     albums al,
+     liked_albums liked
+WHERE liked.user_id = u.id
+  AND liked.album_id = al.id
+  AND al.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW user_artists AS
+SELECT u.id    as user_id,
+       a.ID    as artist_id,
+       a.name  as name,
+       a.image as image
+FROM users u,
+     artists a,
+     liked_artists liked
+WHERE u.id = liked.user_id
+  AND a.id = liked.artist_id
+  AND a.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW sub_artists AS
+SELECT liked.user_ID as user_id,
+       a.ID          as artist_id,
+       a.name        as name,
+       a.image       as image
+FROM artists a,
+     liked_artists liked
+WHERE a.id = liked.artist_id
+  AND a.deleted_at IS NULL;
+
+CREATE TABLE catalog_audit
+(
+    ID         BIGSERIAL PRIMARY KEY,
+    user_ID    BIGINT      NOT NULL,
+    entity     VARCHAR(10) NOT NULL,
+    entity_ID  BIGINT      NOT NULL,
+    action     VARCHAR(10) NOT NULL,
+    changes    JSONB,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
@@ -0,0 +1,9 @@
+DROP VIEW user_following;
+DROP VIEW user_followers;
+DROP TABLE user_follows;
+
+DROP FUNCTION after_user_follows_func();
+
+ALTER TABLE user_stat
+    DROP COLUMN following,
+    DROP COLUMN followers;
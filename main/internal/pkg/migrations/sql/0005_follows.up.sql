@@ -0,0 +1,63 @@
+ALTER TABLE user_stat
+    ADD COLUMN followers INT NOT NULL DEFAULT 0,
+    ADD COLUMN following INT NOT NULL DEFAULT 0;
+
+CREATE TABLE user_follows
+(
+    follower_ID BIGINT    NOT NULL,
+    followed_ID BIGINT    NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (follower_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (followed_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (follower_ID, followed_ID),
+    CHECK (follower_ID <> followed_ID)
+);
+
+CREATE INDEX user_follows_followed_idx ON user_follows (followed_ID);
+
+CREATE OR REPLACE FUNCTION after_user_follows_func() RETURNS TRIGGER AS
+$after_user_follows$
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        update user_stat set following = following + 1 where user_ID = new.follower_ID;
+        update user_stat set followers = followers + 1 where user_ID = new.followed_ID;
+        RETURN NEW;
+    END IF;
+    IF (TG_OP = 'DELETE') THEN
+        update user_stat set following = following - 1 where user_ID = old.follower_ID;
+        update user_stat set followers = followers - 1 where user_ID = old.followed_ID;
+        RETURN NEW;
+    END IF;
+    RETURN NULL;
+END;
+$after_user_follows$ LANGUAGE plpgsql;
+
+CREATE TRIGGER after_user_follows
+    AFTER INSERT or DELETE
+    ON user_follows
+    FOR EACH ROW
+EXECUTE PROCEDURE after_user_follows_func();
+
+CREATE VIEW user_followers AS
+SELECT f.followed_ID as user_id,
+       u.ID          as id,
+       u.login       as login,
+       u.name        as name,
+       u.image       as image,
+       f.created_at  as created_at
+FROM user_follows f
+         JOIN users u ON u.ID = f.follower_ID;
+
+CREATE VIEW user_following AS
+SELECT f.follower_ID as user_id,
+       u.ID          as id,
+       u.login       as login,
+       u.name        as name,
+       u.image       as image,
+       f.created_at  as created_at
+FROM user_follows f
+         JOIN users u ON u.ID = f.followed_ID;
@@ -0,0 +1,7 @@
+DROP INDEX tracks_artist_created_idx;
+DROP INDEX albums_artist_created_idx;
+DROP VIEW user_feed;
+
+ALTER TABLE playlist_tracks DROP COLUMN added_at;
+ALTER TABLE tracks DROP COLUMN created_at;
+ALTER TABLE albums DROP COLUMN created_at;
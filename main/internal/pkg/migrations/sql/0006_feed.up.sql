@@ -0,0 +1,62 @@
+-- rows that are already there get the epoch so that they don't show up in feeds as new
+ALTER TABLE albums ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT 'epoch';
+ALTER TABLE albums ALTER COLUMN created_at SET DEFAULT now();
+ALTER TABLE tracks ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT 'epoch';
+ALTER TABLE tracks ALTER COLUMN created_at SET DEFAULT now();
+ALTER TABLE playlist_tracks ADD COLUMN added_at TIMESTAMP NOT NULL DEFAULT 'epoch';
+ALTER TABLE playlist_tracks ALTER COLUMN added_at SET DEFAULT now();
+
+-- fan-out on read: every row is an event visible to user_id,
+-- new albums and tracks of subscribed artists and tracks added to public playlists of followed users
+CREATE VIEW user_feed AS
+SELECT la.user_ID    as user_id,
+       'album'       as type,
+       al.ID         as id,
+       al.name       as name,
+       al.image      as image,
+       ar.ID         as actor_id,
+       ar.name       as actor_name,
+       NULL::BIGINT  as track_id,
+       NULL::VARCHAR as track_name,
+       al.created_at as created_at
+FROM liked_artists la
+         JOIN albums al ON al.artist_ID = la.artist_ID
+         JOIN artists ar ON ar.ID = al.artist_ID
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+UNION ALL
+SELECT la.user_ID   as user_id,
+       'track'      as type,
+       t.ID         as id,
+       t.name       as name,
+       t.image      as image,
+       ar.ID        as actor_id,
+       ar.name      as actor_name,
+       t.ID         as track_id,
+       t.name       as track_name,
+       t.created_at as created_at
+FROM liked_artists la
+         JOIN tracks t ON t.artist_ID = la.artist_ID
+         JOIN artists ar ON ar.ID = t.artist_ID
+WHERE t.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+UNION ALL
+SELECT f.follower_ID as user_id,
+       'playlist'    as type,
+       p.ID          as id,
+       p.name        as name,
+       p.image       as image,
+       u.ID          as actor_id,
+       u.login       as actor_name,
+       t.ID          as track_id,
+       t.name        as track_name,
+       pt.added_at   as created_at
+FROM user_follows f
+         JOIN playlists p ON p.user_ID = f.followed_ID AND NOT p.private
+         JOIN users u ON u.ID = p.user_ID
+         JOIN playlist_tracks pt ON pt.playlist_ID = p.ID
+         JOIN tracks t ON t.ID = pt.track_ID
+WHERE t.deleted_at IS NULL;
+
+CREATE INDEX albums_artist_created_idx ON albums (artist_ID, created_at);
+CREATE INDEX tracks_artist_created_idx ON tracks (artist_ID, created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE notifications
+(
+    ID         BIGSERIAL PRIMARY KEY,
+    user_ID    BIGINT      NOT NULL,
+    type       VARCHAR(20) NOT NULL,
+    payload    JSONB       NOT NULL DEFAULT '{}',
+    read       bool        NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX notifications_user_idx ON notifications (user_ID, ID);
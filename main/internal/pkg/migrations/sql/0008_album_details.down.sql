@@ -0,0 +1,26 @@
+DROP INDEX albums_artist_release_idx;
+DROP VIEW album_details;
+DROP VIEW tracks_in_album;
+
+CREATE VIEW tracks_in_album AS
+SELECT a.ID    as album_id,
+       t.track_id,
+       t.artist_name,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.link,
+       at.index,
+       a.image as track_image
+FROM album_tracks as at,
+     albums as a,
+     full_track_info as t
+WHERE at.track_id = t.track_id
+  AND at.album_id = a.ID;
+
+ALTER TABLE album_tracks DROP COLUMN disc;
+
+ALTER TABLE albums
+    DROP COLUMN labels,
+    DROP COLUMN genre,
+    DROP COLUMN release_type;
@@ -0,0 +1,50 @@
+ALTER TABLE albums
+    ADD COLUMN release_type VARCHAR(15)   NOT NULL DEFAULT 'album'
+        CHECK (release_type IN ('album', 'single', 'ep', 'compilation')),
+    ADD COLUMN genre        VARCHAR(30),
+    ADD COLUMN labels       VARCHAR(50)[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE album_tracks ADD COLUMN disc SMALLINT NOT NULL DEFAULT 1;
+
+-- disc and number go before track_image, so the view can't be replaced in place
+DROP VIEW tracks_in_album;
+
+CREATE VIEW tracks_in_album AS
+SELECT a.ID    as album_id,
+       t.track_id,
+       t.artist_name,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.link,
+       at.index,
+       at.disc,
+       row_number() over (PARTITION BY at.album_id, at.disc ORDER BY at.index) as number,
+       a.image as track_image
+FROM album_tracks as at,
+     albums as a,
+     full_track_info as t
+WHERE at.track_id = t.track_id
+  AND at.album_id = a.ID;
+
+CREATE VIEW album_details AS
+SELECT al.ID                        as album_id,
+       al.name                      as album_name,
+       al.image                     as album_image,
+       al.release,
+       al.release_type,
+       coalesce(al.genre, ar.genre) as genre,
+       al.labels,
+       ar.ID                        as artist_id,
+       ar.name                      as artist_name,
+       count(t.track_id)            as track_count,
+       coalesce(sum(t.duration), 0) as duration,
+       coalesce(max(t.disc), 0)     as disc_count
+FROM albums as al
+         JOIN artists as ar ON al.artist_ID = ar.ID
+         LEFT JOIN tracks_in_album as t ON t.album_id = al.ID
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+GROUP BY al.ID, ar.ID;
+
+CREATE INDEX albums_artist_release_idx ON albums (artist_ID, release_type, release);
@@ -0,0 +1,111 @@
+DROP VIEW album_details;
+DROP VIEW tracks_in_album;
+DROP VIEW tracks_in_playlist;
+
+ALTER TABLE albums ADD COLUMN artist_name VARCHAR(50);
+
+UPDATE albums al
+SET artist_name = ar.name
+FROM artists ar
+WHERE ar.ID = al.artist_ID;
+
+ALTER TABLE albums ALTER COLUMN artist_name SET NOT NULL;
+
+CREATE OR REPLACE VIEW user_albums AS
+SELECT u.id           as user_ID,
+       al.id          as album_id,
+       al.name        as album_name,
+       al.image       as album_image,
+       al.artist_name as artist_name,
+       al.artist_ID   as artist_id
+FROM users u,
+     albums al,
+     liked_albums liked
+WHERE liked.user_id = u.id
+  AND liked.album_id = al.id
+  AND al.deleted_at IS NULL;
+
+DROP VIEW album_info;
+DROP VIEW full_track_info;
+
+CREATE VIEW full_track_info AS
+SELECT t.ID    as track_id,
+       a.ID    as artist_id,
+       t.name  as track_name,
+       a.name     artist_name,
+       t.duration,
+       t.link,
+       t.image as track_image
+FROM tracks t,
+     artists a
+WHERE a.ID = t.artist_id
+  AND t.deleted_at IS NULL
+  AND a.deleted_at IS NULL;
+
+CREATE VIEW tracks_in_playlist AS
+SELECT p.ID       as playlist_id,
+       p.name     as playlist_name,
+       p.image    as playlist_image,
+       t.track_id as track_id,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.artist_name,
+       t.link,
+       pt.index   as index,
+       pt.image   as track_image
+FROM playlists p,
+     playlist_tracks pt,
+     full_track_info t
+WHERE p.ID = pt.playlist_ID
+  AND t.track_id = pt.track_ID;
+
+CREATE VIEW tracks_in_album AS
+SELECT a.ID    as album_id,
+       t.track_id,
+       t.artist_name,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.link,
+       at.index,
+       at.disc,
+       row_number() over (PARTITION BY at.album_id, at.disc ORDER BY at.index) as number,
+       a.image as track_image
+FROM album_tracks as at,
+     albums as a,
+     full_track_info as t
+WHERE at.track_id = t.track_id
+  AND at.album_id = a.ID;
+
+CREATE VIEW album_details AS
+SELECT al.ID                        as album_id,
+       al.name                      as album_name,
+       al.image                     as album_image,
+       al.release,
+       al.release_type,
+       coalesce(al.genre, ar.genre) as genre,
+       al.labels,
+       ar.ID                        as artist_id,
+       ar.name                      as artist_name,
+       count(t.track_id)            as track_count,
+       coalesce(sum(t.duration), 0) as duration,
+       coalesce(max(t.disc), 0)     as disc_count
+FROM albums as al
+         JOIN artists as ar ON al.artist_ID = ar.ID
+         LEFT JOIN tracks_in_album as t ON t.album_id = al.ID
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+GROUP BY al.ID, ar.ID;
+
+DROP VIEW album_credits;
+DROP VIEW track_credits;
+DROP FUNCTION credit_order(VARCHAR);
+
+DROP TRIGGER album_primary_artist ON albums;
+DROP TRIGGER track_primary_artist ON tracks;
+DROP TABLE album_artists;
+DROP TABLE track_artists;
+
+DROP FUNCTION album_primary_artist_func();
+DROP FUNCTION track_primary_artist_func();
@@ -0,0 +1,241 @@
+-- artist credits, the primary credit is kept equal to tracks.artist_id and albums.artist_ID by triggers
+CREATE TABLE track_artists
+(
+    track_ID  BIGINT      NOT NULL,
+    artist_ID BIGINT      NOT NULL,
+    role      VARCHAR(10) NOT NULL DEFAULT 'primary',
+    position  SMALLINT    NOT NULL DEFAULT 0,
+    FOREIGN KEY (track_ID) REFERENCES tracks (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (artist_ID) REFERENCES artists (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (track_ID, artist_ID, role),
+    CHECK (role IN ('primary', 'featured', 'producer'))
+);
+
+CREATE INDEX track_artists_artist_idx ON track_artists (artist_ID, role);
+
+CREATE OR REPLACE FUNCTION track_primary_artist_func() RETURNS TRIGGER AS
+$track_primary_artist$
+BEGIN
+    DELETE FROM track_artists WHERE track_ID = NEW.ID AND role = 'primary';
+    INSERT INTO track_artists (track_ID, artist_ID, role) VALUES (NEW.ID, NEW.artist_id, 'primary');
+    RETURN NULL;
+END;
+$track_primary_artist$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_primary_artist
+    AFTER INSERT or UPDATE OF artist_id
+    ON tracks
+    FOR EACH ROW
+EXECUTE PROCEDURE track_primary_artist_func();
+
+CREATE TABLE album_artists
+(
+    album_ID  BIGINT      NOT NULL,
+    artist_ID BIGINT      NOT NULL,
+    role      VARCHAR(10) NOT NULL DEFAULT 'primary',
+    position  SMALLINT    NOT NULL DEFAULT 0,
+    FOREIGN KEY (album_ID) REFERENCES albums (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (artist_ID) REFERENCES artists (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (album_ID, artist_ID, role),
+    CHECK (role IN ('primary', 'featured', 'producer'))
+);
+
+CREATE INDEX album_artists_artist_idx ON album_artists (artist_ID, role);
+
+CREATE OR REPLACE FUNCTION album_primary_artist_func() RETURNS TRIGGER AS
+$album_primary_artist$
+BEGIN
+    DELETE FROM album_artists WHERE album_ID = NEW.ID AND role = 'primary';
+    INSERT INTO album_artists (album_ID, artist_ID, role) VALUES (NEW.ID, NEW.artist_ID, 'primary');
+    RETURN NULL;
+END;
+$album_primary_artist$ LANGUAGE plpgsql;
+
+CREATE TRIGGER album_primary_artist
+    AFTER INSERT or UPDATE OF artist_ID
+    ON albums
+    FOR EACH ROW
+EXECUTE PROCEDURE album_primary_artist_func();
+
+-- primary artists go first, then featured, then producers
+CREATE OR REPLACE FUNCTION credit_order(role VARCHAR) RETURNS INT AS
+$credit_order$
+SELECT CASE role
+           WHEN 'primary' THEN 0
+           WHEN 'featured' THEN 1
+           ELSE 2
+           END;
+$credit_order$ LANGUAGE sql IMMUTABLE;
+
+CREATE VIEW track_credits AS
+SELECT ta.track_ID as track_id,
+       ar.ID       as artist_id,
+       ar.name     as artist_name,
+       ta.role,
+       ta.position
+FROM track_artists ta
+         JOIN artists ar ON ar.ID = ta.artist_ID
+WHERE ar.deleted_at IS NULL;
+
+CREATE VIEW album_credits AS
+SELECT aa.album_ID as album_id,
+       ar.ID       as artist_id,
+       ar.name     as artist_name,
+       aa.role,
+       aa.position
+FROM album_artists aa
+         JOIN artists ar ON ar.ID = aa.artist_ID
+WHERE ar.deleted_at IS NULL;
+
+-- existing tracks and albums get their primary credit, the triggers only cover rows written from now on
+INSERT INTO track_artists (track_ID, artist_ID, role)
+SELECT ID, artist_id, 'primary'
+FROM tracks;
+
+INSERT INTO album_artists (album_ID, artist_ID, role)
+SELECT ID, artist_ID, 'primary'
+FROM albums;
+
+CREATE OR REPLACE VIEW full_track_info AS
+SELECT t.ID    as track_id,
+       a.ID    as artist_id,
+       t.name  as track_name,
+       a.name     artist_name,
+       t.duration,
+       t.link,
+       t.image as track_image,
+       c.credit_ids,
+       c.credit_names,
+       c.credit_roles
+FROM tracks t
+         JOIN artists a ON a.ID = t.artist_id
+         CROSS JOIN LATERAL (
+    SELECT coalesce(array_agg(tc.artist_id ORDER BY credit_order(tc.role), tc.position), '{}')   as credit_ids,
+           coalesce(array_agg(tc.artist_name ORDER BY credit_order(tc.role), tc.position), '{}') as credit_names,
+           coalesce(array_agg(tc.role ORDER BY credit_order(tc.role), tc.position), '{}')        as credit_roles
+    FROM track_credits tc
+    WHERE tc.track_id = t.ID
+    ) c
+WHERE t.deleted_at IS NULL
+  AND a.deleted_at IS NULL;
+
+-- the credit columns go before the playlist and album positions, these views are recreated
+DROP VIEW tracks_in_playlist;
+DROP VIEW album_details;
+DROP VIEW tracks_in_album;
+
+CREATE VIEW tracks_in_playlist AS
+SELECT p.ID       as playlist_id,
+       p.name     as playlist_name,
+       p.image    as playlist_image,
+       t.track_id as track_id,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.artist_name,
+       t.link,
+       t.credit_ids,
+       t.credit_names,
+       t.credit_roles,
+       pt.index   as index,
+       pt.image   as track_image
+FROM playlists p,
+     playlist_tracks pt,
+     full_track_info t
+WHERE p.ID = pt.playlist_ID
+  AND t.track_id = pt.track_ID;
+
+CREATE VIEW album_info AS
+SELECT al.ID        as id,
+       al.name,
+       al.image,
+       al.release,
+       ar.name      as artist_name,
+       al.artist_ID as artist_id,
+       al.release_type,
+       al.genre,
+       al.labels,
+       al.created_at,
+       al.deleted_at,
+       c.credit_ids,
+       c.credit_names,
+       c.credit_roles
+FROM albums al
+         JOIN artists ar ON ar.ID = al.artist_ID
+         CROSS JOIN LATERAL (
+    SELECT coalesce(array_agg(ac.artist_id ORDER BY credit_order(ac.role), ac.position), '{}')   as credit_ids,
+           coalesce(array_agg(ac.artist_name ORDER BY credit_order(ac.role), ac.position), '{}') as credit_names,
+           coalesce(array_agg(ac.role ORDER BY credit_order(ac.role), ac.position), '{}')        as credit_roles
+    FROM album_credits ac
+    WHERE ac.album_id = al.ID
+    ) c;
+
+CREATE VIEW tracks_in_album AS
+SELECT a.ID    as album_id,
+       t.track_id,
+       t.artist_name,
+       t.artist_id,
+       t.track_name,
+       t.duration,
+       t.link,
+       t.credit_ids,
+       t.credit_names,
+       t.credit_roles,
+       at.index,
+       at.disc,
+       row_number() over (PARTITION BY at.album_id, at.disc ORDER BY at.index) as number,
+       a.image as track_image
+FROM album_tracks as at,
+     albums as a,
+     full_track_info as t
+WHERE at.track_id = t.track_id
+  AND at.album_id = a.ID;
+
+CREATE VIEW album_details AS
+SELECT al.id                        as album_id,
+       al.name                      as album_name,
+       al.image                     as album_image,
+       al.release,
+       al.release_type,
+       coalesce(al.genre, ar.genre) as genre,
+       al.labels,
+       ar.ID                        as artist_id,
+       ar.name                      as artist_name,
+       al.credit_ids,
+       al.credit_names,
+       al.credit_roles,
+       count(t.track_id)            as track_count,
+       coalesce(sum(t.duration), 0) as duration,
+       coalesce(max(t.disc), 0)     as disc_count
+FROM album_info as al
+         JOIN artists as ar ON al.artist_id = ar.ID
+         LEFT JOIN tracks_in_album as t ON t.album_id = al.id
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+GROUP BY al.id, al.name, al.image, al.release, al.release_type, al.genre, al.labels,
+         al.credit_ids, al.credit_names, al.credit_roles, ar.ID;
+
+-- the artist name of an album is taken from artists from now on
+CREATE OR REPLACE VIEW user_albums AS
+SELECT u.id           as user_ID,
+       al.id          as album_id,
+       al.name        as album_name,
+       al.image       as album_image,
+       al.artist_name as artist_name,
+       al.artist_id   as artist_id
+FROM users u,
+     album_info al,
+     liked_albums liked
+WHERE liked.user_id = u.id
+  AND liked.album_id = al.id
+  AND al.deleted_at IS NULL;
+
+ALTER TABLE albums DROP COLUMN artist_name;
@@ -0,0 +1,10 @@
+DROP VIEW genre_artists;
+DROP VIEW genre_tracks;
+DROP VIEW genre_albums;
+DROP VIEW genre_tree;
+
+DROP TABLE track_genres;
+DROP TABLE album_genres;
+DROP TABLE genres;
+
+DROP FUNCTION genres_cycle_func();
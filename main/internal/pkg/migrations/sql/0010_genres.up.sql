@@ -0,0 +1,119 @@
+CREATE TABLE genres
+(
+    ID        BIGSERIAL PRIMARY KEY,
+    name      VARCHAR(30) NOT NULL UNIQUE,
+    parent_ID BIGINT
+        REFERENCES genres (ID)
+            ON DELETE SET NULL
+            ON UPDATE CASCADE,
+    CHECK (parent_ID <> ID)
+);
+
+CREATE OR REPLACE FUNCTION genres_cycle_func() RETURNS TRIGGER AS
+$genres_cycle$
+BEGIN
+    IF NEW.parent_ID IS NOT NULL AND EXISTS(
+            WITH RECURSIVE ancestors AS (
+                SELECT g.ID, g.parent_ID
+                FROM genres g
+                WHERE g.ID = NEW.parent_ID
+                UNION
+                SELECT g.ID, g.parent_ID
+                FROM genres g
+                         JOIN ancestors a ON g.ID = a.parent_ID
+            )
+            SELECT 1
+            FROM ancestors
+            WHERE ID = NEW.ID) THEN
+        RAISE EXCEPTION 'genre % can''t be a subgenre of itself', NEW.ID;
+    END IF;
+    RETURN NEW;
+END;
+$genres_cycle$ LANGUAGE plpgsql;
+
+CREATE TRIGGER genres_cycle
+    BEFORE INSERT or UPDATE OF parent_ID
+    ON genres
+    FOR EACH ROW
+EXECUTE PROCEDURE genres_cycle_func();
+
+CREATE TABLE album_genres
+(
+    album_ID BIGINT NOT NULL,
+    genre_ID BIGINT NOT NULL,
+    FOREIGN KEY (album_ID) REFERENCES albums (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (genre_ID) REFERENCES genres (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (album_ID, genre_ID)
+);
+
+CREATE INDEX album_genres_genre_idx ON album_genres (genre_ID);
+
+CREATE TABLE track_genres
+(
+    track_ID BIGINT NOT NULL,
+    genre_ID BIGINT NOT NULL,
+    FOREIGN KEY (track_ID) REFERENCES tracks (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (genre_ID) REFERENCES genres (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (track_ID, genre_ID)
+);
+
+CREATE INDEX track_genres_genre_idx ON track_genres (genre_ID);
+
+-- every genre paired with itself and all of its subgenres
+CREATE VIEW genre_tree AS
+WITH RECURSIVE tree AS (
+    SELECT ID as genre_id,
+           ID as subgenre_id
+    FROM genres
+    UNION
+    SELECT tree.genre_id,
+           g.ID
+    FROM tree
+             JOIN genres g ON g.parent_ID = tree.subgenre_id
+)
+SELECT genre_id, subgenre_id
+FROM tree;
+
+CREATE VIEW genre_albums AS
+SELECT DISTINCT gt.genre_id,
+                ag.album_ID as album_id
+FROM genre_tree gt
+         JOIN album_genres ag ON ag.genre_ID = gt.subgenre_id;
+
+-- tracks inherit genres of their albums
+CREATE VIEW genre_tracks AS
+SELECT gt.genre_id,
+       tg.track_ID as track_id
+FROM genre_tree gt
+         JOIN track_genres tg ON tg.genre_ID = gt.subgenre_id
+UNION
+SELECT ga.genre_id,
+       at.track_id
+FROM genre_albums ga
+         JOIN album_tracks at ON at.album_id = ga.album_id;
+
+-- artists are matched by their own genre name and by tagged releases
+CREATE VIEW genre_artists AS
+SELECT gt.genre_id,
+       ar.ID as artist_id
+FROM genre_tree gt
+         JOIN genres g ON g.ID = gt.subgenre_id
+         JOIN artists ar ON lower(ar.genre) = lower(g.name)
+UNION
+SELECT ga.genre_id,
+       al.artist_ID
+FROM genre_albums ga
+         JOIN albums al ON al.ID = ga.album_id
+UNION
+SELECT gtr.genre_id,
+       t.artist_id
+FROM genre_tracks gtr
+         JOIN tracks t ON t.ID = gtr.track_id;
@@ -0,0 +1,24 @@
+DROP VIEW chart_entries;
+DROP VIEW chart_items;
+DROP VIEW chart_events;
+
+DROP TABLE chart_snapshots;
+DROP TABLE track_plays;
+
+ALTER TABLE liked_albums DROP COLUMN liked_at;
+ALTER TABLE liked_artists DROP COLUMN liked_at;
+
+CREATE OR REPLACE FUNCTION after_user_update_func() RETURNS TRIGGER AS
+$after_user_update$
+declare
+    likes int;
+BEGIN
+    likes := cardinality(new.liked_tracks) - cardinality(old.liked_tracks);
+    if likes <> 0 then
+        update user_stat as us set tracks = tracks + likes where us.user_id = new.id;
+    end if;
+    RETURN NEW;
+END;
+$after_user_update$ LANGUAGE plpgsql;
+
+DROP TABLE liked_tracks_log;
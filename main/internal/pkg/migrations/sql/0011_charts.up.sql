@@ -0,0 +1,134 @@
+-- liked_tracks has no timestamps, so the moment of a like is kept aside for charts
+CREATE TABLE liked_tracks_log
+(
+    user_ID  BIGINT    NOT NULL,
+    track_ID BIGINT    NOT NULL,
+    liked_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (track_ID) REFERENCES tracks (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (user_ID, track_ID)
+);
+
+CREATE INDEX liked_tracks_log_liked_at_idx ON liked_tracks_log (liked_at);
+
+CREATE OR REPLACE FUNCTION after_user_update_func() RETURNS TRIGGER AS
+$after_user_update$
+declare
+    likes int;
+BEGIN
+    likes := cardinality(new.liked_tracks) - cardinality(old.liked_tracks);
+    if likes <> 0 then
+        update user_stat as us set tracks = tracks + likes where us.user_id = new.id;
+        insert into liked_tracks_log (user_ID, track_ID)
+        select new.id, liked_id
+        from unnest(new.liked_tracks) as liked_id
+        where liked_id <> all (old.liked_tracks)
+        on conflict do nothing;
+        delete
+        from liked_tracks_log
+        where user_ID = new.id
+          and track_ID <> all (new.liked_tracks);
+    end if;
+    RETURN NEW;
+END;
+$after_user_update$ LANGUAGE plpgsql;
+
+-- likes that are already there get the epoch so that they don't count towards current charts
+ALTER TABLE liked_artists ADD COLUMN liked_at TIMESTAMP NOT NULL DEFAULT 'epoch';
+ALTER TABLE liked_artists ALTER COLUMN liked_at SET DEFAULT now();
+ALTER TABLE liked_albums ADD COLUMN liked_at TIMESTAMP NOT NULL DEFAULT 'epoch';
+ALTER TABLE liked_albums ALTER COLUMN liked_at SET DEFAULT now();
+
+CREATE TABLE track_plays
+(
+    ID        BIGSERIAL PRIMARY KEY,
+    user_ID   BIGINT
+        REFERENCES users (ID)
+            ON DELETE SET NULL
+            ON UPDATE CASCADE,
+    track_ID  BIGINT    NOT NULL
+        REFERENCES tracks (ID)
+            ON DELETE CASCADE
+            ON UPDATE CASCADE,
+    played_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX track_plays_played_at_idx ON track_plays (played_at);
+
+-- every play and like that counts towards a chart
+CREATE VIEW chart_events AS
+SELECT 'tracks' as chart_type, p.track_ID as entity_id, p.played_at as happened_at, 1 as plays, 0 as likes
+FROM track_plays p
+UNION ALL
+SELECT 'tracks', l.track_ID, l.liked_at, 0, 1
+FROM liked_tracks_log l
+UNION ALL
+SELECT 'albums', at.album_id, p.played_at, 1, 0
+FROM track_plays p
+         JOIN album_tracks at ON at.track_id = p.track_ID
+UNION ALL
+SELECT 'albums', l.album_ID, l.liked_at, 0, 1
+FROM liked_albums l
+UNION ALL
+SELECT 'artists', ta.artist_ID, p.played_at, 1, 0
+FROM track_plays p
+         JOIN track_artists ta ON ta.track_ID = p.track_ID AND ta.role IN ('primary', 'featured')
+UNION ALL
+SELECT 'artists', l.artist_ID, l.liked_at, 0, 1
+FROM liked_artists l;
+
+-- what is shown for a chart position
+CREATE VIEW chart_items AS
+SELECT 'tracks' as chart_type, t.ID as id, t.name, t.image, ar.ID as artist_id, ar.name as artist_name
+FROM tracks t
+         JOIN artists ar ON ar.ID = t.artist_id
+WHERE t.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+UNION ALL
+SELECT 'albums', al.ID, al.name, al.image, ar.ID, ar.name
+FROM albums al
+         JOIN artists ar ON ar.ID = al.artist_ID
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+UNION ALL
+SELECT 'artists', ar.ID, ar.name, ar.image, NULL, NULL
+FROM artists ar
+WHERE ar.deleted_at IS NULL;
+
+-- genre_ID = 0 is the global chart
+CREATE TABLE chart_snapshots
+(
+    chart_type  VARCHAR(10) NOT NULL
+        CHECK (chart_type IN ('tracks', 'albums', 'artists')),
+    time_window VARCHAR(10) NOT NULL
+        CHECK (time_window IN ('daily', 'weekly', 'monthly')),
+    genre_ID    BIGINT      NOT NULL DEFAULT 0,
+    period      DATE        NOT NULL,
+    position    SMALLINT    NOT NULL,
+    entity_ID   BIGINT      NOT NULL,
+    plays       BIGINT      NOT NULL,
+    likes       BIGINT      NOT NULL,
+    score       BIGINT      NOT NULL,
+    PRIMARY KEY (chart_type, time_window, genre_ID, period, position)
+);
+
+-- snapshot positions together with the position in the preceding snapshot of the same chart
+CREATE VIEW chart_entries AS
+SELECT s.*,
+       prev.position as previous_position
+FROM chart_snapshots s
+         LEFT JOIN chart_snapshots prev
+                   ON prev.chart_type = s.chart_type
+                       AND prev.time_window = s.time_window
+                       AND prev.genre_ID = s.genre_ID
+                       AND prev.entity_ID = s.entity_ID
+                       AND prev.period = (SELECT max(p.period)
+                                          FROM chart_snapshots p
+                                          WHERE p.chart_type = s.chart_type
+                                            AND p.time_window = s.time_window
+                                            AND p.genre_ID = s.genre_ID
+                                            AND p.period < s.period);
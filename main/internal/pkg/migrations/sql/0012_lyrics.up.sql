@@ -0,0 +1,17 @@
+-- lyrics are kept as uploaded, plain or with LRC timestamps, plain_text has the timestamps stripped for search
+CREATE TABLE track_lyrics
+(
+    track_ID   BIGINT    NOT NULL PRIMARY KEY
+        REFERENCES tracks (ID)
+            ON DELETE CASCADE
+            ON UPDATE CASCADE,
+    lyrics     TEXT      NOT NULL,
+    plain_text TEXT      NOT NULL,
+    updated_by BIGINT
+        REFERENCES users (ID)
+            ON DELETE SET NULL
+            ON UPDATE CASCADE,
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX track_lyrics_search_idx ON track_lyrics USING GIN (to_tsvector('simple', plain_text));
@@ -0,0 +1,82 @@
+ALTER TABLE users ADD COLUMN liked_tracks integer[] DEFAULT '{}';
+
+UPDATE users u
+SET liked_tracks = l.tracks
+FROM (SELECT user_ID, array_agg(track_ID::INTEGER ORDER BY liked_at, track_ID) as tracks
+      FROM liked_tracks
+      GROUP BY user_ID) l
+WHERE l.user_ID = u.ID;
+
+CREATE TABLE liked_tracks_log
+(
+    user_ID  BIGINT    NOT NULL,
+    track_ID BIGINT    NOT NULL,
+    liked_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (track_ID) REFERENCES tracks (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (user_ID, track_ID)
+);
+
+CREATE INDEX liked_tracks_log_liked_at_idx ON liked_tracks_log (liked_at);
+
+INSERT INTO liked_tracks_log (user_ID, track_ID, liked_at)
+SELECT user_ID, track_ID, liked_at
+FROM liked_tracks;
+
+-- every play and like that counts towards a chart
+CREATE OR REPLACE VIEW chart_events AS
+SELECT 'tracks' as chart_type, p.track_ID as entity_id, p.played_at as happened_at, 1 as plays, 0 as likes
+FROM track_plays p
+UNION ALL
+SELECT 'tracks', l.track_ID, l.liked_at, 0, 1
+FROM liked_tracks_log l
+UNION ALL
+SELECT 'albums', at.album_id, p.played_at, 1, 0
+FROM track_plays p
+         JOIN album_tracks at ON at.track_id = p.track_ID
+UNION ALL
+SELECT 'albums', l.album_ID, l.liked_at, 0, 1
+FROM liked_albums l
+UNION ALL
+SELECT 'artists', ta.artist_ID, p.played_at, 1, 0
+FROM track_plays p
+         JOIN track_artists ta ON ta.track_ID = p.track_ID AND ta.role IN ('primary', 'featured')
+UNION ALL
+SELECT 'artists', l.artist_ID, l.liked_at, 0, 1
+FROM liked_artists l;
+
+DROP TABLE liked_tracks;
+DROP FUNCTION after_liked_tracks_func();
+
+-- the trigger goes last, the likes copied above are already counted in user_stat
+CREATE OR REPLACE FUNCTION after_user_update_func() RETURNS TRIGGER AS
+$after_user_update$
+declare
+    likes int;
+BEGIN
+    likes := cardinality(new.liked_tracks) - cardinality(old.liked_tracks);
+    if likes <> 0 then
+        update user_stat as us set tracks = tracks + likes where us.user_id = new.id;
+        insert into liked_tracks_log (user_ID, track_ID)
+        select new.id, liked_id
+        from unnest(new.liked_tracks) as liked_id
+        where liked_id <> all (old.liked_tracks)
+        on conflict do nothing;
+        delete
+        from liked_tracks_log
+        where user_ID = new.id
+          and track_ID <> all (new.liked_tracks);
+    end if;
+    RETURN NEW;
+END;
+$after_user_update$ LANGUAGE plpgsql;
+
+CREATE TRIGGER after_user_update
+    AFTER UPDATE
+    ON users
+    for each row
+EXECUTE PROCEDURE after_user_update_func();
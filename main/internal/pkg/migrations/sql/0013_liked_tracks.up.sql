@@ -0,0 +1,78 @@
+-- likes move from the users.liked_tracks array to a table, timestamps are taken from liked_tracks_log
+CREATE TABLE liked_tracks
+(
+    user_ID  BIGINT    NOT NULL,
+    track_ID BIGINT    NOT NULL,
+    liked_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (track_ID) REFERENCES tracks (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    PRIMARY KEY (user_ID, track_ID)
+);
+
+-- liked tracks of a user newest first, the primary key serves is-liked lookups
+CREATE INDEX liked_tracks_user_liked_at_idx ON liked_tracks (user_ID, liked_at DESC);
+CREATE INDEX liked_tracks_liked_at_idx ON liked_tracks (liked_at);
+
+-- ids in the array have no foreign key, likes of tracks that are gone are dropped
+INSERT INTO liked_tracks (user_ID, track_ID, liked_at)
+SELECT u.ID, liked.track_ID, coalesce(lt.liked_at, now())
+FROM users u
+         CROSS JOIN LATERAL unnest(u.liked_tracks) as liked(track_ID)
+         JOIN tracks t ON t.ID = liked.track_ID
+         LEFT JOIN liked_tracks_log lt ON lt.user_ID = u.ID AND lt.track_ID = liked.track_ID
+ON CONFLICT DO NOTHING;
+
+UPDATE user_stat us
+SET tracks = (SELECT count(*) FROM liked_tracks l WHERE l.user_ID = us.user_id);
+
+CREATE OR REPLACE FUNCTION after_liked_tracks_func() RETURNS TRIGGER AS
+$after_liked_tracks$
+BEGIN
+    IF (TG_OP = 'INSERT') THEN
+        update user_stat set tracks = tracks + 1 where user_ID = new.user_ID;
+        RETURN NEW;
+    END IF;
+    IF (TG_OP = 'DELETE') THEN
+        update user_stat set tracks = tracks - 1 where user_ID = old.user_ID;
+        RETURN NEW;
+    END IF;
+    RETURN NULL;
+END;
+$after_liked_tracks$ LANGUAGE plpgsql;
+
+CREATE TRIGGER after_liked_tracks
+    AFTER INSERT or DELETE
+    ON liked_tracks
+    FOR EACH ROW
+EXECUTE PROCEDURE after_liked_tracks_func();
+
+-- every play and like that counts towards a chart
+CREATE OR REPLACE VIEW chart_events AS
+SELECT 'tracks' as chart_type, p.track_ID as entity_id, p.played_at as happened_at, 1 as plays, 0 as likes
+FROM track_plays p
+UNION ALL
+SELECT 'tracks', l.track_ID, l.liked_at, 0, 1
+FROM liked_tracks l
+UNION ALL
+SELECT 'albums', at.album_id, p.played_at, 1, 0
+FROM track_plays p
+         JOIN album_tracks at ON at.track_id = p.track_ID
+UNION ALL
+SELECT 'albums', l.album_ID, l.liked_at, 0, 1
+FROM liked_albums l
+UNION ALL
+SELECT 'artists', ta.artist_ID, p.played_at, 1, 0
+FROM track_plays p
+         JOIN track_artists ta ON ta.track_ID = p.track_ID AND ta.role IN ('primary', 'featured')
+UNION ALL
+SELECT 'artists', l.artist_ID, l.liked_at, 0, 1
+FROM liked_artists l;
+
+DROP TRIGGER after_user_update ON users;
+DROP FUNCTION after_user_update_func();
+DROP TABLE liked_tracks_log;
+ALTER TABLE users DROP COLUMN liked_tracks;
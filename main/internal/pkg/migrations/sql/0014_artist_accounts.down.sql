@@ -0,0 +1,5 @@
+ALTER TABLE users
+    DROP CONSTRAINT users_artist_id_fkey,
+    ADD CONSTRAINT users_artist_id_fkey FOREIGN KEY (artist_id) REFERENCES artists (ID)
+        ON DELETE SET NULL
+        ON UPDATE CASCADE;
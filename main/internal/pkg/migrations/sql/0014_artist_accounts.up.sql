@@ -0,0 +1,6 @@
+-- an artist linked to an artist account can't go away, clearing the link would break the role check
+ALTER TABLE users
+    DROP CONSTRAINT users_artist_id_fkey,
+    ADD CONSTRAINT users_artist_id_fkey FOREIGN KEY (artist_id) REFERENCES artists (ID)
+        ON DELETE RESTRICT
+        ON UPDATE CASCADE;
@@ -0,0 +1,35 @@
+CREATE OR REPLACE VIEW album_info AS
+SELECT al.ID        as id,
+       al.name,
+       al.image,
+       al.release,
+       ar.name      as artist_name,
+       al.artist_ID as artist_id,
+       al.release_type,
+       al.genre,
+       al.labels,
+       al.created_at,
+       al.deleted_at,
+       c.credit_ids,
+       c.credit_names,
+       c.credit_roles
+FROM albums al
+         JOIN artists ar ON ar.ID = al.artist_ID
+         CROSS JOIN LATERAL (
+    SELECT coalesce(array_agg(ac.artist_id ORDER BY credit_order(ac.role), ac.position), '{}')   as credit_ids,
+           coalesce(array_agg(ac.artist_name ORDER BY credit_order(ac.role), ac.position), '{}') as credit_names,
+           coalesce(array_agg(ac.role ORDER BY credit_order(ac.role), ac.position), '{}')        as credit_roles
+    FROM album_credits ac
+    WHERE ac.album_id = al.ID
+    ) c;
+
+DELETE
+FROM catalog_audit
+WHERE user_ID IS NULL;
+
+ALTER TABLE catalog_audit
+    ALTER COLUMN user_ID SET NOT NULL,
+    DROP CONSTRAINT catalog_audit_user_id_fkey,
+    ADD CONSTRAINT catalog_audit_user_id_fkey FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
@@ -0,0 +1,35 @@
+-- deleted albums and albums of deleted artists are hidden from every read of the catalog
+CREATE OR REPLACE VIEW album_info AS
+SELECT al.ID        as id,
+       al.name,
+       al.image,
+       al.release,
+       ar.name      as artist_name,
+       al.artist_ID as artist_id,
+       al.release_type,
+       al.genre,
+       al.labels,
+       al.created_at,
+       al.deleted_at,
+       c.credit_ids,
+       c.credit_names,
+       c.credit_roles
+FROM albums al
+         JOIN artists ar ON ar.ID = al.artist_ID
+         CROSS JOIN LATERAL (
+    SELECT coalesce(array_agg(ac.artist_id ORDER BY credit_order(ac.role), ac.position), '{}')   as credit_ids,
+           coalesce(array_agg(ac.artist_name ORDER BY credit_order(ac.role), ac.position), '{}') as credit_names,
+           coalesce(array_agg(ac.role ORDER BY credit_order(ac.role), ac.position), '{}')        as credit_roles
+    FROM album_credits ac
+    WHERE ac.album_id = al.ID
+    ) c
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL;
+
+-- the audit trail of a deleted account stays, only the link to the account goes
+ALTER TABLE catalog_audit
+    ALTER COLUMN user_ID DROP NOT NULL,
+    DROP CONSTRAINT catalog_audit_user_id_fkey,
+    ADD CONSTRAINT catalog_audit_user_id_fkey FOREIGN KEY (user_ID) REFERENCES users (ID)
+        ON DELETE SET NULL
+        ON UPDATE CASCADE;
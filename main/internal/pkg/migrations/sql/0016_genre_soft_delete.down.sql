@@ -0,0 +1,33 @@
+CREATE OR REPLACE VIEW genre_albums AS
+SELECT DISTINCT gt.genre_id,
+                ag.album_ID as album_id
+FROM genre_tree gt
+         JOIN album_genres ag ON ag.genre_ID = gt.subgenre_id;
+
+CREATE OR REPLACE VIEW genre_tracks AS
+SELECT gt.genre_id,
+       tg.track_ID as track_id
+FROM genre_tree gt
+         JOIN track_genres tg ON tg.genre_ID = gt.subgenre_id
+UNION
+SELECT ga.genre_id,
+       at.track_id
+FROM genre_albums ga
+         JOIN album_tracks at ON at.album_id = ga.album_id;
+
+CREATE OR REPLACE VIEW genre_artists AS
+SELECT gt.genre_id,
+       ar.ID as artist_id
+FROM genre_tree gt
+         JOIN genres g ON g.ID = gt.subgenre_id
+         JOIN artists ar ON lower(ar.genre) = lower(g.name)
+UNION
+SELECT ga.genre_id,
+       al.artist_ID
+FROM genre_albums ga
+         JOIN albums al ON al.ID = ga.album_id
+UNION
+SELECT gtr.genre_id,
+       t.artist_id
+FROM genre_tracks gtr
+         JOIN tracks t ON t.ID = gtr.track_id;
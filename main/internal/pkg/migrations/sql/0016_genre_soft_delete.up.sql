@@ -0,0 +1,45 @@
+-- deleted releases and artists, and releases of deleted artists, drop out of genre browsing and genre charts
+CREATE OR REPLACE VIEW genre_albums AS
+SELECT DISTINCT gt.genre_id,
+                ag.album_ID as album_id
+FROM genre_tree gt
+         JOIN album_genres ag ON ag.genre_ID = gt.subgenre_id
+         JOIN albums al ON al.ID = ag.album_ID
+         JOIN artists ar ON ar.ID = al.artist_ID
+WHERE al.deleted_at IS NULL
+  AND ar.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW genre_tracks AS
+SELECT gt.genre_id,
+       tg.track_ID as track_id
+FROM genre_tree gt
+         JOIN track_genres tg ON tg.genre_ID = gt.subgenre_id
+         JOIN tracks t ON t.ID = tg.track_ID
+         JOIN artists ar ON ar.ID = t.artist_ID
+WHERE t.deleted_at IS NULL
+  AND ar.deleted_at IS NULL
+UNION
+SELECT ga.genre_id,
+       at.track_id
+FROM genre_albums ga
+         JOIN album_tracks at ON at.album_id = ga.album_id
+         JOIN tracks t ON t.ID = at.track_id
+WHERE t.deleted_at IS NULL;
+
+CREATE OR REPLACE VIEW genre_artists AS
+SELECT gt.genre_id,
+       ar.ID as artist_id
+FROM genre_tree gt
+         JOIN genres g ON g.ID = gt.subgenre_id
+         JOIN artists ar ON lower(ar.genre) = lower(g.name)
+WHERE ar.deleted_at IS NULL
+UNION
+SELECT ga.genre_id,
+       al.artist_ID
+FROM genre_albums ga
+         JOIN albums al ON al.ID = ga.album_id
+UNION
+SELECT gtr.genre_id,
+       t.artist_id
+FROM genre_tracks gtr
+         JOIN tracks t ON t.ID = gtr.track_id;
@@ -0,0 +1,2 @@
+-- the time step of the last accepted code, a code is accepted once and never for an earlier step
+ALTER TABLE user_two_factor ADD COLUMN last_counter BIGINT NOT NULL DEFAULT 0;
@@ -1,13 +1,48 @@
 package models
 
+const (
+	ReleaseAlbum       = "album"
+	ReleaseSingle      = "single"
+	ReleaseEP          = "ep"
+	ReleaseCompilation = "compilation"
+)
+
 type Album struct {
-	Id         string `json:"id"`
-	Name       string `json:"name"`
-	Image      string `json:"image"`
-	Release    string `json:"release"`
-	ArtistName string `json:"artist_name"`
-	ArtistId   string `json:"artist_id"`
-	IsLiked    bool   `json:"is_liked"`
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Image       string   `json:"image"`
+	Release     string   `json:"release"`
+	ArtistName  string   `json:"artist_name"`
+	ArtistId    string   `json:"artist_id"`
+	IsLiked     bool     `json:"is_liked"`
+	ReleaseType string   `json:"release_type,omitempty"`
+	Genre       string   `json:"genre,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	// primary artist is duplicated in ArtistName and ArtistId
+	Artists []ArtistCredit `json:"artists,omitempty"`
+}
+
+type AlbumTrack struct {
+	Track
+	Disc   uint `json:"disc"`
+	Number uint `json:"number"`
+}
+
+type AlbumDetails struct {
+	Album
+	TrackCount uint         `json:"track_count"`
+	Duration   uint         `json:"duration"`
+	DiscCount  uint         `json:"disc_count"`
+	Tracks     []AlbumTrack `json:"tracks,omitempty"`
+}
+
+// Discography is a list of artist releases grouped by release type
+type Discography struct {
+	ArtistId     string         `json:"id"`
+	Albums       []AlbumDetails `json:"albums"`
+	Singles      []AlbumDetails `json:"singles"`
+	EPs          []AlbumDetails `json:"eps"`
+	Compilations []AlbumDetails `json:"compilations"`
 }
 
 type AlbumSearch struct {
@@ -17,3 +52,9 @@ type AlbumSearch struct {
 	ArtistName string `json:"artist_name"`
 	Image      string `json:"image"`
 }
+
+type AlbumTracks struct {
+	Tracks []string `json:"tracks"`
+	// disc number of every track, all tracks are on the first disc if empty
+	Discs []uint `json:"discs,omitempty"`
+}
@@ -1,5 +1,13 @@
 package models
 
+import "strconv"
+
+const (
+	CreditPrimary  = "primary"
+	CreditFeatured = "featured"
+	CreditProducer = "producer"
+)
+
 type Artist struct {
 	Id           string `json:"id"`
 	Name         string `json:"name"`
@@ -29,3 +37,31 @@ type ArtistSubscription struct {
 	ArtistID string `json:"artist_id"`
 	USerID   string `json:"user_id"`
 }
+
+// ArtistCredit is an artist taking part in a track or an album
+type ArtistCredit struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Role string `json:"role"`
+}
+
+type ArtistCredits struct {
+	Artists []ArtistCredit `json:"artists"`
+}
+
+// NewArtistCredits zips the credit arrays aggregated by the album and track views,
+// arrays of different lengths give no credits
+func NewArtistCredits(ids []int64, names []string, roles []string) []ArtistCredit {
+	if len(ids) == 0 || len(ids) != len(names) || len(ids) != len(roles) {
+		return nil
+	}
+	credits := make([]ArtistCredit, len(ids))
+	for i, id := range ids {
+		credits[i] = ArtistCredit{
+			Id:   strconv.FormatInt(id, 10),
+			Name: names[i],
+			Role: roles[i],
+		}
+	}
+	return credits
+}
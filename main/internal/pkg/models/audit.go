@@ -0,0 +1,13 @@
+package models
+
+import "encoding/json"
+
+type AuditEntry struct {
+	Id        string          `json:"id"`
+	UserId    string          `json:"user_id"`
+	Entity    string          `json:"entity"`
+	EntityId  string          `json:"entity_id"`
+	Action    string          `json:"action"`
+	Changes   json.RawMessage `json:"changes,omitempty"`
+	CreatedAt string          `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+const (
+	ChartTracks  = "tracks"
+	ChartAlbums  = "albums"
+	ChartArtists = "artists"
+)
+
+const (
+	ChartDaily   = "daily"
+	ChartWeekly  = "weekly"
+	ChartMonthly = "monthly"
+)
+
+type ChartEntry struct {
+	Position         int    `json:"position"`
+	PreviousPosition int    `json:"previous_position,omitempty"`
+	Id               string `json:"id"`
+	Name             string `json:"name,omitempty"`
+	Image            string `json:"image,omitempty"`
+	ArtistId         string `json:"artist_id,omitempty"`
+	ArtistName       string `json:"artist_name,omitempty"`
+	Plays            int64  `json:"plays"`
+	Likes            int64  `json:"likes"`
+	Score            int64  `json:"score"`
+}
+
+type Chart struct {
+	Type    string       `json:"type"`
+	Window  string       `json:"window"`
+	GenreId string       `json:"genre_id,omitempty"`
+	Period  string       `json:"period,omitempty"`
+	Entries []ChartEntry `json:"entries"`
+}
@@ -0,0 +1,24 @@
+package models
+
+const (
+	FeedAlbum    = "album"
+	FeedTrack    = "track"
+	FeedPlaylist = "playlist"
+)
+
+type FeedItem struct {
+	Type      string `json:"type"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Image     string `json:"image"`
+	ActorId   string `json:"actor_id"`
+	ActorName string `json:"actor_name"`
+	TrackId   string `json:"track_id,omitempty"`
+	TrackName string `json:"track_name,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type Feed struct {
+	Items      []FeedItem `json:"items"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,13 @@
+package models
+
+type Genre struct {
+	Id        string  `json:"id"`
+	Name      string  `json:"name"`
+	ParentId  string  `json:"parent_id,omitempty"`
+	Subgenres []Genre `json:"subgenres,omitempty"`
+}
+
+// GenreTags is a full list of genre ids of an album or a track
+type GenreTags struct {
+	Genres []string `json:"genres"`
+}
@@ -0,0 +1,18 @@
+package models
+
+type LyricsLine struct {
+	// milliseconds from the start of the track, only set for synced lyrics
+	TimeMs *uint64 `json:"time_ms,omitempty"`
+	Text   string  `json:"text"`
+}
+
+type Lyrics struct {
+	TrackId string       `json:"track_id"`
+	Synced  bool         `json:"synced"`
+	Lines   []LyricsLine `json:"lines"`
+}
+
+// LyricsInput is plain text or text with LRC timestamps
+type LyricsInput struct {
+	Text string `json:"text"`
+}
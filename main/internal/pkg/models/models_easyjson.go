@@ -46,6 +46,10 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			out.Playlists = uint64(in.Uint64())
 		case "artists":
 			out.Artists = uint64(in.Uint64())
+		case "followers":
+			out.Followers = uint64(in.Uint64())
+		case "following":
+			out.Following = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -85,6 +89,16 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix)
 		out.Uint64(uint64(in.Artists))
 	}
+	{
+		const prefix string = ",\"followers\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Followers))
+	}
+	{
+		const prefix string = ",\"following\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Following))
+	}
 	out.RawByte('}')
 }
 
@@ -134,6 +148,8 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			out.Login = string(in.String())
 		case "password":
 			out.Password = string(in.String())
+		case "code":
+			out.Code = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -158,6 +174,11 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix)
 		out.String(string(in.Password))
 	}
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
 	out.RawByte('}')
 }
 
@@ -219,6 +240,10 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			out.Image = string(in.String())
 		case "email":
 			out.Email = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -273,6 +298,16 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix)
 		out.String(string(in.Email))
 	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if in.ArtistId != "" {
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistId))
+	}
 	out.RawByte('}')
 }
 
@@ -299,7 +334,80 @@ func (v *UserSettings) UnmarshalJSON(data []byte) error {
 func (v *UserSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(in *jlexer.Lexer, out *User) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(in *jlexer.Lexer, out *UserRole) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "role":
+			out.Role = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(out *jwriter.Writer, in UserRole) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Role))
+	}
+	if in.ArtistId != "" {
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserRole) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserRole) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserRole) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(in *jlexer.Lexer, out *UserProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -318,6 +426,56 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
+		case "stat":
+			(out.Stat).UnmarshalEasyJSON(in)
+		case "is_followed":
+			out.IsFollowed = bool(in.Bool())
+		case "playlists":
+			if in.IsNull() {
+				in.Skip()
+				out.Playlists = nil
+			} else {
+				in.Delim('[')
+				if out.Playlists == nil {
+					if !in.IsDelim(']') {
+						out.Playlists = make([]Playlist, 0, 1)
+					} else {
+						out.Playlists = []Playlist{}
+					}
+				} else {
+					out.Playlists = (out.Playlists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Playlist
+					(v1).UnmarshalEasyJSON(in)
+					out.Playlists = append(out.Playlists, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "liked_tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedTracks = nil
+			} else {
+				in.Delim('[')
+				if out.LikedTracks == nil {
+					if !in.IsDelim(']') {
+						out.LikedTracks = make([]Track, 0, 1)
+					} else {
+						out.LikedTracks = []Track{}
+					}
+				} else {
+					out.LikedTracks = (out.LikedTracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 Track
+					(v2).UnmarshalEasyJSON(in)
+					out.LikedTracks = append(out.LikedTracks, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "id":
 			out.Id = string(in.String())
 		case "password":
@@ -332,6 +490,10 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			out.Image = string(in.String())
 		case "email":
 			out.Email = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -342,13 +504,51 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(out *jwriter.Writer, in User) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(out *jwriter.Writer, in UserProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"stat\":"
 		out.RawString(prefix[1:])
+		(in.Stat).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"is_followed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFollowed))
+	}
+	if len(in.Playlists) != 0 {
+		const prefix string = ",\"playlists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Playlists {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.LikedTracks) != 0 {
+		const prefix string = ",\"liked_tracks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.LikedTracks {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
 		out.String(string(in.Id))
 	}
 	if in.Password != "" {
@@ -381,33 +581,43 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix)
 		out.String(string(in.Email))
 	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if in.ArtistId != "" {
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistId))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v User) MarshalJSON() ([]byte, error) {
+func (v UserProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(w, v)
+func (v UserProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *User) UnmarshalJSON(data []byte) error {
+func (v *UserProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels3(l, v)
+func (v *UserProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(in *jlexer.Lexer, out *TrackSearch) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(in *jlexer.Lexer, out *UserPreview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -427,13 +637,11 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		}
 		switch key {
 		case "id":
-			out.TrackID = string(in.String())
+			out.Id = string(in.String())
+		case "login":
+			out.Login = string(in.String())
 		case "name":
-			out.TrackName = string(in.String())
-		case "artist":
-			out.ArtistName = string(in.String())
-		case "artist_id":
-			out.ArtistID = string(in.String())
+			out.Name = string(in.String())
 		case "image":
 			out.Image = string(in.String())
 		default:
@@ -446,29 +654,24 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(out *jwriter.Writer, in TrackSearch) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(out *jwriter.Writer, in UserPreview) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.TrackID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.TrackName))
+		out.String(string(in.Id))
 	}
 	{
-		const prefix string = ",\"artist\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix)
-		out.String(string(in.ArtistName))
+		out.String(string(in.Login))
 	}
 	{
-		const prefix string = ",\"artist_id\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.ArtistID))
+		out.String(string(in.Name))
 	}
 	{
 		const prefix string = ",\"image\":"
@@ -479,29 +682,29 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackSearch) MarshalJSON() ([]byte, error) {
+func (v UserPreview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackSearch) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(w, v)
+func (v UserPreview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackSearch) UnmarshalJSON(data []byte) error {
+func (v *UserPreview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels4(l, v)
+func (v *UserPreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(in *jlexer.Lexer, out *Track) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -522,20 +725,22 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		switch key {
 		case "id":
 			out.Id = string(in.String())
+		case "password":
+			out.Password = string(in.String())
 		case "name":
 			out.Name = string(in.String())
-		case "artist":
-			out.Artist = string(in.String())
-		case "duration":
-			out.Duration = uint(in.Uint())
+		case "login":
+			out.Login = string(in.String())
+		case "sex":
+			out.Sex = string(in.String())
 		case "image":
 			out.Image = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "role":
+			out.Role = string(in.String())
 		case "artist_id":
-			out.ArtistID = string(in.String())
-		case "link":
-			out.Link = string(in.String())
-		case "is_liked":
-			out.IsLiked = bool(in.Bool())
+			out.ArtistId = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -546,7 +751,7 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(out *jwriter.Writer, in Track) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -555,20 +760,25 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix[1:])
 		out.String(string(in.Id))
 	}
+	if in.Password != "" {
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"artist\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix)
-		out.String(string(in.Artist))
+		out.String(string(in.Login))
 	}
 	{
-		const prefix string = ",\"duration\":"
+		const prefix string = ",\"sex\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Duration))
+		out.String(string(in.Sex))
 	}
 	{
 		const prefix string = ",\"image\":"
@@ -576,47 +786,47 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.String(string(in.Image))
 	}
 	{
-		const prefix string = ",\"artist_id\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix)
-		out.String(string(in.ArtistID))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"link\":"
+		const prefix string = ",\"role\":"
 		out.RawString(prefix)
-		out.String(string(in.Link))
+		out.String(string(in.Role))
 	}
-	{
-		const prefix string = ",\"is_liked\":"
+	if in.ArtistId != "" {
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsLiked))
+		out.String(string(in.ArtistId))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Track) MarshalJSON() ([]byte, error) {
+func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Track) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(w, v)
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Track) UnmarshalJSON(data []byte) error {
+func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Track) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels5(l, v)
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(in *jlexer.Lexer, out *SearchResult) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(in *jlexer.Lexer, out *TwoFactorSetup) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -635,29 +845,2608 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "artists":
-			if in.IsNull() {
-				in.Skip()
-				out.Artists = nil
-			} else {
+		case "secret":
+			out.Secret = string(in.String())
+		case "uri":
+			out.URI = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(out *jwriter.Writer, in TwoFactorSetup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"uri\":"
+		out.RawString(prefix)
+		out.String(string(in.URI))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorSetup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorSetup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(in *jlexer.Lexer, out *TwoFactorInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(out *jwriter.Writer, in TwoFactorInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(in *jlexer.Lexer, out *TrackSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.TrackID = string(in.String())
+		case "name":
+			out.TrackName = string(in.String())
+		case "artist":
+			out.ArtistName = string(in.String())
+		case "artist_id":
+			out.ArtistID = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "lyrics":
+			out.Lyrics = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(out *jwriter.Writer, in TrackSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TrackID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackName))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistName))
+	}
+	{
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistID))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	if in.Lyrics != "" {
+		const prefix string = ",\"lyrics\":"
+		out.RawString(prefix)
+		out.String(string(in.Lyrics))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(in *jlexer.Lexer, out *Track) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "artist":
+			out.Artist = string(in.String())
+		case "duration":
+			out.Duration = uint(in.Uint())
+		case "image":
+			out.Image = string(in.String())
+		case "artist_id":
+			out.ArtistID = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistCredit, 0, 1)
+					} else {
+						out.Artists = []ArtistCredit{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 ArtistCredit
+					(v7).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(out *jwriter.Writer, in Track) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Duration))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistID))
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Artists {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Track) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Track) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Track) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Track) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(in *jlexer.Lexer, out *SearchResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistSearch, 0, 1)
+					} else {
+						out.Artists = []ArtistSearch{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 ArtistSearch
+					(v10).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]AlbumSearch, 0, 1)
+					} else {
+						out.Albums = []AlbumSearch{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 AlbumSearch
+					(v11).UnmarshalEasyJSON(in)
+					out.Albums = append(out.Albums, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]TrackSearch, 0, 1)
+					} else {
+						out.Tracks = []TrackSearch{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 TrackSearch
+					(v12).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lyrics":
+			if in.IsNull() {
+				in.Skip()
+				out.Lyrics = nil
+			} else {
+				in.Delim('[')
+				if out.Lyrics == nil {
+					if !in.IsDelim(']') {
+						out.Lyrics = make([]TrackSearch, 0, 1)
+					} else {
+						out.Lyrics = []TrackSearch{}
+					}
+				} else {
+					out.Lyrics = (out.Lyrics)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 TrackSearch
+					(v13).UnmarshalEasyJSON(in)
+					out.Lyrics = append(out.Lyrics, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(out *jwriter.Writer, in SearchResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix[1:])
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Artists {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Albums {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				(v17).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Tracks {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				(v19).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lyrics\":"
+		out.RawString(prefix)
+		if in.Lyrics == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Lyrics {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(in *jlexer.Lexer, out *ReleasePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(out *jwriter.Writer, in ReleasePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReleasePayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReleasePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReleasePayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReleasePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(in *jlexer.Lexer, out *RecoveryCodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recovery_codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]string, 0, 4)
+					} else {
+						out.Codes = []string{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 string
+					v22 = string(in.String())
+					out.Codes = append(out.Codes, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(out *jwriter.Writer, in RecoveryCodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recovery_codes\":"
+		out.RawString(prefix[1:])
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Codes {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(in *jlexer.Lexer, out *QueueTrack) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "track_id":
+			out.TrackId = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(out *jwriter.Writer, in QueueTrack) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueueTrack) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueueTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueueTrack) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueueTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(in *jlexer.Lexer, out *QueueSeed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "source":
+			out.Source = string(in.String())
+		case "id":
+			out.Id = string(in.String())
+		case "shuffle":
+			out.Shuffle = bool(in.Bool())
+		case "radio":
+			out.Radio = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(out *jwriter.Writer, in QueueSeed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"shuffle\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shuffle))
+	}
+	{
+		const prefix string = ",\"radio\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Radio))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueueSeed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueueSeed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueueSeed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueueSeed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(in *jlexer.Lexer, out *PlaylistsID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlists":
+			if in.IsNull() {
+				in.Skip()
+				out.IDs = nil
+			} else {
+				in.Delim('[')
+				if out.IDs == nil {
+					if !in.IsDelim(']') {
+						out.IDs = make([]string, 0, 4)
+					} else {
+						out.IDs = []string{}
+					}
+				} else {
+					out.IDs = (out.IDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.IDs = append(out.IDs, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(out *jwriter.Writer, in PlaylistsID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlists\":"
+		out.RawString(prefix[1:])
+		if in.IDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.IDs {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistsID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistsID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistsID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistsID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(in *jlexer.Lexer, out *PlaylistTracksArray) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]Track, 0, 1)
+					} else {
+						out.Tracks = []Track{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 Track
+					(v28).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(out *jwriter.Writer, in PlaylistTracksArray) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Tracks {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistTracksArray) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistTracksArray) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistTracksArray) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistTracksArray) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(in *jlexer.Lexer, out *PlaylistTracks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlist_id":
+			out.PlaylistID = string(in.String())
+		case "track_id":
+			out.TrackID = string(in.String())
+		case "index":
+			out.Index = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(out *jwriter.Writer, in PlaylistTracks) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlist_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PlaylistID))
+	}
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackID))
+	}
+	if in.Index != "" {
+		const prefix string = ",\"index\":"
+		out.RawString(prefix)
+		out.String(string(in.Index))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistTracks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistTracks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistTracks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistTracks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(in *jlexer.Lexer, out *PlaylistSharePayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlist_id":
+			out.PlaylistId = string(in.String())
+		case "playlist_name":
+			out.PlaylistName = string(in.String())
+		case "user_id":
+			out.UserId = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(out *jwriter.Writer, in PlaylistSharePayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlist_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PlaylistId))
+	}
+	{
+		const prefix string = ",\"playlist_name\":"
+		out.RawString(prefix)
+		out.String(string(in.PlaylistName))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistSharePayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistSharePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistSharePayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistSharePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(in *jlexer.Lexer, out *PlaylistExport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]Track, 0, 1)
+					} else {
+						out.Tracks = []Track{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 Track
+					(v31).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "user_id":
+			out.UserId = string(in.String())
+		case "private":
+			out.Private = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(out *jwriter.Writer, in PlaylistExport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix[1:])
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Tracks {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				(v33).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Image != "" {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistExport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistExport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistExport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistExport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(in *jlexer.Lexer, out *Playlist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "user_id":
+			out.UserId = string(in.String())
+		case "private":
+			out.Private = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(out *jwriter.Writer, in Playlist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Image != "" {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Playlist) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Playlist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Playlist) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Playlist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(in *jlexer.Lexer, out *PlayerState) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "device_id":
+			out.DeviceId = string(in.String())
+		case "queue":
+			if in.IsNull() {
+				in.Skip()
+				out.Queue = nil
+			} else {
+				in.Delim('[')
+				if out.Queue == nil {
+					if !in.IsDelim(']') {
+						out.Queue = make([]string, 0, 4)
+					} else {
+						out.Queue = []string{}
+					}
+				} else {
+					out.Queue = (out.Queue)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 string
+					v34 = string(in.String())
+					out.Queue = append(out.Queue, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "index":
+			out.Index = uint(in.Uint())
+		case "track_id":
+			out.TrackId = string(in.String())
+		case "position":
+			out.Position = uint(in.Uint())
+		case "shuffle":
+			out.Shuffle = bool(in.Bool())
+		case "repeat":
+			out.Repeat = string(in.String())
+		case "radio":
+			out.Radio = bool(in.Bool())
+		case "paused":
+			out.Paused = bool(in.Bool())
+		case "updated_at":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(out *jwriter.Writer, in PlayerState) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DeviceId))
+	}
+	{
+		const prefix string = ",\"queue\":"
+		out.RawString(prefix)
+		if in.Queue == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Queue {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v36))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"index\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Index))
+	}
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackId))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Position))
+	}
+	{
+		const prefix string = ",\"shuffle\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shuffle))
+	}
+	{
+		const prefix string = ",\"repeat\":"
+		out.RawString(prefix)
+		out.String(string(in.Repeat))
+	}
+	{
+		const prefix string = ",\"radio\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Radio))
+	}
+	{
+		const prefix string = ",\"paused\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Paused))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlayerState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlayerState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlayerState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlayerState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(in *jlexer.Lexer, out *PasswordConfirm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(out *jwriter.Writer, in PasswordConfirm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PasswordConfirm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PasswordConfirm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PasswordConfirm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PasswordConfirm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "user_id":
+			out.UserId = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "payload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Payload).UnmarshalJSON(data))
+			}
+		case "read":
+			out.Read = bool(in.Bool())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.Raw((in.Payload).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Read))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(in *jlexer.Lexer, out *LyricsLine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time_ms":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeMs = nil
+			} else {
+				if out.TimeMs == nil {
+					out.TimeMs = new(uint64)
+				}
+				*out.TimeMs = uint64(in.Uint64())
+			}
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(out *jwriter.Writer, in LyricsLine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.TimeMs != nil {
+		const prefix string = ",\"time_ms\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(*in.TimeMs))
+	}
+	{
+		const prefix string = ",\"text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LyricsLine) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LyricsLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LyricsLine) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LyricsLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(in *jlexer.Lexer, out *LyricsInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(out *jwriter.Writer, in LyricsInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LyricsInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LyricsInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LyricsInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LyricsInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(in *jlexer.Lexer, out *Lyrics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackId = string(in.String())
+		case "synced":
+			out.Synced = bool(in.Bool())
+		case "lines":
+			if in.IsNull() {
+				in.Skip()
+				out.Lines = nil
+			} else {
+				in.Delim('[')
+				if out.Lines == nil {
+					if !in.IsDelim(']') {
+						out.Lines = make([]LyricsLine, 0, 2)
+					} else {
+						out.Lines = []LyricsLine{}
+					}
+				} else {
+					out.Lines = (out.Lines)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 LyricsLine
+					(v37).UnmarshalEasyJSON(in)
+					out.Lines = append(out.Lines, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(out *jwriter.Writer, in Lyrics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TrackId))
+	}
+	{
+		const prefix string = ",\"synced\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Synced))
+	}
+	{
+		const prefix string = ",\"lines\":"
+		out.RawString(prefix)
+		if in.Lines == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Lines {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				(v39).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Lyrics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Lyrics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Lyrics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Lyrics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(in *jlexer.Lexer, out *GenreTags) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]string, 0, 4)
+					} else {
+						out.Genres = []string{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 string
+					v40 = string(in.String())
+					out.Genres = append(out.Genres, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(out *jwriter.Writer, in GenreTags) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix[1:])
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Genres {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v42))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenreTags) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenreTags) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenreTags) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenreTags) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(in *jlexer.Lexer, out *Genre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "parent_id":
+			out.ParentId = string(in.String())
+		case "subgenres":
+			if in.IsNull() {
+				in.Skip()
+				out.Subgenres = nil
+			} else {
+				in.Delim('[')
+				if out.Subgenres == nil {
+					if !in.IsDelim(']') {
+						out.Subgenres = make([]Genre, 0, 1)
+					} else {
+						out.Subgenres = []Genre{}
+					}
+				} else {
+					out.Subgenres = (out.Subgenres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 Genre
+					(v43).UnmarshalEasyJSON(in)
+					out.Subgenres = append(out.Subgenres, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(out *jwriter.Writer, in Genre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.ParentId != "" {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentId))
+	}
+	if len(in.Subgenres) != 0 {
+		const prefix string = ",\"subgenres\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v44, v45 := range in.Subgenres {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Genre) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Genre) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Genre) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Genre) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels29(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(in *jlexer.Lexer, out *FeedItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "actor_id":
+			out.ActorId = string(in.String())
+		case "actor_name":
+			out.ActorName = string(in.String())
+		case "track_id":
+			out.TrackId = string(in.String())
+		case "track_name":
+			out.TrackName = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(out *jwriter.Writer, in FeedItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"actor_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorId))
+	}
+	{
+		const prefix string = ",\"actor_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorName))
+	}
+	if in.TrackId != "" {
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackId))
+	}
+	if in.TrackName != "" {
+		const prefix string = ",\"track_name\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackName))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FeedItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FeedItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FeedItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FeedItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(in *jlexer.Lexer, out *Feed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
 				in.Delim('[')
-				if out.Artists == nil {
+				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Artists = make([]ArtistSearch, 0, 1)
+						out.Items = make([]FeedItem, 0, 1)
 					} else {
-						out.Artists = []ArtistSearch{}
+						out.Items = []FeedItem{}
 					}
 				} else {
-					out.Artists = (out.Artists)[:0]
+					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 ArtistSearch
-					(v1).UnmarshalEasyJSON(in)
-					out.Artists = append(out.Artists, v1)
+					var v46 FeedItem
+					(v46).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v46)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(out *jwriter.Writer, in Feed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Items {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Feed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Feed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Feed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Feed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(in *jlexer.Lexer, out *Discography) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ArtistId = string(in.String())
 		case "albums":
 			if in.IsNull() {
 				in.Skip()
@@ -666,40 +3455,374 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 				in.Delim('[')
 				if out.Albums == nil {
 					if !in.IsDelim(']') {
-						out.Albums = make([]AlbumSearch, 0, 1)
+						out.Albums = make([]AlbumDetails, 0, 1)
 					} else {
-						out.Albums = []AlbumSearch{}
+						out.Albums = []AlbumDetails{}
 					}
 				} else {
 					out.Albums = (out.Albums)[:0]
 				}
-				for !in.IsDelim(']') {
-					var v2 AlbumSearch
-					(v2).UnmarshalEasyJSON(in)
-					out.Albums = append(out.Albums, v2)
-					in.WantComma()
+				for !in.IsDelim(']') {
+					var v49 AlbumDetails
+					(v49).UnmarshalEasyJSON(in)
+					out.Albums = append(out.Albums, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "singles":
+			if in.IsNull() {
+				in.Skip()
+				out.Singles = nil
+			} else {
+				in.Delim('[')
+				if out.Singles == nil {
+					if !in.IsDelim(']') {
+						out.Singles = make([]AlbumDetails, 0, 1)
+					} else {
+						out.Singles = []AlbumDetails{}
+					}
+				} else {
+					out.Singles = (out.Singles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v50 AlbumDetails
+					(v50).UnmarshalEasyJSON(in)
+					out.Singles = append(out.Singles, v50)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "eps":
+			if in.IsNull() {
+				in.Skip()
+				out.EPs = nil
+			} else {
+				in.Delim('[')
+				if out.EPs == nil {
+					if !in.IsDelim(']') {
+						out.EPs = make([]AlbumDetails, 0, 1)
+					} else {
+						out.EPs = []AlbumDetails{}
+					}
+				} else {
+					out.EPs = (out.EPs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v51 AlbumDetails
+					(v51).UnmarshalEasyJSON(in)
+					out.EPs = append(out.EPs, v51)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "compilations":
+			if in.IsNull() {
+				in.Skip()
+				out.Compilations = nil
+			} else {
+				in.Delim('[')
+				if out.Compilations == nil {
+					if !in.IsDelim(']') {
+						out.Compilations = make([]AlbumDetails, 0, 1)
+					} else {
+						out.Compilations = []AlbumDetails{}
+					}
+				} else {
+					out.Compilations = (out.Compilations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 AlbumDetails
+					(v52).UnmarshalEasyJSON(in)
+					out.Compilations = append(out.Compilations, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(out *jwriter.Writer, in Discography) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ArtistId))
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Albums {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				(v54).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"singles\":"
+		out.RawString(prefix)
+		if in.Singles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Singles {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				(v56).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"eps\":"
+		out.RawString(prefix)
+		if in.EPs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.EPs {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				(v58).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"compilations\":"
+		out.RawString(prefix)
+		if in.Compilations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Compilations {
+				if v59 > 0 {
+					out.RawByte(',')
 				}
-				in.Delim(']')
+				(v60).MarshalEasyJSON(out)
 			}
-		case "tracks":
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Discography) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Discography) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Discography) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Discography) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels32(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(in *jlexer.Lexer, out *ChartEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int(in.Int())
+		case "previous_position":
+			out.PreviousPosition = int(in.Int())
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
+		case "artist_name":
+			out.ArtistName = string(in.String())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "likes":
+			out.Likes = int64(in.Int64())
+		case "score":
+			out.Score = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(out *jwriter.Writer, in ChartEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Position))
+	}
+	if in.PreviousPosition != 0 {
+		const prefix string = ",\"previous_position\":"
+		out.RawString(prefix)
+		out.Int(int(in.PreviousPosition))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Image != "" {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	if in.ArtistId != "" {
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistId))
+	}
+	if in.ArtistName != "" {
+		const prefix string = ",\"artist_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistName))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Likes))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Score))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChartEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChartEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChartEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChartEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels33(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(in *jlexer.Lexer, out *Chart) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "window":
+			out.Window = string(in.String())
+		case "genre_id":
+			out.GenreId = string(in.String())
+		case "period":
+			out.Period = string(in.String())
+		case "entries":
 			if in.IsNull() {
 				in.Skip()
-				out.Tracks = nil
+				out.Entries = nil
 			} else {
 				in.Delim('[')
-				if out.Tracks == nil {
+				if out.Entries == nil {
 					if !in.IsDelim(']') {
-						out.Tracks = make([]TrackSearch, 0, 1)
+						out.Entries = make([]ChartEntry, 0, 1)
 					} else {
-						out.Tracks = []TrackSearch{}
+						out.Entries = []ChartEntry{}
 					}
 				} else {
-					out.Tracks = (out.Tracks)[:0]
+					out.Entries = (out.Entries)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v3 TrackSearch
-					(v3).UnmarshalEasyJSON(in)
-					out.Tracks = append(out.Tracks, v3)
+					var v61 ChartEntry
+					(v61).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -714,85 +3837,183 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(out *jwriter.Writer, in SearchResult) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(out *jwriter.Writer, in Chart) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artists\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v4, v5 := range in.Artists {
-				if v4 > 0 {
-					out.RawByte(',')
-				}
-				(v5).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"albums\":"
+		const prefix string = ",\"window\":"
 		out.RawString(prefix)
-		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Window))
+	}
+	if in.GenreId != "" {
+		const prefix string = ",\"genre_id\":"
+		out.RawString(prefix)
+		out.String(string(in.GenreId))
+	}
+	if in.Period != "" {
+		const prefix string = ",\"period\":"
+		out.RawString(prefix)
+		out.String(string(in.Period))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Albums {
-				if v6 > 0 {
+			for v62, v63 := range in.Entries {
+				if v62 > 0 {
 					out.RawByte(',')
 				}
-				(v7).MarshalEasyJSON(out)
+				(v63).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Chart) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Chart) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Chart) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Chart) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels34(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(in *jlexer.Lexer, out *AuditEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "user_id":
+			out.UserId = string(in.String())
+		case "entity":
+			out.Entity = string(in.String())
+		case "entity_id":
+			out.EntityId = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "changes":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Changes).UnmarshalJSON(data))
+			}
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(out *jwriter.Writer, in AuditEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserId))
+	}
+	{
+		const prefix string = ",\"entity\":"
+		out.RawString(prefix)
+		out.String(string(in.Entity))
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityId))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	if len(in.Changes) != 0 {
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		out.Raw((in.Changes).MarshalJSON())
+	}
 	{
-		const prefix string = ",\"tracks\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Tracks {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.CreatedAt))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchResult) MarshalJSON() ([]byte, error) {
+func (v AuditEntry) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(w, v)
+func (v AuditEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchResult) UnmarshalJSON(data []byte) error {
+func (v *AuditEntry) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels6(l, v)
+func (v *AuditEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels35(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(in *jlexer.Lexer, out *PlaylistsID) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(in *jlexer.Lexer, out *Artists) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -811,25 +4032,25 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "playlists":
+		case "artists":
 			if in.IsNull() {
 				in.Skip()
-				out.IDs = nil
+				out.Artists = nil
 			} else {
 				in.Delim('[')
-				if out.IDs == nil {
+				if out.Artists == nil {
 					if !in.IsDelim(']') {
-						out.IDs = make([]string, 0, 4)
+						out.Artists = make([]Artist, 0, 1)
 					} else {
-						out.IDs = []string{}
+						out.Artists = []Artist{}
 					}
 				} else {
-					out.IDs = (out.IDs)[:0]
+					out.Artists = (out.Artists)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.IDs = append(out.IDs, v10)
+					var v64 Artist
+					(v64).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v64)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -844,22 +4065,22 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(out *jwriter.Writer, in PlaylistsID) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(out *jwriter.Writer, in Artists) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"playlists\":"
+		const prefix string = ",\"artists\":"
 		out.RawString(prefix[1:])
-		if in.IDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.IDs {
-				if v11 > 0 {
+			for v65, v66 := range in.Artists {
+				if v65 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				(v66).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -868,29 +4089,29 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PlaylistsID) MarshalJSON() ([]byte, error) {
+func (v Artists) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistsID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(w, v)
+func (v Artists) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistsID) UnmarshalJSON(data []byte) error {
+func (v *Artists) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistsID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels7(l, v)
+func (v *Artists) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels36(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(in *jlexer.Lexer, out *PlaylistTracksArray) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(in *jlexer.Lexer, out *ArtistSubscription) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -909,31 +4130,10 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = string(in.String())
-		case "tracks":
-			if in.IsNull() {
-				in.Skip()
-				out.Tracks = nil
-			} else {
-				in.Delim('[')
-				if out.Tracks == nil {
-					if !in.IsDelim(']') {
-						out.Tracks = make([]Track, 0, 1)
-					} else {
-						out.Tracks = []Track{}
-					}
-				} else {
-					out.Tracks = (out.Tracks)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 Track
-					(v13).UnmarshalEasyJSON(in)
-					out.Tracks = append(out.Tracks, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "artist_id":
+			out.ArtistID = string(in.String())
+		case "user_id":
+			out.USerID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -944,58 +4144,47 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(out *jwriter.Writer, in PlaylistTracksArray) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(out *jwriter.Writer, in ArtistSubscription) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Id))
+		out.String(string(in.ArtistID))
 	}
 	{
-		const prefix string = ",\"tracks\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix)
-		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Tracks {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				(v15).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.USerID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PlaylistTracksArray) MarshalJSON() ([]byte, error) {
+func (v ArtistSubscription) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistTracksArray) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(w, v)
+func (v ArtistSubscription) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistTracksArray) UnmarshalJSON(data []byte) error {
+func (v *ArtistSubscription) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistTracksArray) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels8(l, v)
+func (v *ArtistSubscription) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels37(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(in *jlexer.Lexer, out *PlaylistTracks) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(in *jlexer.Lexer, out *ArtistStat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1014,14 +4203,14 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "playlist_id":
-			out.PlaylistID = string(in.String())
-		case "track_id":
-			out.TrackID = string(in.String())
-		case "index":
-			out.Index = string(in.String())
-		case "image":
-			out.Image = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
+		case "tracks":
+			out.Tracks = uint64(in.Uint64())
+		case "albums":
+			out.Albums = uint64(in.Uint64())
+		case "subscribers":
+			out.Subscribers = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1032,57 +4221,57 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(out *jwriter.Writer, in PlaylistTracks) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(out *jwriter.Writer, in ArtistStat) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"playlist_id\":"
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.PlaylistID))
+		out.String(string(in.ArtistId))
 	}
 	{
-		const prefix string = ",\"track_id\":"
+		const prefix string = ",\"tracks\":"
 		out.RawString(prefix)
-		out.String(string(in.TrackID))
+		out.Uint64(uint64(in.Tracks))
 	}
-	if in.Index != "" {
-		const prefix string = ",\"index\":"
+	{
+		const prefix string = ",\"albums\":"
 		out.RawString(prefix)
-		out.String(string(in.Index))
+		out.Uint64(uint64(in.Albums))
 	}
 	{
-		const prefix string = ",\"image\":"
+		const prefix string = ",\"subscribers\":"
 		out.RawString(prefix)
-		out.String(string(in.Image))
+		out.Uint64(uint64(in.Subscribers))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PlaylistTracks) MarshalJSON() ([]byte, error) {
+func (v ArtistStat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistTracks) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(w, v)
+func (v ArtistStat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistTracks) UnmarshalJSON(data []byte) error {
+func (v *ArtistStat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistTracks) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels9(l, v)
+func (v *ArtistStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels38(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(in *jlexer.Lexer, out *Playlist) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(in *jlexer.Lexer, out *ArtistSearch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1102,15 +4291,11 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		}
 		switch key {
 		case "id":
-			out.Id = string(in.String())
+			out.ArtistID = string(in.String())
 		case "name":
 			out.Name = string(in.String())
 		case "image":
 			out.Image = string(in.String())
-		case "user_id":
-			out.UserId = string(in.String())
-		case "private":
-			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1121,62 +4306,150 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(out *jwriter.Writer, in Playlist) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(out *jwriter.Writer, in ArtistSearch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Id))
+		out.String(string(in.ArtistID))
 	}
-	if in.Name != "" {
+	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
-	if in.Image != "" {
+	{
 		const prefix string = ",\"image\":"
 		out.RawString(prefix)
 		out.String(string(in.Image))
 	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.String(string(in.UserId))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ArtistSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ArtistSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ArtistSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ArtistSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels39(l, v)
+}
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(in *jlexer.Lexer, out *ArtistCredits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistCredit, 0, 1)
+					} else {
+						out.Artists = []ArtistCredit{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 ArtistCredit
+					(v67).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(out *jwriter.Writer, in ArtistCredits) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"private\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Private))
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix[1:])
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Artists {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				(v69).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Playlist) MarshalJSON() ([]byte, error) {
+func (v ArtistCredits) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Playlist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(w, v)
+func (v ArtistCredits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Playlist) UnmarshalJSON(data []byte) error {
+func (v *ArtistCredits) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Playlist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels10(l, v)
+func (v *ArtistCredits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels40(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(in *jlexer.Lexer, out *Artists) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(in *jlexer.Lexer, out *ArtistCredit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1195,29 +4468,12 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "artists":
-			if in.IsNull() {
-				in.Skip()
-				out.Artists = nil
-			} else {
-				in.Delim('[')
-				if out.Artists == nil {
-					if !in.IsDelim(']') {
-						out.Artists = make([]Artist, 0, 1)
-					} else {
-						out.Artists = []Artist{}
-					}
-				} else {
-					out.Artists = (out.Artists)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 Artist
-					(v16).UnmarshalEasyJSON(in)
-					out.Artists = append(out.Artists, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1228,53 +4484,52 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(out *jwriter.Writer, in Artists) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(out *jwriter.Writer, in ArtistCredit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artists\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.Artists {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				(v18).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Id))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Artists) MarshalJSON() ([]byte, error) {
+func (v ArtistCredit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Artists) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(w, v)
+func (v ArtistCredit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Artists) UnmarshalJSON(data []byte) error {
+func (v *ArtistCredit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Artists) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels11(l, v)
+func (v *ArtistCredit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels41(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(in *jlexer.Lexer, out *ArtistSubscription) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(in *jlexer.Lexer, out *Artist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1293,10 +4548,16 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "artist_id":
-			out.ArtistID = string(in.String())
-		case "user_id":
-			out.USerID = string(in.String())
+		case "id":
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "genre":
+			out.Genre = string(in.String())
+		case "is_subscribed":
+			out.IsSubscribed = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1307,47 +4568,62 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(out *jwriter.Writer, in ArtistSubscription) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(out *jwriter.Writer, in Artist) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artist_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ArtistID))
+		out.String(string(in.Id))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.USerID))
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		out.String(string(in.Genre))
+	}
+	{
+		const prefix string = ",\"is_subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribed))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ArtistSubscription) MarshalJSON() ([]byte, error) {
+func (v Artist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ArtistSubscription) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(w, v)
+func (v Artist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ArtistSubscription) UnmarshalJSON(data []byte) error {
+func (v *Artist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ArtistSubscription) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels12(l, v)
+func (v *Artist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels42(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(in *jlexer.Lexer, out *ArtistStat) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(in *jlexer.Lexer, out *AlbumTracks) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1366,14 +4642,52 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
-		case "artist_id":
-			out.ArtistId = string(in.String())
 		case "tracks":
-			out.Tracks = uint64(in.Uint64())
-		case "albums":
-			out.Albums = uint64(in.Uint64())
-		case "subscribers":
-			out.Subscribers = uint64(in.Uint64())
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]string, 0, 4)
+					} else {
+						out.Tracks = []string{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 string
+					v70 = string(in.String())
+					out.Tracks = append(out.Tracks, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "discs":
+			if in.IsNull() {
+				in.Skip()
+				out.Discs = nil
+			} else {
+				in.Delim('[')
+				if out.Discs == nil {
+					if !in.IsDelim(']') {
+						out.Discs = make([]uint, 0, 8)
+					} else {
+						out.Discs = []uint{}
+					}
+				} else {
+					out.Discs = (out.Discs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v71 uint
+					v71 = uint(in.Uint())
+					out.Discs = append(out.Discs, v71)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1384,57 +4698,67 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(out *jwriter.Writer, in ArtistStat) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(out *jwriter.Writer, in AlbumTracks) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"artist_id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ArtistId))
-	}
 	{
 		const prefix string = ",\"tracks\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Tracks))
-	}
-	{
-		const prefix string = ",\"albums\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Albums))
+		out.RawString(prefix[1:])
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v72, v73 := range in.Tracks {
+				if v72 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v73))
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"subscribers\":"
+	if len(in.Discs) != 0 {
+		const prefix string = ",\"discs\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Subscribers))
+		{
+			out.RawByte('[')
+			for v74, v75 := range in.Discs {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v75))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ArtistStat) MarshalJSON() ([]byte, error) {
+func (v AlbumTracks) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ArtistStat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(w, v)
+func (v AlbumTracks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ArtistStat) UnmarshalJSON(data []byte) error {
+func (v *AlbumTracks) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ArtistStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels13(l, v)
+func (v *AlbumTracks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels43(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(in *jlexer.Lexer, out *ArtistSearch) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(in *jlexer.Lexer, out *AlbumTrack) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1453,12 +4777,49 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
+		case "disc":
+			out.Disc = uint(in.Uint())
+		case "number":
+			out.Number = uint(in.Uint())
 		case "id":
-			out.ArtistID = string(in.String())
+			out.Id = string(in.String())
 		case "name":
 			out.Name = string(in.String())
+		case "artist":
+			out.Artist = string(in.String())
+		case "duration":
+			out.Duration = uint(in.Uint())
 		case "image":
 			out.Image = string(in.String())
+		case "artist_id":
+			out.ArtistID = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistCredit, 0, 1)
+					} else {
+						out.Artists = []ArtistCredit{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 ArtistCredit
+					(v76).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1469,52 +4830,101 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(out *jwriter.Writer, in ArtistSearch) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(out *jwriter.Writer, in AlbumTrack) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"disc\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ArtistID))
+		out.Uint(uint(in.Disc))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Number))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
 	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Duration))
+	}
 	{
 		const prefix string = ",\"image\":"
 		out.RawString(prefix)
 		out.String(string(in.Image))
 	}
+	{
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistID))
+	}
+	{
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v77, v78 := range in.Artists {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				(v78).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ArtistSearch) MarshalJSON() ([]byte, error) {
+func (v AlbumTrack) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ArtistSearch) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(w, v)
+func (v AlbumTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ArtistSearch) UnmarshalJSON(data []byte) error {
+func (v *AlbumTrack) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ArtistSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels14(l, v)
+func (v *AlbumTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels44(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(in *jlexer.Lexer, out *Artist) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(in *jlexer.Lexer, out *AlbumSearch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1534,15 +4944,15 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		}
 		switch key {
 		case "id":
-			out.Id = string(in.String())
+			out.AlbumID = string(in.String())
 		case "name":
-			out.Name = string(in.String())
+			out.AlbumName = string(in.String())
+		case "artist_id":
+			out.ArtistID = string(in.String())
+		case "artist_name":
+			out.ArtistName = string(in.String())
 		case "image":
 			out.Image = string(in.String())
-		case "genre":
-			out.Genre = string(in.String())
-		case "is_subscribed":
-			out.IsSubscribed = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1553,62 +4963,62 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(out *jwriter.Writer, in Artist) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(out *jwriter.Writer, in AlbumSearch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Id))
+		out.String(string(in.AlbumID))
 	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.AlbumName))
 	}
 	{
-		const prefix string = ",\"image\":"
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Image))
+		out.String(string(in.ArtistID))
 	}
 	{
-		const prefix string = ",\"genre\":"
+		const prefix string = ",\"artist_name\":"
 		out.RawString(prefix)
-		out.String(string(in.Genre))
+		out.String(string(in.ArtistName))
 	}
 	{
-		const prefix string = ",\"is_subscribed\":"
+		const prefix string = ",\"image\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsSubscribed))
+		out.String(string(in.Image))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Artist) MarshalJSON() ([]byte, error) {
+func (v AlbumSearch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Artist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(w, v)
+func (v AlbumSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Artist) UnmarshalJSON(data []byte) error {
+func (v *AlbumSearch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Artist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels15(l, v)
+func (v *AlbumSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels45(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(in *jlexer.Lexer, out *AlbumSearch) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(in *jlexer.Lexer, out *AlbumDetails) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1627,16 +5037,99 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			continue
 		}
 		switch key {
+		case "track_count":
+			out.TrackCount = uint(in.Uint())
+		case "duration":
+			out.Duration = uint(in.Uint())
+		case "disc_count":
+			out.DiscCount = uint(in.Uint())
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]AlbumTrack, 0, 1)
+					} else {
+						out.Tracks = []AlbumTrack{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 AlbumTrack
+					(v79).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "id":
-			out.AlbumID = string(in.String())
+			out.Id = string(in.String())
 		case "name":
-			out.AlbumName = string(in.String())
-		case "artist_id":
-			out.ArtistID = string(in.String())
-		case "artist_name":
-			out.ArtistName = string(in.String())
+			out.Name = string(in.String())
 		case "image":
 			out.Image = string(in.String())
+		case "release":
+			out.Release = string(in.String())
+		case "artist_name":
+			out.ArtistName = string(in.String())
+		case "artist_id":
+			out.ArtistId = string(in.String())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
+		case "release_type":
+			out.ReleaseType = string(in.String())
+		case "genre":
+			out.Genre = string(in.String())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]string, 0, 4)
+					} else {
+						out.Labels = []string{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 string
+					v80 = string(in.String())
+					out.Labels = append(out.Labels, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistCredit, 0, 1)
+					} else {
+						out.Artists = []ArtistCredit{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 ArtistCredit
+					(v81).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1647,24 +5140,58 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(out *jwriter.Writer, in AlbumSearch) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(out *jwriter.Writer, in AlbumDetails) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"track_count\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.AlbumID))
+		out.Uint(uint(in.TrackCount))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Duration))
+	}
+	{
+		const prefix string = ",\"disc_count\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.DiscCount))
+	}
+	if len(in.Tracks) != 0 {
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v82, v83 := range in.Tracks {
+				if v82 > 0 {
+					out.RawByte(',')
+				}
+				(v83).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.Id))
 	}
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.AlbumName))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"artist_id\":"
+		const prefix string = ",\"image\":"
 		out.RawString(prefix)
-		out.String(string(in.ArtistID))
+		out.String(string(in.Image))
+	}
+	{
+		const prefix string = ",\"release\":"
+		out.RawString(prefix)
+		out.String(string(in.Release))
 	}
 	{
 		const prefix string = ",\"artist_name\":"
@@ -1672,37 +5199,80 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.String(string(in.ArtistName))
 	}
 	{
-		const prefix string = ",\"image\":"
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Image))
+		out.String(string(in.ArtistId))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	if in.ReleaseType != "" {
+		const prefix string = ",\"release_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ReleaseType))
+	}
+	if in.Genre != "" {
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		out.String(string(in.Genre))
+	}
+	if len(in.Labels) != 0 {
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v84, v85 := range in.Labels {
+				if v84 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v85))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v86, v87 := range in.Artists {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				(v87).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AlbumSearch) MarshalJSON() ([]byte, error) {
+func (v AlbumDetails) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AlbumSearch) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(w, v)
+func (v AlbumDetails) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AlbumSearch) UnmarshalJSON(data []byte) error {
+func (v *AlbumDetails) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AlbumSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels16(l, v)
+func (v *AlbumDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels46(l, v)
 }
-func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(in *jlexer.Lexer, out *Album) {
+func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(in *jlexer.Lexer, out *Album) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1735,6 +5305,56 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 			out.ArtistId = string(in.String())
 		case "is_liked":
 			out.IsLiked = bool(in.Bool())
+		case "release_type":
+			out.ReleaseType = string(in.String())
+		case "genre":
+			out.Genre = string(in.String())
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]string, 0, 4)
+					} else {
+						out.Labels = []string{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 string
+					v88 = string(in.String())
+					out.Labels = append(out.Labels, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ArtistCredit, 0, 1)
+					} else {
+						out.Artists = []ArtistCredit{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v89 ArtistCredit
+					(v89).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v89)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1745,7 +5365,7 @@ func easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(out *jwriter.Writer, in Album) {
+func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(out *jwriter.Writer, in Album) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1784,29 +5404,67 @@ func easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgMode
 		out.RawString(prefix)
 		out.Bool(bool(in.IsLiked))
 	}
+	if in.ReleaseType != "" {
+		const prefix string = ",\"release_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ReleaseType))
+	}
+	if in.Genre != "" {
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		out.String(string(in.Genre))
+	}
+	if len(in.Labels) != 0 {
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v90, v91 := range in.Labels {
+				if v90 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v91))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v92, v93 := range in.Artists {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				(v93).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Album) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(&w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Album) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(w, v)
+	easyjsonD2b7633eEncodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Album) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(&r, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Album) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels17(l, v)
+	easyjsonD2b7633eDecodeGithubCom20201NoHomomorphismNoHomoMainInternalPkgModels47(l, v)
 }
@@ -0,0 +1,30 @@
+package models
+
+import "encoding/json"
+
+const (
+	NotificationRelease       = "release"
+	NotificationPlaylistShare = "playlist_share"
+)
+
+type Notification struct {
+	Id        string          `json:"id"`
+	UserId    string          `json:"user_id"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	Read      bool            `json:"read"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type ReleasePayload struct {
+	Type     string `json:"type"`
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	ArtistId string `json:"artist_id"`
+}
+
+type PlaylistSharePayload struct {
+	PlaylistId   string `json:"playlist_id"`
+	PlaylistName string `json:"playlist_name"`
+	UserId       string `json:"user_id"`
+}
@@ -0,0 +1,42 @@
+package models
+
+const (
+	RepeatOff = "off"
+	RepeatAll = "all"
+	RepeatOne = "one"
+)
+
+const (
+	QueueSourceAlbum    = "album"
+	QueueSourcePlaylist = "playlist"
+	QueueSourceArtist   = "artist"
+	QueueSourceTrack    = "track"
+)
+
+const NotificationPlayerState = "player_state"
+
+type PlayerState struct {
+	DeviceId  string   `json:"device_id"`
+	Queue     []string `json:"queue"`
+	Index     uint     `json:"index"`
+	TrackId   string   `json:"track_id"`
+	Position  uint     `json:"position"`
+	Shuffle   bool     `json:"shuffle"`
+	Repeat    string   `json:"repeat"`
+	Radio     bool     `json:"radio"`
+	Paused    bool     `json:"paused"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+type QueueSeed struct {
+	DeviceId string `json:"device_id"`
+	Source   string `json:"source"`
+	Id       string `json:"id"`
+	Shuffle  bool   `json:"shuffle"`
+	Radio    bool   `json:"radio"`
+}
+
+type QueueTrack struct {
+	DeviceId string `json:"device_id"`
+	TrackId  string `json:"track_id"`
+}
@@ -1,5 +1,10 @@
 package models
 
+import v "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
+
+// PlaylistNameLen follows the size of playlists.name in the migrations
+const PlaylistNameLen = 50
+
 type Playlist struct {
 	Id      string `json:"id"`
 	Name    string `json:"name,omitempty"`
@@ -15,6 +20,13 @@ type PlaylistTracks struct {
 	Image      string `json:"image"`
 }
 
+func (p PlaylistTracks) Validate() error {
+	return v.Validate(
+		v.Field("playlist_id", p.PlaylistID, v.Required, v.ID),
+		v.Field("track_id", p.TrackID, v.Required, v.ID),
+	)
+}
+
 type PlaylistsID struct {
 	IDs []string `json:"playlists"`
 }
@@ -23,3 +35,8 @@ type PlaylistTracksArray struct {
 	Id     string  `json:"id"`
 	Tracks []Track `json:"tracks"`
 }
+
+type PlaylistExport struct {
+	Playlist
+	Tracks []Track `json:"tracks"`
+}
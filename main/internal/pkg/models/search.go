@@ -4,4 +4,5 @@ type SearchResult struct {
 	Artists []ArtistSearch `json:"artists"`
 	Albums  []AlbumSearch  `json:"albums"`
 	Tracks  []TrackSearch  `json:"tracks"`
+	Lyrics  []TrackSearch  `json:"lyrics"`
 }
@@ -9,6 +9,8 @@ type Track struct {
 	ArtistID string `json:"artist_id"`
 	Link     string `json:"link"`
 	IsLiked  bool   `json:"is_liked"`
+	// primary artist is duplicated in Artist and ArtistID
+	Artists []ArtistCredit `json:"artists,omitempty"`
 }
 
 type TrackSearch struct {
@@ -17,4 +19,6 @@ type TrackSearch struct {
 	ArtistName string `json:"artist"`
 	ArtistID   string `json:"artist_id"`
 	Image      string `json:"image"`
+	// matched line when the track is found by lyrics
+	Lyrics string `json:"lyrics,omitempty"`
 }
@@ -1,5 +1,29 @@
 package models
 
+import v "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
+
+const (
+	RoleListener  = "listener"
+	RoleArtist    = "artist"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+const (
+	SexMale   = "male"
+	SexFemale = "female"
+	SexOther  = "other"
+)
+
+// limits follow the column sizes of the users table in the migrations
+const (
+	loginMinLen = 3
+	loginLen    = 32
+	userNameLen = 50
+	emailLen    = 320
+	imageLen    = 100
+)
+
 type User struct {
 	Id       string `json:"id"`
 	Password string `json:"password,omitempty"`
@@ -8,6 +32,32 @@ type User struct {
 	Sex      string `json:"sex"`
 	Image    string `json:"image"`
 	Email    string `json:"email"`
+	Role     string `json:"role"`
+	ArtistId string `json:"artist_id,omitempty"`
+}
+
+// Validate checks a sign up form, the role and artist id are never taken from it
+func (u User) Validate() error {
+	return v.Validate(
+		v.Field("login", u.Login, v.Required, v.MinLen(loginMinLen), v.MaxLen(loginLen), v.Login),
+		v.Field("password", u.Password, v.Required, v.Password),
+		v.Field("name", u.Name, v.Required, v.MaxLen(userNameLen)),
+		v.Field("email", u.Email, v.Required, v.MaxLen(emailLen), v.Email),
+		v.Field("sex", u.Sex, v.Required, v.OneOf(SexMale, SexFemale, SexOther)),
+		v.Field("image", u.Image, v.MaxLen(imageLen)),
+	)
+}
+
+type UserRole struct {
+	Role     string `json:"role"`
+	ArtistId string `json:"artist_id,omitempty"`
+}
+
+func (r UserRole) Validate() error {
+	return v.Validate(
+		v.Field("role", r.Role, v.Required, v.OneOf(RoleListener, RoleArtist, RoleModerator, RoleAdmin)),
+		v.Field("artist_id", r.ArtistId, v.ID),
+	)
 }
 
 type UserSettings struct {
@@ -15,9 +65,54 @@ type UserSettings struct {
 	User
 }
 
+// Validate checks the editable fields, password is the current one and only needed to set a new one
+func (s UserSettings) Validate() error {
+	oldPassword := []v.Rule{}
+	if s.NewPassword != "" {
+		oldPassword = append(oldPassword, v.Required)
+	}
+	return v.Validate(
+		v.Field("name", s.Name, v.Required, v.MaxLen(userNameLen)),
+		v.Field("email", s.Email, v.Required, v.MaxLen(emailLen), v.Email),
+		v.Field("password", s.Password, oldPassword...),
+		v.Field("new_password", s.NewPassword, v.Password),
+	)
+}
+
 type UserSignIn struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
+	Code     string `json:"code,omitempty"`
+}
+
+// Validate doesn't check formats, old accounts may not match the current rules
+func (s UserSignIn) Validate() error {
+	return v.Validate(
+		v.Field("login", s.Login, v.Required, v.MaxLen(loginLen)),
+		v.Field("password", s.Password, v.Required),
+	)
+}
+
+type TwoFactorSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type TwoFactorInput struct {
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+type PasswordConfirm struct {
+	Password string `json:"password"`
+}
+
+func (p PasswordConfirm) Validate() error {
+	return v.Validate(v.Field("password", p.Password, v.Required))
+}
+
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
 }
 
 type UserStat struct {
@@ -26,4 +121,21 @@ type UserStat struct {
 	Albums    uint64 `json:"albums"`
 	Playlists uint64 `json:"playlists"`
 	Artists   uint64 `json:"artists"`
+	Followers uint64 `json:"followers"`
+	Following uint64 `json:"following"`
+}
+
+type UserPreview struct {
+	Id    string `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+type UserProfile struct {
+	User
+	Stat        UserStat   `json:"stat"`
+	IsFollowed  bool       `json:"is_followed"`
+	Playlists   []Playlist `json:"playlists,omitempty"`
+	LikedTracks []Track    `json:"liked_tracks,omitempty"`
 }
@@ -0,0 +1,154 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/gorilla/mux"
+)
+
+const defaultKeepAlive = 30 * time.Second
+
+type NotificationHandler struct {
+	NotificationUC notification.UseCase
+	Log            *logger.MainLogger
+	// comment sent to idle streams so that proxies don't close them
+	KeepAlive time.Duration
+}
+
+// Stream sends notifications of the user as server-sent events until the client disconnects
+func (h *NotificationHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "notification delivery", "Stream", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "notification delivery", "Stream", "streaming is not supported")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	notifications, unsubscribe := h.NotificationUC.Subscribe(user.Id)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	interval := h.KeepAlive
+	if interval <= 0 {
+		interval = defaultKeepAlive
+	}
+	keepAlive := time.NewTicker(interval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			h.Log.HttpInfo(r.Context(), "stream closed", http.StatusOK)
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case elem, ok := <-notifications:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(elem)
+			if err != nil {
+				h.Log.LogWarning(r.Context(), "notification delivery", "Stream", "failed to encode json"+err.Error())
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", elem.Id, elem.Type, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "notification delivery", "GetNotifications", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	vars := mux.Vars(r)
+	start, okStart := vars["start"]
+	end, okEnd := vars["end"]
+
+	if !okStart || !okEnd {
+		h.Log.HttpInfo(r.Context(), "no data in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	uStart, err1 := strconv.ParseUint(start, 10, 32)
+	uEnd, err2 := strconv.ParseUint(end, 10, 32)
+	if err1 != nil || err2 != nil || uStart > uEnd {
+		h.Log.HttpInfo(r.Context(), "failed to parse start or end parameters", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	notifications, err := h.NotificationUC.GetNotifications(r.Context(), user.Id, uStart, uEnd)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get notifications"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Notifications []models.Notification `json:"notifications"`
+	}{notifications})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "notification delivery", "GetNotifications", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "notification delivery", "MarkRead", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.NotificationUC.MarkRead(r.Context(), user.Id, id); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to mark notification:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
@@ -0,0 +1,209 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var notificationHandler NotificationHandler
+
+var testUser = models.User{
+	Id:    "1",
+	Login: "test",
+}
+
+var testNotification = models.Notification{
+	Id:        "5",
+	UserId:    "1",
+	Type:      models.NotificationRelease,
+	Payload:   []byte(`{"type":"album","id":"3","name":"album","artist_id":"4"}`),
+	CreatedAt: "2020-05-12T10:00:00Z",
+}
+
+const testNotificationJSON = `{"id":"5","user_id":"1","type":"release","payload":{"type":"album","id":"3","name":"album","artist_id":"4"},"read":false,"created_at":"2020-05-12T10:00:00Z"}`
+
+func init() {
+	notificationHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestStream(t *testing.T) {
+	t.Run("Stream-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := notification.NewMockUseCase(ctrl)
+		notificationHandler.NotificationUC = m
+
+		// closed channel ends the stream after the buffered notification
+		ch := make(chan models.Notification, 1)
+		ch <- testNotification
+		close(ch)
+
+		unsubscribed := false
+		m.EXPECT().
+			Subscribe(testUser.Id).
+			Return(ch, func() { unsubscribed = true })
+
+		apitest.New("Stream-OK").
+			Handler(middleware.AuthMiddlewareMock(notificationHandler.Stream, true, testUser, "")).
+			Method("Get").
+			URL("/users/notifications/stream").
+			Expect(t).
+			Status(http.StatusOK).
+			Header("Content-Type", "text/event-stream").
+			Body("id: 5\nevent: release\ndata: " + testNotificationJSON + "\n\n").
+			End()
+
+		if !unsubscribed {
+			t.Error("stream did not unsubscribe")
+		}
+	})
+
+	t.Run("Stream-NoUser", func(t *testing.T) {
+		apitest.New("Stream-NoUser").
+			Handler(http.HandlerFunc(notificationHandler.Stream)).
+			Method("Get").
+			URL("/users/notifications/stream").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestGetNotifications(t *testing.T) {
+	t.Run("GetNotifications-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := notification.NewMockUseCase(ctrl)
+		notificationHandler.NotificationUC = m
+
+		m.EXPECT().
+			GetNotifications(gomock.Any(), testUser.Id, uint64(0), uint64(10)).
+			Return([]models.Notification{testNotification}, nil)
+
+		handler := middleware.AuthMiddlewareMock(
+			middleware.SetUnlimitedVars(notificationHandler.GetNotifications,
+				middleware.VarsPair{Key: "start", Value: "0"},
+				middleware.VarsPair{Key: "end", Value: "10"},
+			),
+			true, testUser, "")
+
+		apitest.New("GetNotifications-OK").
+			Handler(handler).
+			Method("Get").
+			URL("/users/notifications/0/10").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(`{"notifications":[` + testNotificationJSON + `]}`).
+			End()
+	})
+
+	t.Run("GetNotifications-WrongBounds", func(t *testing.T) {
+		handler := middleware.AuthMiddlewareMock(
+			middleware.SetUnlimitedVars(notificationHandler.GetNotifications,
+				middleware.VarsPair{Key: "start", Value: "10"},
+				middleware.VarsPair{Key: "end", Value: "0"},
+			),
+			true, testUser, "")
+
+		apitest.New("GetNotifications-WrongBounds").
+			Handler(handler).
+			Method("Get").
+			URL("/users/notifications/10/0").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("GetNotifications-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := notification.NewMockUseCase(ctrl)
+		notificationHandler.NotificationUC = m
+
+		m.EXPECT().
+			GetNotifications(gomock.Any(), testUser.Id, uint64(0), uint64(10)).
+			Return(nil, errors.New("test error"))
+
+		handler := middleware.AuthMiddlewareMock(
+			middleware.SetUnlimitedVars(notificationHandler.GetNotifications,
+				middleware.VarsPair{Key: "start", Value: "0"},
+				middleware.VarsPair{Key: "end", Value: "10"},
+			),
+			true, testUser, "")
+
+		apitest.New("GetNotifications-Error").
+			Handler(handler).
+			Method("Get").
+			URL("/users/notifications/0/10").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	t.Run("MarkRead-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := notification.NewMockUseCase(ctrl)
+		notificationHandler.NotificationUC = m
+
+		m.EXPECT().
+			MarkRead(gomock.Any(), testUser.Id, "5").
+			Return(nil)
+
+		apitest.New("MarkRead-OK").
+			Handler(middleware.AuthMiddlewareMock(
+				middleware.SetMuxVars(notificationHandler.MarkRead, "id", "5"),
+				true, testUser, "")).
+			Method("Post").
+			URL("/users/notifications/5/read").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("MarkRead-NoCSRF", func(t *testing.T) {
+		apitest.New("MarkRead-NoCSRF").
+			Handler(http.HandlerFunc(notificationHandler.MarkRead)).
+			Method("Post").
+			URL("/users/notifications/5/read").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+
+	t.Run("MarkRead-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := notification.NewMockUseCase(ctrl)
+		notificationHandler.NotificationUC = m
+
+		m.EXPECT().
+			MarkRead(gomock.Any(), testUser.Id, "5").
+			Return(errors.New("test error"))
+
+		apitest.New("MarkRead-Error").
+			Handler(middleware.AuthMiddlewareMock(
+				middleware.SetMuxVars(notificationHandler.MarkRead, "id", "5"),
+				true, testUser, "")).
+			Method("Post").
+			URL("/users/notifications/5/read").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
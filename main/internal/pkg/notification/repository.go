@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	Create(ctx context.Context, uID string, nType string, payload []byte) (models.Notification, error)
+	CreateForSubscribers(ctx context.Context, artistID string, nType string, payload []byte) ([]models.Notification, error)
+	GetBounded(ctx context.Context, uID string, start, end uint64) ([]models.Notification, error)
+	MarkRead(ctx context.Context, uID string, nID string) error
+}
+
+// Broker delivers stored notifications to every main service replica
+type Broker interface {
+	Publish(ctx context.Context, notification models.Notification) error
+	Listen(handle func(models.Notification)) error
+}
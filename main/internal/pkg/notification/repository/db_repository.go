@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/jinzhu/gorm"
+	"strconv"
+	"time"
+)
+
+const returningFields = " returning id, user_id, type, payload, read, created_at"
+
+type Notification struct {
+	Id        uint64    `gorm:"column:id"`
+	UserId    uint64    `gorm:"column:user_id"`
+	Type      string    `gorm:"column:type"`
+	Payload   []byte    `gorm:"column:payload"`
+	Read      bool      `gorm:"column:read"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+type DbNotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewDbNotificationRepository(database *gorm.DB) DbNotificationRepository {
+	return DbNotificationRepository{
+		db: database,
+	}
+}
+
+func toModel(notification Notification) models.Notification {
+	return models.Notification{
+		Id:        strconv.FormatUint(notification.Id, 10),
+		UserId:    strconv.FormatUint(notification.UserId, 10),
+		Type:      notification.Type,
+		Payload:   notification.Payload,
+		Read:      notification.Read,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (nr *DbNotificationRepository) Create(ctx context.Context, uID string, nType string, payload []byte) (models.Notification, error) {
+	var notification Notification
+
+	db := database.WithContext(ctx, nr.db).Raw("insert into notifications (user_id, type, payload) values (?, ?, ?::jsonb)"+returningFields,
+		uID, nType, string(payload)).
+		Scan(&notification)
+
+	if err := db.Error; err != nil {
+		return models.Notification{}, fmt.Errorf("failed to create notification: %w", err)
+	}
+	return toModel(notification), nil
+}
+
+func (nr *DbNotificationRepository) CreateForSubscribers(ctx context.Context, artistID string, nType string, payload []byte) ([]models.Notification, error) {
+	var notifications []Notification
+
+	db := database.WithContext(ctx, nr.db).Raw("insert into notifications (user_id, type, payload) "+
+		"select user_id, ?, ?::jsonb from liked_artists where artist_id = ?"+returningFields,
+		nType, string(payload), artistID).
+		Scan(&notifications)
+
+	if err := db.Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to create notifications: %w", err)
+	}
+
+	result := make([]models.Notification, len(notifications))
+	for i, elem := range notifications {
+		result[i] = toModel(elem)
+	}
+	return result, nil
+}
+
+func (nr *DbNotificationRepository) GetBounded(ctx context.Context, uID string, start, end uint64) ([]models.Notification, error) {
+	var notifications []Notification
+	limit := end - start
+
+	db := database.WithContext(ctx, nr.db).
+		Table("notifications").
+		Where("user_id = ?", uID).
+		Order("id desc").
+		Limit(limit).
+		Offset(start).
+		Find(&notifications)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	result := make([]models.Notification, len(notifications))
+	for i, elem := range notifications {
+		result[i] = toModel(elem)
+	}
+	return result, nil
+}
+
+func (nr *DbNotificationRepository) MarkRead(ctx context.Context, uID string, nID string) error {
+	db := database.WithContext(ctx, nr.db).Exec("update notifications set read = true where id = ? and user_id = ?", nID, uID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to mark notification: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "notification not found")
+	}
+	return nil
+}
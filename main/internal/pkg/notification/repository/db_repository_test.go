@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type Suite struct {
+	suite.Suite
+	DB         *gorm.DB
+	mock       sqlmock.Sqlmock
+	repository DbNotificationRepository
+	columns    []string
+	createdAt  time.Time
+	bdError    error
+}
+
+func (s *Suite) SetupSuite() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+
+	s.DB, err = gorm.Open("postgres", db)
+	require.NoError(s.T(), err)
+	s.DB.LogMode(false)
+
+	s.columns = []string{"id", "user_id", "type", "payload", "read", "created_at"}
+	s.createdAt = time.Date(2020, 5, 12, 10, 0, 0, 0, time.UTC)
+	s.bdError = errors.New("some bd error")
+	s.repository = NewDbNotificationRepository(s.DB)
+}
+
+func (s *Suite) AfterTest(_, _ string) {
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func TestInit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestCreate() {
+	payload := `{"playlist_id":"3"}`
+	query := `insert into notifications (user_id, type, payload) values ($1, $2, $3::jsonb)` + returningFields
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("1", models.NotificationPlaylistShare, payload).
+		WillReturnRows(sqlmock.NewRows(s.columns).
+			AddRow(5, 1, models.NotificationPlaylistShare, []byte(payload), false, s.createdAt))
+
+	res, err := s.repository.Create(context.Background(), "1", models.NotificationPlaylistShare, []byte(payload))
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(models.Notification{
+		Id:        "5",
+		UserId:    "1",
+		Type:      models.NotificationPlaylistShare,
+		Payload:   []byte(payload),
+		CreatedAt: "2020-05-12T10:00:00Z",
+	}, res))
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("1", models.NotificationPlaylistShare, payload).
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.Create(context.Background(), "1", models.NotificationPlaylistShare, []byte(payload))
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestCreateForSubscribers() {
+	payload := `{"type":"album"}`
+	query := `insert into notifications (user_id, type, payload) select user_id, $1, $2::jsonb from liked_artists where artist_id = $3` + returningFields
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(models.NotificationRelease, payload, "4").
+		WillReturnRows(sqlmock.NewRows(s.columns).
+			AddRow(6, 1, models.NotificationRelease, []byte(payload), false, s.createdAt).
+			AddRow(7, 2, models.NotificationRelease, []byte(payload), false, s.createdAt))
+
+	res, err := s.repository.CreateForSubscribers(context.Background(), "4", models.NotificationRelease, []byte(payload))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res, 2)
+	require.Equal(s.T(), "2", res[1].UserId)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(models.NotificationRelease, payload, "4").
+		WillReturnRows(sqlmock.NewRows(s.columns))
+
+	res, err = s.repository.CreateForSubscribers(context.Background(), "4", models.NotificationRelease, []byte(payload))
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), res)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(models.NotificationRelease, payload, "4").
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.CreateForSubscribers(context.Background(), "4", models.NotificationRelease, []byte(payload))
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestGetBounded() {
+	query := `SELECT * FROM "notifications" WHERE (user_id = $1) ORDER BY id desc LIMIT 2 OFFSET 0`
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows(s.columns).
+			AddRow(5, 1, models.NotificationRelease, []byte(`{}`), true, s.createdAt))
+
+	res, err := s.repository.GetBounded(context.Background(), "1", 0, 2)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.Notification{{
+		Id:        "5",
+		UserId:    "1",
+		Type:      models.NotificationRelease,
+		Payload:   []byte(`{}`),
+		Read:      true,
+		CreatedAt: "2020-05-12T10:00:00Z",
+	}}, res))
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("1").
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.GetBounded(context.Background(), "1", 0, 2)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestMarkRead() {
+	query := `update notifications set read = true where id = $1 and user_id = $2`
+
+	s.mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs("5", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.MarkRead(context.Background(), "1", "5"))
+
+	s.mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs("5", "1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.MarkRead(context.Background(), "1", "5"))
+
+	s.mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs("5", "1").
+		WillReturnError(s.bdError)
+
+	require.Error(s.T(), s.repository.MarkRead(context.Background(), "1", "5"))
+}
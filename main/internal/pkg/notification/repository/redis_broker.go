@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+)
+
+const notificationsChannel = "notifications"
+
+type RedisBroker struct {
+	redisPool *redis.Pool
+}
+
+func NewRedisBroker(conn *redis.Pool) RedisBroker {
+	return RedisBroker{
+		redisPool: conn,
+	}
+}
+
+func (rb *RedisBroker) Publish(ctx context.Context, notification models.Notification) error {
+	conn, err := tracing.RedisConn(ctx, rb.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+	if _, err := conn.Do("PUBLISH", notificationsChannel, data); err != nil {
+		return fmt.Errorf("failed to publish notification: %w", err)
+	}
+	return nil
+}
+
+// Listen blocks and passes every published notification to handle until the connection fails
+func (rb *RedisBroker) Listen(handle func(models.Notification)) error {
+	conn := redis.PubSubConn{Conn: rb.redisPool.Get()}
+	defer conn.Close()
+
+	if err := conn.Subscribe(notificationsChannel); err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	for {
+		switch message := conn.Receive().(type) {
+		case redis.Message:
+			var notification models.Notification
+			if err := json.Unmarshal(message.Data, &notification); err != nil {
+				continue
+			}
+			handle(notification)
+		case error:
+			return errors.New("failed to receive notification: " + message.Error())
+		}
+	}
+}
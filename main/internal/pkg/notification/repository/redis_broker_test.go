@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type RedisSuite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	redisConn   *redis.Pool
+	broker      RedisBroker
+}
+
+func (s *RedisSuite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	s.redisConn = &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+	s.broker = NewRedisBroker(s.redisConn)
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *RedisSuite) AfterTest(_, _ string) {
+	s.redisServer.Close()
+	s.SetupSuite()
+}
+
+func (s *RedisSuite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestRedisBroker(t *testing.T) {
+	suite.Run(t, new(RedisSuite))
+}
+
+func (s *RedisSuite) TestPublishAndListen() {
+	notification := models.Notification{
+		Id:      "5",
+		UserId:  "1",
+		Type:    models.NotificationRelease,
+		Payload: []byte(`{"id":"3"}`),
+	}
+
+	received := make(chan models.Notification, 1)
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- s.broker.Listen(func(n models.Notification) {
+			received <- n
+		})
+	}()
+
+	require.Eventually(s.T(), func() bool {
+		return s.redisServer.PubSubNumSub(notificationsChannel)[notificationsChannel] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(s.T(), s.broker.Publish(context.Background(), notification))
+
+	select {
+	case res := <-received:
+		require.Equal(s.T(), notification, res)
+	case <-time.After(time.Second):
+		s.T().Fatal("notification was not received")
+	}
+
+	s.redisServer.Close()
+	select {
+	case err := <-listenErr:
+		require.Error(s.T(), err)
+	case <-time.After(time.Second):
+		s.T().Fatal("listen did not stop")
+	}
+}
+
+func (s *RedisSuite) TestPublishError() {
+	s.redisServer.Close()
+	require.Error(s.T(), s.broker.Publish(context.Background(), models.Notification{Id: "1"}))
+}
@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type UseCase interface {
+	Notify(ctx context.Context, uID string, nType string, payload interface{}) error
+	NotifySubscribers(ctx context.Context, artistID string, nType string, payload interface{}) error
+	Push(ctx context.Context, uID string, nType string, payload interface{}) error
+	Subscribe(uID string) (<-chan models.Notification, func())
+	GetNotifications(ctx context.Context, uID string, start, end uint64) ([]models.Notification, error)
+	MarkRead(ctx context.Context, uID string, nID string) error
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"sync"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+// slow clients lose notifications instead of blocking the dispatch,
+// they still can get them from the store
+const subscriberBuffer = 16
+
+// hub keeps notification streams of users connected to this replica
+type hub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan models.Notification]struct{}
+}
+
+func newHub() *hub {
+	return &hub{
+		subscribers: make(map[string]map[chan models.Notification]struct{}),
+	}
+}
+
+func (h *hub) subscribe(uID string) (<-chan models.Notification, func()) {
+	ch := make(chan models.Notification, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[uID] == nil {
+		h.subscribers[uID] = make(map[chan models.Notification]struct{})
+	}
+	h.subscribers[uID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers[uID], ch)
+			if len(h.subscribers[uID]) == 0 {
+				delete(h.subscribers, uID)
+			}
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (h *hub) dispatch(notification models.Notification) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[notification.UserId] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+)
+
+type NotificationUseCase struct {
+	Repository notification.Repository
+	Broker     notification.Broker
+	hub        *hub
+}
+
+func NewNotificationUseCase(repository notification.Repository, broker notification.Broker) *NotificationUseCase {
+	return &NotificationUseCase{
+		Repository: repository,
+		Broker:     broker,
+		hub:        newHub(),
+	}
+}
+
+// Listen passes notifications published by any replica to the local subscribers, it blocks until the broker fails
+func (uc *NotificationUseCase) Listen() error {
+	return uc.Broker.Listen(uc.hub.dispatch)
+}
+
+func (uc *NotificationUseCase) Notify(ctx context.Context, uID string, nType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	stored, err := uc.Repository.Create(ctx, uID, nType, data)
+	if err != nil {
+		return err
+	}
+	return uc.Broker.Publish(ctx, stored)
+}
+
+func (uc *NotificationUseCase) NotifySubscribers(ctx context.Context, artistID string, nType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	stored, err := uc.Repository.CreateForSubscribers(ctx, artistID, nType, data)
+	if err != nil {
+		return err
+	}
+	for _, elem := range stored {
+		if err := uc.Broker.Publish(ctx, elem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Push delivers an event to the connected devices of the user without storing it
+func (uc *NotificationUseCase) Push(ctx context.Context, uID string, nType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return uc.Broker.Publish(ctx, models.Notification{
+		UserId:    uID,
+		Type:      nType,
+		Payload:   data,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+func (uc *NotificationUseCase) Subscribe(uID string) (<-chan models.Notification, func()) {
+	return uc.hub.subscribe(uID)
+}
+
+func (uc *NotificationUseCase) GetNotifications(ctx context.Context, uID string, start, end uint64) ([]models.Notification, error) {
+	return uc.Repository.GetBounded(ctx, uID, start, end)
+}
+
+func (uc *NotificationUseCase) MarkRead(ctx context.Context, uID string, nID string) error {
+	return uc.Repository.MarkRead(ctx, uID, nID)
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testNotification = models.Notification{
+	Id:      "5",
+	UserId:  "1",
+	Type:    models.NotificationPlaylistShare,
+	Payload: []byte(`{"playlist_id":"3","playlist_name":"test","user_id":"2"}`),
+}
+
+var testPayload = models.PlaylistSharePayload{
+	PlaylistId:   "3",
+	PlaylistName: "test",
+	UserId:       "2",
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("Notify-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repository := notification.NewMockRepository(ctrl)
+		broker := notification.NewMockBroker(ctrl)
+
+		repository.EXPECT().
+			Create(gomock.Any(), "1", models.NotificationPlaylistShare, []byte(testNotification.Payload)).
+			Return(testNotification, nil)
+		broker.EXPECT().
+			Publish(gomock.Any(), testNotification).
+			Return(nil)
+
+		useCase := NewNotificationUseCase(repository, broker)
+		assert.NoError(t, useCase.Notify(context.Background(), "1", models.NotificationPlaylistShare, testPayload))
+	})
+
+	t.Run("Notify-CreateError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repository := notification.NewMockRepository(ctrl)
+
+		repository.EXPECT().
+			Create(gomock.Any(), "1", models.NotificationPlaylistShare, gomock.Any()).
+			Return(models.Notification{}, errors.New("test error"))
+
+		useCase := NewNotificationUseCase(repository, notification.NewMockBroker(ctrl))
+		assert.Error(t, useCase.Notify(context.Background(), "1", models.NotificationPlaylistShare, testPayload))
+	})
+
+	t.Run("Notify-WrongPayload", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := NewNotificationUseCase(notification.NewMockRepository(ctrl), notification.NewMockBroker(ctrl))
+		assert.Error(t, useCase.Notify(context.Background(), "1", models.NotificationPlaylistShare, make(chan int)))
+	})
+}
+
+func TestNotifySubscribers(t *testing.T) {
+	t.Run("NotifySubscribers-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repository := notification.NewMockRepository(ctrl)
+		broker := notification.NewMockBroker(ctrl)
+
+		stored := []models.Notification{{Id: "1", UserId: "1"}, {Id: "2", UserId: "2"}}
+
+		repository.EXPECT().
+			CreateForSubscribers(gomock.Any(), "4", models.NotificationRelease, gomock.Any()).
+			Return(stored, nil)
+		broker.EXPECT().Publish(gomock.Any(), stored[0]).Return(nil)
+		broker.EXPECT().Publish(gomock.Any(), stored[1]).Return(nil)
+
+		useCase := NewNotificationUseCase(repository, broker)
+		assert.NoError(t, useCase.NotifySubscribers(context.Background(), "4", models.NotificationRelease, models.ReleasePayload{Id: "3"}))
+	})
+
+	t.Run("NotifySubscribers-PublishError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repository := notification.NewMockRepository(ctrl)
+		broker := notification.NewMockBroker(ctrl)
+
+		repository.EXPECT().
+			CreateForSubscribers(gomock.Any(), "4", models.NotificationRelease, gomock.Any()).
+			Return([]models.Notification{{Id: "1"}}, nil)
+		broker.EXPECT().
+			Publish(gomock.Any(), gomock.Any()).
+			Return(errors.New("test error"))
+
+		useCase := NewNotificationUseCase(repository, broker)
+		assert.Error(t, useCase.NotifySubscribers(context.Background(), "4", models.NotificationRelease, models.ReleasePayload{Id: "3"}))
+	})
+}
+
+func TestListen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	broker := notification.NewMockBroker(ctrl)
+	useCase := NewNotificationUseCase(notification.NewMockRepository(ctrl), broker)
+
+	own, unsubscribe := useCase.Subscribe("1")
+	defer unsubscribe()
+	other, unsubscribeOther := useCase.Subscribe("2")
+	defer unsubscribeOther()
+
+	testError := errors.New("connection closed")
+	broker.EXPECT().
+		Listen(gomock.Any()).
+		DoAndReturn(func(handle func(models.Notification)) error {
+			handle(testNotification)
+			return testError
+		})
+
+	assert.Equal(t, testError, useCase.Listen())
+	assert.Equal(t, testNotification, <-own)
+	assert.Empty(t, other)
+}
+
+func TestHub(t *testing.T) {
+	h := newHub()
+
+	first, unsubscribeFirst := h.subscribe("1")
+	second, unsubscribeSecond := h.subscribe("1")
+
+	h.dispatch(testNotification)
+	assert.Equal(t, testNotification, <-first)
+	assert.Equal(t, testNotification, <-second)
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+
+	// a full buffer must not block the dispatch
+	for i := 0; i < subscriberBuffer+1; i++ {
+		h.dispatch(testNotification)
+	}
+	assert.Len(t, second, subscriberBuffer)
+
+	unsubscribeSecond()
+	assert.Empty(t, h.subscribers)
+}
+
+func TestPush(t *testing.T) {
+	t.Run("Push-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		broker := notification.NewMockBroker(ctrl)
+		broker.EXPECT().
+			Publish(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n models.Notification) error {
+				assert.Empty(t, n.Id)
+				assert.Equal(t, "1", n.UserId)
+				assert.Equal(t, models.NotificationPlaylistShare, n.Type)
+				assert.JSONEq(t, string(testNotification.Payload), string(n.Payload))
+				return nil
+			})
+
+		useCase := NewNotificationUseCase(notification.NewMockRepository(ctrl), broker)
+		assert.NoError(t, useCase.Push(context.Background(), "1", models.NotificationPlaylistShare, testPayload))
+	})
+
+	t.Run("Push-WrongPayload", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := NewNotificationUseCase(notification.NewMockRepository(ctrl), notification.NewMockBroker(ctrl))
+		assert.Error(t, useCase.Push(context.Background(), "1", models.NotificationPlaylistShare, make(chan int)))
+	})
+}
@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package notification is a generated GoMock package.
+package notification
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCase is a mock of UseCase interface
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method
+func (m *MockUseCase) Notify(ctx context.Context, uID, nType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, uID, nType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify
+func (mr *MockUseCaseMockRecorder) Notify(ctx, uID, nType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockUseCase)(nil).Notify), ctx, uID, nType, payload)
+}
+
+// NotifySubscribers mocks base method
+func (m *MockUseCase) NotifySubscribers(ctx context.Context, artistID, nType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifySubscribers", ctx, artistID, nType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifySubscribers indicates an expected call of NotifySubscribers
+func (mr *MockUseCaseMockRecorder) NotifySubscribers(ctx, artistID, nType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifySubscribers", reflect.TypeOf((*MockUseCase)(nil).NotifySubscribers), ctx, artistID, nType, payload)
+}
+
+// Push mocks base method
+func (m *MockUseCase) Push(ctx context.Context, uID, nType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", ctx, uID, nType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push
+func (mr *MockUseCaseMockRecorder) Push(ctx, uID, nType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockUseCase)(nil).Push), ctx, uID, nType, payload)
+}
+
+// Subscribe mocks base method
+func (m *MockUseCase) Subscribe(uID string) (<-chan models.Notification, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", uID)
+	ret0, _ := ret[0].(<-chan models.Notification)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockUseCaseMockRecorder) Subscribe(uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUseCase)(nil).Subscribe), uID)
+}
+
+// GetNotifications mocks base method
+func (m *MockUseCase) GetNotifications(ctx context.Context, uID string, start, end uint64) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, uID, start, end)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications
+func (mr *MockUseCaseMockRecorder) GetNotifications(ctx, uID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockUseCase)(nil).GetNotifications), ctx, uID, start, end)
+}
+
+// MarkRead mocks base method
+func (m *MockUseCase) MarkRead(ctx context.Context, uID, nID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, uID, nID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead
+func (mr *MockUseCaseMockRecorder) MarkRead(ctx, uID, nID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUseCase)(nil).MarkRead), ctx, uID, nID)
+}
@@ -0,0 +1,150 @@
+package delivery
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+type PlayerHandler struct {
+	PlayerUC player.UseCase
+	Log      *logger.MainLogger
+}
+
+func (h *PlayerHandler) GetState(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "player delivery", "GetState", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	state, err := h.PlayerUC.GetState(r.Context(), user.Id)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get player state: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.sendState(w, r, state)
+}
+
+// UpdateState saves the state and takes over playback for the device from the body
+func (h *PlayerHandler) UpdateState(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "UpdateState")
+	if !ok {
+		return
+	}
+	var state models.PlayerState
+	if !h.decode(w, r, &state) {
+		return
+	}
+	state, err := h.PlayerUC.UpdateState(r.Context(), user.Id, state)
+	h.sendChanged(w, r, state, err)
+}
+
+func (h *PlayerHandler) SeedQueue(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "SeedQueue")
+	if !ok {
+		return
+	}
+	var seed models.QueueSeed
+	if !h.decode(w, r, &seed) {
+		return
+	}
+	state, err := h.PlayerUC.SeedQueue(r.Context(), user.Id, seed)
+	h.sendChanged(w, r, state, err)
+}
+
+func (h *PlayerHandler) PlayNext(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "PlayNext")
+	if !ok {
+		return
+	}
+	var track models.QueueTrack
+	if !h.decode(w, r, &track) {
+		return
+	}
+	state, err := h.PlayerUC.PlayNext(r.Context(), user.Id, track)
+	h.sendChanged(w, r, state, err)
+}
+
+func (h *PlayerHandler) AddToQueue(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "AddToQueue")
+	if !ok {
+		return
+	}
+	var track models.QueueTrack
+	if !h.decode(w, r, &track) {
+		return
+	}
+	state, err := h.PlayerUC.AddToQueue(r.Context(), user.Id, track)
+	h.sendChanged(w, r, state, err)
+}
+
+func (h *PlayerHandler) Shuffle(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "Shuffle")
+	if !ok {
+		return
+	}
+	state, err := h.PlayerUC.Shuffle(r.Context(), user.Id)
+	h.sendChanged(w, r, state, err)
+}
+
+func (h *PlayerHandler) Next(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.checkUser(w, r, "Next")
+	if !ok {
+		return
+	}
+	state, err := h.PlayerUC.Next(r.Context(), user.Id)
+	h.sendChanged(w, r, state, err)
+}
+
+// checkUser checks csrf token of a changing request and gets the user from context
+func (h *PlayerHandler) checkUser(w http.ResponseWriter, r *http.Request, funcName string) (models.User, bool) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return models.User{}, false
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "player delivery", funcName, "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return models.User{}, false
+	}
+	return user, true
+}
+
+func (h *PlayerHandler) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := validation.DecodeJSON(r, v); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return false
+	}
+	return true
+}
+
+func (h *PlayerHandler) sendChanged(w http.ResponseWriter, r *http.Request, state models.PlayerState, err error) {
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to update player state: "+err.Error(), http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.sendState(w, r, state)
+}
+
+func (h *PlayerHandler) sendState(w http.ResponseWriter, r *http.Request, state models.PlayerState) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		h.Log.LogWarning(r.Context(), "player delivery", "sendState", "failed to encode json: "+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
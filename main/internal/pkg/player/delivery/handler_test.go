@@ -0,0 +1,254 @@
+package delivery
+
+import (
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/steinfletcher/apitest"
+	"net/http"
+	"os"
+	"testing"
+)
+
+var playerHandler PlayerHandler
+
+var testUser = models.User{
+	Id:    "1",
+	Login: "test",
+}
+
+var testState = models.PlayerState{
+	DeviceId:  "phone",
+	Queue:     []string{"1", "2"},
+	Index:     1,
+	TrackId:   "2",
+	Position:  42,
+	Repeat:    models.RepeatAll,
+	UpdatedAt: "2020-05-12T10:00:00Z",
+}
+
+const testStateJSON = `{"device_id":"phone","queue":["1","2"],"index":1,"track_id":"2","position":42,"shuffle":false,"repeat":"all","radio":false,"paused":false,"updated_at":"2020-05-12T10:00:00Z"}`
+
+func init() {
+	playerHandler.Log = logger.NewLogger(os.Stdout)
+}
+
+func TestGetState(t *testing.T) {
+	t.Run("GetState-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().GetState(gomock.Any(), testUser.Id).Return(testState, nil)
+
+		apitest.New("GetState-OK").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.GetState, true, testUser, "")).
+			Method("Get").
+			URL("/users/player").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(testStateJSON).
+			End()
+	})
+
+	t.Run("GetState-NoUser", func(t *testing.T) {
+		apitest.New("GetState-NoUser").
+			Handler(http.HandlerFunc(playerHandler.GetState)).
+			Method("Get").
+			URL("/users/player").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetState-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().GetState(gomock.Any(), testUser.Id).Return(models.PlayerState{}, errors.New("test error"))
+
+		apitest.New("GetState-Error").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.GetState, true, testUser, "")).
+			Method("Get").
+			URL("/users/player").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestUpdateState(t *testing.T) {
+	t.Run("UpdateState-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		input := testState
+		input.UpdatedAt = ""
+		m.EXPECT().UpdateState(gomock.Any(), testUser.Id, input).Return(testState, nil)
+
+		apitest.New("UpdateState-OK").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.UpdateState, true, testUser, "")).
+			Method("Put").
+			URL("/users/player").
+			Body(`{"device_id":"phone","queue":["1","2"],"index":1,"track_id":"2","position":42,"repeat":"all"}`).
+			Expect(t).
+			Status(http.StatusOK).
+			Body(testStateJSON).
+			End()
+	})
+
+	t.Run("UpdateState-NoCSRF", func(t *testing.T) {
+		apitest.New("UpdateState-NoCSRF").
+			Handler(http.HandlerFunc(playerHandler.UpdateState)).
+			Method("Put").
+			URL("/users/player").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+
+	t.Run("UpdateState-WrongBody", func(t *testing.T) {
+		apitest.New("UpdateState-WrongBody").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.UpdateState, true, testUser, "")).
+			Method("Put").
+			URL("/users/player").
+			Body(`{"device_id":`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("UpdateState-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().UpdateState(gomock.Any(), testUser.Id, gomock.Any()).Return(models.PlayerState{}, errors.New("test error"))
+
+		apitest.New("UpdateState-Error").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.UpdateState, true, testUser, "")).
+			Method("Put").
+			URL("/users/player").
+			Body(`{"device_id":"phone"}`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestQueue(t *testing.T) {
+	t.Run("SeedQueue-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().
+			SeedQueue(gomock.Any(), testUser.Id, models.QueueSeed{DeviceId: "phone", Source: models.QueueSourceAlbum, Id: "3", Shuffle: true}).
+			Return(testState, nil)
+
+		apitest.New("SeedQueue-OK").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.SeedQueue, true, testUser, "")).
+			Method("Post").
+			URL("/users/player/queue").
+			Body(`{"device_id":"phone","source":"album","id":"3","shuffle":true}`).
+			Expect(t).
+			Status(http.StatusOK).
+			Body(testStateJSON).
+			End()
+	})
+
+	t.Run("PlayNext-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().
+			PlayNext(gomock.Any(), testUser.Id, models.QueueTrack{DeviceId: "phone", TrackId: "9"}).
+			Return(testState, nil)
+
+		apitest.New("PlayNext-OK").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.PlayNext, true, testUser, "")).
+			Method("Post").
+			URL("/users/player/queue/next").
+			Body(`{"device_id":"phone","track_id":"9"}`).
+			Expect(t).
+			Status(http.StatusOK).
+			Body(testStateJSON).
+			End()
+	})
+
+	t.Run("AddToQueue-WrongBody", func(t *testing.T) {
+		apitest.New("AddToQueue-WrongBody").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.AddToQueue, true, testUser, "")).
+			Method("Post").
+			URL("/users/player/queue/tracks").
+			Body(`[]`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("Shuffle-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().Shuffle(gomock.Any(), testUser.Id).Return(models.PlayerState{}, errors.New("test error"))
+
+		apitest.New("Shuffle-Error").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.Shuffle, true, testUser, "")).
+			Method("Post").
+			URL("/users/player/queue/shuffle").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("Next-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockUseCase(ctrl)
+		playerHandler.PlayerUC = m
+
+		m.EXPECT().Next(gomock.Any(), testUser.Id).Return(testState, nil)
+
+		apitest.New("Next-OK").
+			Handler(middleware.AuthMiddlewareMock(playerHandler.Next, true, testUser, "")).
+			Method("Post").
+			URL("/users/player/next").
+			Expect(t).
+			Status(http.StatusOK).
+			Body(testStateJSON).
+			End()
+	})
+
+	t.Run("Next-NoCSRF", func(t *testing.T) {
+		apitest.New("Next-NoCSRF").
+			Handler(http.HandlerFunc(playerHandler.Next)).
+			Method("Post").
+			URL("/users/player/next").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
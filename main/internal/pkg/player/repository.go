@@ -0,0 +1,11 @@
+package player
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type Repository interface {
+	GetState(ctx context.Context, uID string) (models.PlayerState, error)
+	SetState(ctx context.Context, uID string, state models.PlayerState) error
+}
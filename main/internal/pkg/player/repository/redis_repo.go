@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+)
+
+type RedisPlayerRepository struct {
+	redisPool *redis.Pool
+	ttl       int64
+}
+
+func NewRedisPlayerRepository(conn *redis.Pool, ttl int64) RedisPlayerRepository {
+	return RedisPlayerRepository{
+		redisPool: conn,
+		ttl:       ttl,
+	}
+}
+
+func stateKey(uID string) string {
+	return "player:" + uID
+}
+
+// GetState returns zero state if the user has never played anything or the state expired
+func (pr *RedisPlayerRepository) GetState(ctx context.Context, uID string) (models.PlayerState, error) {
+	conn, err := tracing.RedisConn(ctx, pr.redisPool)
+	if err != nil {
+		return models.PlayerState{}, fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	data, err := redis.Bytes(conn.Do("GET", stateKey(uID)))
+	if err == redis.ErrNil {
+		return models.PlayerState{}, nil
+	}
+	if err != nil {
+		return models.PlayerState{}, fmt.Errorf("failed to get player state: %w", err)
+	}
+
+	var state models.PlayerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return models.PlayerState{}, fmt.Errorf("failed to unmarshal player state: %w", err)
+	}
+	return state, nil
+}
+
+func (pr *RedisPlayerRepository) SetState(ctx context.Context, uID string, state models.PlayerState) error {
+	conn, err := tracing.RedisConn(ctx, pr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal player state: %w", err)
+	}
+	result, err := redis.String(conn.Do("SET", stateKey(uID), data, "EX", pr.ttl))
+	if err != nil {
+		return fmt.Errorf("failed to write player state: %w", err)
+	}
+	if result != "OK" {
+		return errors.New("result not OK")
+	}
+	return nil
+}
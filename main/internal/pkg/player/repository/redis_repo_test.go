@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type RedisSuite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	redisConn   *redis.Pool
+	repository  RedisPlayerRepository
+	state       models.PlayerState
+}
+
+func (s *RedisSuite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	s.redisConn = &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+	s.repository = NewRedisPlayerRepository(s.redisConn, 60)
+
+	s.state = models.PlayerState{
+		DeviceId: "phone",
+		Queue:    []string{"1", "2"},
+		Index:    1,
+		TrackId:  "2",
+		Position: 42,
+		Repeat:   models.RepeatAll,
+	}
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *RedisSuite) AfterTest(_, _ string) {
+	s.redisServer.Close()
+	s.SetupSuite()
+}
+
+func (s *RedisSuite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestRedisPlayer(t *testing.T) {
+	suite.Run(t, new(RedisSuite))
+}
+
+func (s *RedisSuite) TestSetAndGetState() {
+	require.NoError(s.T(), s.repository.SetState(context.Background(), "1", s.state))
+	require.Equal(s.T(), 60*time.Second, s.redisServer.TTL(stateKey("1")))
+
+	res, err := s.repository.GetState(context.Background(), "1")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), s.state, res)
+}
+
+func (s *RedisSuite) TestGetStateEmpty() {
+	res, err := s.repository.GetState(context.Background(), "1")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.PlayerState{}, res)
+}
+
+func (s *RedisSuite) TestGetStateBroken() {
+	require.NoError(s.T(), s.redisServer.Set(stateKey("1"), "not json"))
+
+	_, err := s.repository.GetState(context.Background(), "1")
+	require.Error(s.T(), err)
+}
+
+func (s *RedisSuite) TestClosedConnection() {
+	s.redisServer.Close()
+
+	require.Error(s.T(), s.repository.SetState(context.Background(), "1", s.state))
+	_, err := s.repository.GetState(context.Background(), "1")
+	require.Error(s.T(), err)
+}
@@ -0,0 +1,16 @@
+package player
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+type UseCase interface {
+	GetState(ctx context.Context, uID string) (models.PlayerState, error)
+	UpdateState(ctx context.Context, uID string, state models.PlayerState) (models.PlayerState, error)
+	SeedQueue(ctx context.Context, uID string, seed models.QueueSeed) (models.PlayerState, error)
+	PlayNext(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error)
+	AddToQueue(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error)
+	Shuffle(ctx context.Context, uID string) (models.PlayerState, error)
+	Next(ctx context.Context, uID string) (models.PlayerState, error)
+}
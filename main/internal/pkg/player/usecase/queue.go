@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
+
+const (
+	// radio adds tracks when less than radioThreshold tracks are left after the current one
+	radioThreshold = 5
+	radioBatch     = 20
+)
+
+// SeedQueue replaces the queue with tracks of the source and starts playing on the device from the seed
+func (uc *PlayerUseCase) SeedQueue(ctx context.Context, uID string, seed models.QueueSeed) (models.PlayerState, error) {
+	tracks, err := uc.sourceTracks(ctx, uID, seed.Source, seed.Id)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	if len(tracks) == 0 {
+		return models.PlayerState{}, apperrors.New(apperrors.NotFound, "source has no tracks")
+	}
+
+	state, err := uc.GetState(ctx, uID)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	state.DeviceId = seed.DeviceId
+	state.Queue = unique(tracks)
+	state.Shuffle = seed.Shuffle
+	state.Radio = seed.Radio
+	state.Position = 0
+	state.Paused = false
+	if state.Shuffle {
+		shuffle(state.Queue)
+	}
+	if state.Radio {
+		state.Queue, err = uc.extendRadio(ctx, state.Queue, state.Queue[len(state.Queue)-1])
+		if err != nil {
+			return models.PlayerState{}, err
+		}
+		if len(state.Queue) > maxQueueLen {
+			state.Queue = state.Queue[:maxQueueLen]
+		}
+	}
+	state.Index = 0
+	state.TrackId = state.Queue[0]
+	return uc.save(ctx, uID, state)
+}
+
+// PlayNext puts the track right after the current one
+func (uc *PlayerUseCase) PlayNext(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error) {
+	return uc.addTrack(ctx, uID, track, func(state *models.PlayerState) {
+		next := state.Index + 1
+		state.Queue = append(state.Queue, "")
+		copy(state.Queue[next+1:], state.Queue[next:])
+		state.Queue[next] = track.TrackId
+	})
+}
+
+func (uc *PlayerUseCase) AddToQueue(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error) {
+	return uc.addTrack(ctx, uID, track, func(state *models.PlayerState) {
+		state.Queue = append(state.Queue, track.TrackId)
+	})
+}
+
+func (uc *PlayerUseCase) addTrack(ctx context.Context, uID string, track models.QueueTrack, insert func(state *models.PlayerState)) (models.PlayerState, error) {
+	if _, err := uc.TrackRepository.GetTrackById(ctx, track.TrackId); err != nil {
+		return models.PlayerState{}, fmt.Errorf("failed to get track: %w", err)
+	}
+	state, err := uc.GetState(ctx, uID)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	if state.DeviceId == "" {
+		state.DeviceId = track.DeviceId
+	}
+	if len(state.Queue) == 0 {
+		state.Queue = []string{track.TrackId}
+		state.Index = 0
+		state.TrackId = track.TrackId
+		return uc.save(ctx, uID, state)
+	}
+	insert(&state)
+	return uc.save(ctx, uID, state)
+}
+
+// Shuffle shuffles tracks after the current one, played tracks stay in place so nothing repeats
+func (uc *PlayerUseCase) Shuffle(ctx context.Context, uID string) (models.PlayerState, error) {
+	state, err := uc.GetState(ctx, uID)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	if len(state.Queue) == 0 {
+		return models.PlayerState{}, apperrors.New(apperrors.Conflict, "queue is empty")
+	}
+	shuffle(state.Queue[state.Index+1:])
+	state.Shuffle = true
+	return uc.save(ctx, uID, state)
+}
+
+// Next moves to the next track according to the repeat mode, radio extends the queue before it ends
+func (uc *PlayerUseCase) Next(ctx context.Context, uID string) (models.PlayerState, error) {
+	state, err := uc.GetState(ctx, uID)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	if len(state.Queue) == 0 {
+		return models.PlayerState{}, apperrors.New(apperrors.Conflict, "queue is empty")
+	}
+	state.Position = 0
+
+	if state.Repeat == models.RepeatOne {
+		return uc.save(ctx, uID, state)
+	}
+
+	if state.Radio && uint(len(state.Queue))-state.Index-1 < radioThreshold {
+		queue, err := uc.extendRadio(ctx, state.Queue, state.Queue[state.Index])
+		if err != nil {
+			return models.PlayerState{}, err
+		}
+		// the oldest played tracks make room for the new ones
+		if over := len(queue) - maxQueueLen; over > 0 {
+			queue = queue[over:]
+			state.Index -= uint(over)
+		}
+		state.Queue = queue
+	}
+
+	switch {
+	case state.Index+1 < uint(len(state.Queue)):
+		state.Index++
+	case state.Repeat == models.RepeatAll:
+		state.Index = 0
+	default:
+		state.Paused = true
+	}
+	state.TrackId = state.Queue[state.Index]
+	return uc.save(ctx, uID, state)
+}
+
+func (uc *PlayerUseCase) sourceTracks(ctx context.Context, uID string, source string, id string) ([]string, error) {
+	var (
+		tracks []models.Track
+		err    error
+	)
+	switch source {
+	case models.QueueSourceAlbum:
+		tracks, err = uc.TrackRepository.GetBoundedTracksByAlbumId(ctx, id, 0, maxQueueLen)
+	case models.QueueSourceArtist:
+		tracks, err = uc.TrackRepository.GetBoundedTracksByArtistId(ctx, id, 0, maxQueueLen)
+	case models.QueueSourcePlaylist:
+		pl, plErr := uc.PlaylistRepository.GetPlaylistById(ctx, id)
+		if plErr != nil {
+			return nil, fmt.Errorf("failed to get playlist: %w", plErr)
+		}
+		if pl.Private && pl.UserId != uID {
+			return nil, apperrors.New(apperrors.Forbidden, "playlist is private")
+		}
+		tracks, err = uc.TrackRepository.GetBoundedTracksByPlaylistId(ctx, id, 0, maxQueueLen)
+	case models.QueueSourceTrack:
+		var track models.Track
+		track, err = uc.TrackRepository.GetTrackById(ctx, id)
+		tracks = []models.Track{track}
+	default:
+		return nil, apperrors.Errorf(apperrors.Validation, "unknown queue source %q", source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source tracks: %w", err)
+	}
+
+	ids := make([]string, len(tracks))
+	for i, elem := range tracks {
+		ids[i] = elem.Id
+	}
+	return ids, nil
+}
+
+// extendRadio appends tracks similar to the seed which are not in the queue yet
+func (uc *PlayerUseCase) extendRadio(ctx context.Context, queue []string, seed string) ([]string, error) {
+	similar, err := uc.TrackRepository.GetSimilarTracks(ctx, seed, queue, radioBatch)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range similar {
+		queue = append(queue, elem.Id)
+	}
+	return queue, nil
+}
+
+func unique(tracks []string) []string {
+	seen := make(map[string]struct{}, len(tracks))
+	result := tracks[:0]
+	for _, elem := range tracks {
+		if _, ok := seen[elem]; ok {
+			continue
+		}
+		seen[elem] = struct{}{}
+		result = append(result, elem)
+	}
+	return result
+}
+
+func shuffle(tracks []string) {
+	rand.Shuffle(len(tracks), func(i, j int) {
+		tracks[i], tracks[j] = tracks[j], tracks[i]
+	})
+}
@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+type queueMocks struct {
+	repository   *player.MockRepository
+	tracks       *track.MockRepository
+	playlists    *playlist.MockRepository
+	notification *notification.MockUseCase
+	useCase      PlayerUseCase
+}
+
+func newQueueMocks(ctrl *gomock.Controller) queueMocks {
+	m := queueMocks{
+		repository:   player.NewMockRepository(ctrl),
+		tracks:       track.NewMockRepository(ctrl),
+		playlists:    playlist.NewMockRepository(ctrl),
+		notification: notification.NewMockUseCase(ctrl),
+	}
+	m.useCase = PlayerUseCase{
+		Repository:         m.repository,
+		TrackRepository:    m.tracks,
+		PlaylistRepository: m.playlists,
+		NotificationUC:     m.notification,
+	}
+	return m
+}
+
+// expectSave returns the pointer to the state which will be saved
+func (m queueMocks) expectSave() *models.PlayerState {
+	saved := &models.PlayerState{}
+	m.repository.EXPECT().
+		SetState(gomock.Any(), "1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, state models.PlayerState) error {
+			*saved = state
+			return nil
+		})
+	m.notification.EXPECT().
+		Push(gomock.Any(), "1", models.NotificationPlayerState, gomock.Any()).
+		Return(nil)
+	return saved
+}
+
+func testTracks(ids ...int) []models.Track {
+	tracks := make([]models.Track, len(ids))
+	for i, elem := range ids {
+		tracks[i] = models.Track{Id: strconv.Itoa(elem)}
+	}
+	return tracks
+}
+
+func TestSeedQueue(t *testing.T) {
+	t.Run("SeedQueue-Album", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().
+			GetBoundedTracksByAlbumId(gomock.Any(), "3", uint64(0), uint64(maxQueueLen)).
+			Return(testTracks(1, 2, 2, 3), nil)
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+		saved := m.expectSave()
+
+		res, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourceAlbum, Id: "3"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, res.Queue)
+		assert.Equal(t, "1", res.TrackId)
+		assert.Equal(t, "phone", res.DeviceId)
+		assert.Equal(t, *saved, res)
+	})
+
+	t.Run("SeedQueue-ShuffleWithoutRepeats", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().
+			GetBoundedTracksByArtistId(gomock.Any(), "4", uint64(0), uint64(maxQueueLen)).
+			Return(testTracks(1, 2, 3, 4, 5, 5, 6), nil)
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourceArtist, Id: "4", Shuffle: true})
+		assert.NoError(t, err)
+		assert.True(t, res.Shuffle)
+		assert.Equal(t, res.Queue[0], res.TrackId)
+
+		sorted := append([]string{}, res.Queue...)
+		sort.Strings(sorted)
+		assert.Equal(t, []string{"1", "2", "3", "4", "5", "6"}, sorted)
+	})
+
+	t.Run("SeedQueue-Radio", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().GetTrackById(gomock.Any(), "7").Return(models.Track{Id: "7"}, nil)
+		m.tracks.EXPECT().
+			GetSimilarTracks(gomock.Any(), "7", []string{"7"}, uint64(radioBatch)).
+			Return(testTracks(8, 9), nil)
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourceTrack, Id: "7", Radio: true})
+		assert.NoError(t, err)
+		assert.True(t, res.Radio)
+		assert.Equal(t, []string{"7", "8", "9"}, res.Queue)
+	})
+
+	t.Run("SeedQueue-PrivatePlaylist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.playlists.EXPECT().
+			GetPlaylistById(gomock.Any(), "5").
+			Return(models.Playlist{Id: "5", UserId: "2", Private: true}, nil)
+
+		_, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourcePlaylist, Id: "5"})
+		assert.Error(t, err)
+	})
+
+	t.Run("SeedQueue-Playlist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.playlists.EXPECT().
+			GetPlaylistById(gomock.Any(), "5").
+			Return(models.Playlist{Id: "5", UserId: "1", Private: true}, nil)
+		m.tracks.EXPECT().
+			GetBoundedTracksByPlaylistId(gomock.Any(), "5", uint64(0), uint64(maxQueueLen)).
+			Return(testTracks(1), nil)
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourcePlaylist, Id: "5"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1"}, res.Queue)
+	})
+
+	t.Run("SeedQueue-Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().
+			GetBoundedTracksByAlbumId(gomock.Any(), "3", uint64(0), uint64(maxQueueLen)).
+			Return([]models.Track{}, nil)
+
+		_, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: models.QueueSourceAlbum, Id: "3"})
+		assert.Error(t, err)
+	})
+
+	t.Run("SeedQueue-WrongSource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		_, err := m.useCase.SeedQueue(context.Background(), "1", models.QueueSeed{DeviceId: "phone", Source: "genre", Id: "3"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPlayNext(t *testing.T) {
+	t.Run("PlayNext-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().GetTrackById(gomock.Any(), "9").Return(models.Track{Id: "9"}, nil)
+		m.repository.EXPECT().
+			GetState(gomock.Any(), "1").
+			Return(models.PlayerState{DeviceId: "phone", Queue: []string{"1", "2", "3"}, Index: 1, TrackId: "2"}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.PlayNext(context.Background(), "1", models.QueueTrack{DeviceId: "desktop", TrackId: "9"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "9", "3"}, res.Queue)
+		assert.Equal(t, "phone", res.DeviceId)
+	})
+
+	t.Run("PlayNext-EmptyQueue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().GetTrackById(gomock.Any(), "9").Return(models.Track{Id: "9"}, nil)
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.PlayNext(context.Background(), "1", models.QueueTrack{DeviceId: "desktop", TrackId: "9"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"9"}, res.Queue)
+		assert.Equal(t, "9", res.TrackId)
+		assert.Equal(t, "desktop", res.DeviceId)
+	})
+
+	t.Run("PlayNext-NoTrack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.tracks.EXPECT().GetTrackById(gomock.Any(), "9").Return(models.Track{}, errors.New("test error"))
+
+		_, err := m.useCase.PlayNext(context.Background(), "1", models.QueueTrack{DeviceId: "desktop", TrackId: "9"})
+		assert.Error(t, err)
+	})
+}
+
+func TestAddToQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newQueueMocks(ctrl)
+
+	m.tracks.EXPECT().GetTrackById(gomock.Any(), "9").Return(models.Track{Id: "9"}, nil)
+	m.repository.EXPECT().
+		GetState(gomock.Any(), "1").
+		Return(models.PlayerState{DeviceId: "phone", Queue: []string{"1", "2", "3"}, Index: 1, TrackId: "2"}, nil)
+	m.expectSave()
+
+	res, err := m.useCase.AddToQueue(context.Background(), "1", models.QueueTrack{DeviceId: "phone", TrackId: "9"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "9"}, res.Queue)
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("Shuffle-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.repository.EXPECT().
+			GetState(gomock.Any(), "1").
+			Return(models.PlayerState{DeviceId: "phone", Queue: []string{"1", "2", "3", "4", "5"}, Index: 1, TrackId: "2"}, nil)
+		m.expectSave()
+
+		res, err := m.useCase.Shuffle(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.True(t, res.Shuffle)
+		assert.Equal(t, []string{"1", "2"}, res.Queue[:2])
+
+		upcoming := append([]string{}, res.Queue[2:]...)
+		sort.Strings(upcoming)
+		assert.Equal(t, []string{"3", "4", "5"}, upcoming)
+	})
+
+	t.Run("Shuffle-EmptyQueue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+
+		_, err := m.useCase.Shuffle(context.Background(), "1")
+		assert.Error(t, err)
+	})
+}
+
+func TestNext(t *testing.T) {
+	queue := []string{"1", "2", "3"}
+
+	for _, tc := range []struct {
+		name   string
+		state  models.PlayerState
+		index  uint
+		paused bool
+	}{
+		{"Next-OK", models.PlayerState{Index: 0, Repeat: models.RepeatOff}, 1, false},
+		{"Next-RepeatOne", models.PlayerState{Index: 1, Repeat: models.RepeatOne}, 1, false},
+		{"Next-RepeatAll", models.PlayerState{Index: 2, Repeat: models.RepeatAll}, 0, false},
+		{"Next-End", models.PlayerState{Index: 2, Repeat: models.RepeatOff}, 2, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newQueueMocks(ctrl)
+
+			state := tc.state
+			state.DeviceId = "phone"
+			state.Queue = queue
+			state.TrackId = queue[state.Index]
+			state.Position = 100
+
+			m.repository.EXPECT().GetState(gomock.Any(), "1").Return(state, nil)
+			m.expectSave()
+
+			res, err := m.useCase.Next(context.Background(), "1")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.index, res.Index)
+			assert.Equal(t, queue[tc.index], res.TrackId)
+			assert.Equal(t, tc.paused, res.Paused)
+			assert.Equal(t, uint(0), res.Position)
+		})
+	}
+
+	t.Run("Next-Radio", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.repository.EXPECT().
+			GetState(gomock.Any(), "1").
+			Return(models.PlayerState{DeviceId: "phone", Queue: []string{"1", "2"}, Index: 1, TrackId: "2", Radio: true}, nil)
+		m.tracks.EXPECT().
+			GetSimilarTracks(gomock.Any(), "2", []string{"1", "2"}, uint64(radioBatch)).
+			Return(testTracks(5, 6), nil)
+		m.expectSave()
+
+		res, err := m.useCase.Next(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "5", "6"}, res.Queue)
+		assert.Equal(t, "5", res.TrackId)
+	})
+
+	t.Run("Next-EmptyQueue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := newQueueMocks(ctrl)
+
+		m.repository.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+
+		_, err := m.useCase.Next(context.Background(), "1")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	deviceIdLen = 64
+	maxQueueLen = 1000
+)
+
+type PlayerUseCase struct {
+	Repository         player.Repository
+	TrackRepository    track.Repository
+	PlaylistRepository playlist.Repository
+	NotificationUC     notification.UseCase
+	Log                *logger.MainLogger
+}
+
+func (uc *PlayerUseCase) GetState(ctx context.Context, uID string) (models.PlayerState, error) {
+	state, err := uc.Repository.GetState(ctx, uID)
+	if err != nil {
+		return models.PlayerState{}, err
+	}
+	if state.Queue == nil {
+		state.Queue = []string{}
+	}
+	if state.Repeat == "" {
+		state.Repeat = models.RepeatOff
+	}
+	return state, nil
+}
+
+// UpdateState makes the device from the state the active one,
+// other devices of the user get the new state through the notification stream and stop playing
+func (uc *PlayerUseCase) UpdateState(ctx context.Context, uID string, state models.PlayerState) (models.PlayerState, error) {
+	return uc.save(ctx, uID, state)
+}
+
+func (uc *PlayerUseCase) save(ctx context.Context, uID string, state models.PlayerState) (models.PlayerState, error) {
+	if state.Repeat == "" {
+		state.Repeat = models.RepeatOff
+	}
+	if state.Queue == nil {
+		state.Queue = []string{}
+	}
+	if err := validateState(state); err != nil {
+		return models.PlayerState{}, err
+	}
+	state.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	if err := uc.Repository.SetState(ctx, uID, state); err != nil {
+		return models.PlayerState{}, err
+	}
+	// the state is already saved, other devices pick it up on their next GetState
+	if err := uc.NotificationUC.Push(ctx, uID, models.NotificationPlayerState, state); err != nil {
+		uc.Log.LogWarning(ctx, "player usecase", "save", "failed to push player state: "+err.Error())
+	}
+	return state, nil
+}
+
+func validateState(state models.PlayerState) error {
+	if state.DeviceId == "" {
+		return apperrors.New(apperrors.Validation, "device id is empty")
+	}
+	if utf8.RuneCountInString(state.DeviceId) > deviceIdLen {
+		return apperrors.Errorf(apperrors.Validation, "device id is longer than %d characters", deviceIdLen)
+	}
+	switch state.Repeat {
+	case models.RepeatOff, models.RepeatAll, models.RepeatOne:
+	default:
+		return apperrors.Errorf(apperrors.Validation, "unknown repeat mode %q", state.Repeat)
+	}
+	if len(state.Queue) > maxQueueLen {
+		return apperrors.Errorf(apperrors.Validation, "queue is longer than %d tracks", maxQueueLen)
+	}
+
+	for _, elem := range state.Queue {
+		if _, err := strconv.ParseUint(elem, 10, 64); err != nil {
+			return apperrors.Errorf(apperrors.Validation, "wrong track id %q in queue", elem)
+		}
+	}
+	if len(state.Queue) > 0 && state.Index >= uint(len(state.Queue)) {
+		return apperrors.New(apperrors.Validation, "index is out of queue")
+	}
+	if state.TrackId != "" {
+		if _, err := strconv.ParseUint(state.TrackId, 10, 64); err != nil {
+			return apperrors.Errorf(apperrors.Validation, "wrong track id %q", state.TrackId)
+		}
+		if len(state.Queue) > 0 && state.Queue[state.Index] != state.TrackId {
+			return apperrors.New(apperrors.Validation, "current track is not in queue")
+		}
+	}
+	return nil
+}
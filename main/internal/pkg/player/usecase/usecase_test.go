@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/player"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+var testState = models.PlayerState{
+	DeviceId: "phone",
+	Queue:    []string{"1", "2"},
+	Index:    1,
+	TrackId:  "2",
+	Position: 42,
+	Shuffle:  true,
+	Repeat:   models.RepeatOne,
+}
+
+func TestGetState(t *testing.T) {
+	t.Run("GetState-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		m.EXPECT().GetState(gomock.Any(), "1").Return(testState, nil)
+
+		useCase := PlayerUseCase{Repository: m}
+
+		res, err := useCase.GetState(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.Equal(t, testState, res)
+	})
+
+	t.Run("GetState-Empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		m.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, nil)
+
+		useCase := PlayerUseCase{Repository: m}
+
+		res, err := useCase.GetState(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.Equal(t, models.PlayerState{Queue: []string{}, Repeat: models.RepeatOff}, res)
+	})
+
+	t.Run("GetState-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		m.EXPECT().GetState(gomock.Any(), "1").Return(models.PlayerState{}, errors.New("test error"))
+
+		useCase := PlayerUseCase{Repository: m}
+
+		_, err := useCase.GetState(context.Background(), "1")
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateState(t *testing.T) {
+	t.Run("UpdateState-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		n := notification.NewMockUseCase(ctrl)
+
+		var saved models.PlayerState
+		m.EXPECT().
+			SetState(gomock.Any(), "1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, state models.PlayerState) error {
+				saved = state
+				return nil
+			})
+		n.EXPECT().
+			Push(gomock.Any(), "1", models.NotificationPlayerState, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, payload interface{}) error {
+				assert.Equal(t, saved, payload)
+				return nil
+			})
+
+		useCase := PlayerUseCase{Repository: m, NotificationUC: n}
+
+		res, err := useCase.UpdateState(context.Background(), "1", testState)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.UpdatedAt)
+		assert.Equal(t, saved, res)
+	})
+
+	t.Run("UpdateState-Defaults", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		n := notification.NewMockUseCase(ctrl)
+
+		m.EXPECT().SetState(gomock.Any(), "1", gomock.Any()).Return(nil)
+		n.EXPECT().Push(gomock.Any(), "1", models.NotificationPlayerState, gomock.Any()).Return(nil)
+
+		useCase := PlayerUseCase{Repository: m, NotificationUC: n}
+
+		res, err := useCase.UpdateState(context.Background(), "1", models.PlayerState{DeviceId: "desktop"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, res.Queue)
+		assert.Equal(t, models.RepeatOff, res.Repeat)
+	})
+
+	t.Run("UpdateState-Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := PlayerUseCase{
+			Repository:     player.NewMockRepository(ctrl),
+			NotificationUC: notification.NewMockUseCase(ctrl),
+		}
+
+		longQueue := make([]string, maxQueueLen+1)
+		for i := range longQueue {
+			longQueue[i] = "1"
+		}
+
+		for _, input := range []models.PlayerState{
+			{DeviceId: ""},
+			{DeviceId: strings.Repeat("d", deviceIdLen+1)},
+			{DeviceId: "phone", Repeat: "forever"},
+			{DeviceId: "phone", Queue: longQueue},
+			{DeviceId: "phone", Queue: []string{"track"}},
+			{DeviceId: "phone", Queue: []string{"1"}, TrackId: "2"},
+			{DeviceId: "phone", Queue: []string{"1"}, Index: 1},
+			{DeviceId: "phone", TrackId: "track"},
+		} {
+			_, err := useCase.UpdateState(context.Background(), "1", input)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("UpdateState-SetError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		m.EXPECT().SetState(gomock.Any(), "1", gomock.Any()).Return(errors.New("test error"))
+
+		useCase := PlayerUseCase{Repository: m, NotificationUC: notification.NewMockUseCase(ctrl)}
+
+		_, err := useCase.UpdateState(context.Background(), "1", testState)
+		assert.Error(t, err)
+	})
+
+	t.Run("UpdateState-PushError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := player.NewMockRepository(ctrl)
+		n := notification.NewMockUseCase(ctrl)
+
+		m.EXPECT().SetState(gomock.Any(), "1", gomock.Any()).Return(nil)
+		n.EXPECT().
+			Push(gomock.Any(), "1", models.NotificationPlayerState, gomock.Any()).
+			Return(errors.New("test error"))
+
+		useCase := PlayerUseCase{Repository: m, NotificationUC: n, Log: logger.NewLogger(os.Stdout)}
+
+		res, err := useCase.UpdateState(context.Background(), "1", testState)
+		assert.NoError(t, err)
+		assert.Equal(t, testState.TrackId, res.TrackId)
+	})
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package player is a generated GoMock package.
+package player
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCase is a mock of UseCase interface
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetState mocks base method
+func (m *MockUseCase) GetState(ctx context.Context, uID string) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", ctx, uID)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState
+func (mr *MockUseCaseMockRecorder) GetState(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockUseCase)(nil).GetState), ctx, uID)
+}
+
+// UpdateState mocks base method
+func (m *MockUseCase) UpdateState(ctx context.Context, uID string, state models.PlayerState) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateState", ctx, uID, state)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateState indicates an expected call of UpdateState
+func (mr *MockUseCaseMockRecorder) UpdateState(ctx, uID, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockUseCase)(nil).UpdateState), ctx, uID, state)
+}
+
+// SeedQueue mocks base method
+func (m *MockUseCase) SeedQueue(ctx context.Context, uID string, seed models.QueueSeed) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedQueue", ctx, uID, seed)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedQueue indicates an expected call of SeedQueue
+func (mr *MockUseCaseMockRecorder) SeedQueue(ctx, uID, seed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedQueue", reflect.TypeOf((*MockUseCase)(nil).SeedQueue), ctx, uID, seed)
+}
+
+// PlayNext mocks base method
+func (m *MockUseCase) PlayNext(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayNext", ctx, uID, track)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlayNext indicates an expected call of PlayNext
+func (mr *MockUseCaseMockRecorder) PlayNext(ctx, uID, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayNext", reflect.TypeOf((*MockUseCase)(nil).PlayNext), ctx, uID, track)
+}
+
+// AddToQueue mocks base method
+func (m *MockUseCase) AddToQueue(ctx context.Context, uID string, track models.QueueTrack) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToQueue", ctx, uID, track)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToQueue indicates an expected call of AddToQueue
+func (mr *MockUseCaseMockRecorder) AddToQueue(ctx, uID, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToQueue", reflect.TypeOf((*MockUseCase)(nil).AddToQueue), ctx, uID, track)
+}
+
+// Shuffle mocks base method
+func (m *MockUseCase) Shuffle(ctx context.Context, uID string) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shuffle", ctx, uID)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Shuffle indicates an expected call of Shuffle
+func (mr *MockUseCaseMockRecorder) Shuffle(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shuffle", reflect.TypeOf((*MockUseCase)(nil).Shuffle), ctx, uID)
+}
+
+// Next mocks base method
+func (m *MockUseCase) Next(ctx context.Context, uID string) (models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, uID)
+	ret0, _ := ret[0].(models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next
+func (mr *MockUseCaseMockRecorder) Next(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockUseCase)(nil).Next), ctx, uID)
+}
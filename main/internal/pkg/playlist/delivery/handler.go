@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/gorilla/mux"
 	"net/http"
@@ -27,9 +29,9 @@ func (h *PlaylistHandler) GetUserPlaylists(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	playlists, err := h.PlaylistUC.GetUserPlaylists(user.Id)
+	playlists, err := h.PlaylistUC.GetUserPlaylists(r.Context(), user.Id)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to get playlists"+err.Error())
+		h.sendError(w, r.Context(), "failed to get playlists"+err.Error(), err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -58,9 +60,9 @@ func (h *PlaylistHandler) GetFullPlaylistById(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	playlistData, err := h.PlaylistUC.GetPlaylistById(varId)
+	playlistData, err := h.PlaylistUC.GetPlaylistById(r.Context(), varId)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to get playlistData: "+err.Error())
+		h.sendError(w, r.Context(), "failed to get playlistData: "+err.Error(), err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -96,9 +98,9 @@ func (h *PlaylistHandler) GetBoundedPlaylistTracks(w http.ResponseWriter, r *htt
 		user = models.User{Id: ""}
 	}
 
-	tracks, err := h.TrackUC.GetBoundedTracksByPlaylistId(id, start, end, user.Id)
+	tracks, err := h.TrackUC.GetBoundedTracksByPlaylistId(r.Context(), id, start, end, user.Id)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to get tracks"+err.Error())
+		h.sendError(w, r.Context(), "failed to get tracks"+err.Error(), err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -121,15 +123,19 @@ func (h *PlaylistHandler) sendBadRequest(w http.ResponseWriter, ctx context.Cont
 	w.WriteHeader(http.StatusBadRequest)
 }
 
+func (h *PlaylistHandler) sendError(w http.ResponseWriter, ctx context.Context, msg string, err error) {
+	h.Log.HttpInfo(ctx, msg, apperrors.WriteHTTP(w, err))
+}
+
 func (h *PlaylistHandler) checkUserAccess(w http.ResponseWriter, r *http.Request, playlistID string, isStrict bool) error {
 	user, ok := r.Context().Value(middleware.UserKey).(models.User)
 	if !ok {
 		user = models.User{Id: "0"}
 	}
 
-	ok, err := h.PlaylistUC.CheckAccessToPlaylist(user.Id, playlistID, isStrict)
+	ok, err := h.PlaylistUC.CheckAccessToPlaylist(r.Context(), user.Id, playlistID, isStrict)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to check access: "+err.Error())
+		h.sendError(w, r.Context(), "failed to check access: "+err.Error(), err)
 		return errors.New("failed to check access")
 	}
 	if !ok {
@@ -146,6 +152,10 @@ func (h *PlaylistHandler) CreatePlaylist(w http.ResponseWriter, r *http.Request)
 		h.sendBadRequest(w, r.Context(), "no name in mux vars")
 		return
 	}
+	if err := validation.Validate(validation.Field("name", name, validation.Required, validation.MaxLen(models.PlaylistNameLen))); err != nil {
+		h.sendError(w, r.Context(), "invalid playlist name: "+err.Error(), err)
+		return
+	}
 
 	user, ok := r.Context().Value(middleware.UserKey).(models.User)
 	if !ok {
@@ -154,9 +164,9 @@ func (h *PlaylistHandler) CreatePlaylist(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	plID, err := h.PlaylistUC.CreatePlaylist(name, user.Id)
+	plID, err := h.PlaylistUC.CreatePlaylist(r.Context(), name, user.Id)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "cant create playlist:"+err.Error())
+		h.sendError(w, r.Context(), "cant create playlist:"+err.Error(), err)
 		return
 	}
 
@@ -180,10 +190,9 @@ func (h *PlaylistHandler) CreatePlaylist(w http.ResponseWriter, r *http.Request)
 func (h *PlaylistHandler) AddTrackToPlaylist(w http.ResponseWriter, r *http.Request) {
 	plTracks := models.PlaylistTracks{}
 
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&plTracks)
+	err := validation.DecodeJSON(r, &plTracks)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "error while unmarshalling JSON:"+err.Error())
+		h.sendError(w, r.Context(), "invalid input: "+err.Error(), err)
 		return
 	}
 
@@ -191,8 +200,8 @@ func (h *PlaylistHandler) AddTrackToPlaylist(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if err = h.PlaylistUC.AddTrackToPlaylist(plTracks); err != nil {
-		h.sendBadRequest(w, r.Context(), "cant add track to playlist:"+err.Error())
+	if err = h.PlaylistUC.AddTrackToPlaylist(r.Context(), plTracks); err != nil {
+		h.sendError(w, r.Context(), "cant add track to playlist:"+err.Error(), err)
 		return
 	}
 
@@ -213,11 +222,11 @@ func (h *PlaylistHandler) GetPlaylistsIDByTrack(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	playlistIDs, err := h.PlaylistUC.GetUserPlaylistsIdByTrack(user.Id, id)
+	playlistIDs, err := h.PlaylistUC.GetUserPlaylistsIdByTrack(r.Context(), user.Id, id)
 
 	if err != nil {
 		h.Log.LogWarning(r.Context(), "playlist delivery", "GetPlaylistsIDByTrack", "failed to get playlists:"+err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
+		apperrors.WriteHTTP(w, err)
 		return
 	}
 
@@ -252,8 +261,8 @@ func (h *PlaylistHandler) DeleteTrackFromPlaylist(w http.ResponseWriter, r *http
 		return
 	}
 
-	if err := h.PlaylistUC.DeleteTrackFromPlaylist(playlistID, trackID); err != nil {
-		h.sendBadRequest(w, r.Context(), "cant delete track from playlist:"+err.Error())
+	if err := h.PlaylistUC.DeleteTrackFromPlaylist(r.Context(), playlistID, trackID); err != nil {
+		h.sendError(w, r.Context(), "cant delete track from playlist:"+err.Error(), err)
 		return
 	}
 
@@ -271,8 +280,8 @@ func (h *PlaylistHandler) DeletePlaylist(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := h.PlaylistUC.DeletePlaylist(playlistID); err != nil {
-		h.sendBadRequest(w, r.Context(), "cant delete track from playlist:"+err.Error())
+	if err := h.PlaylistUC.DeletePlaylist(r.Context(), playlistID); err != nil {
+		h.sendError(w, r.Context(), "cant delete track from playlist:"+err.Error(), err)
 		return
 	}
 
@@ -286,9 +295,9 @@ func (h *PlaylistHandler) ChangePrivacy(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err := h.PlaylistUC.ChangePrivacy(varId)
+	err := h.PlaylistUC.ChangePrivacy(r.Context(), varId)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to change playlist privacy: "+err.Error())
+		h.sendError(w, r.Context(), "failed to change playlist privacy: "+err.Error(), err)
 		return
 	}
 }
@@ -311,9 +320,9 @@ func (h *PlaylistHandler) AddSharedPlaylist(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	id, err := h.PlaylistUC.AddSharedPlaylist(varId, user.Id)
+	id, err := h.PlaylistUC.AddSharedPlaylist(r.Context(), varId, user.Id)
 	if err != nil {
-		h.sendBadRequest(w, r.Context(), "failed to copy playlist"+err.Error())
+		h.sendError(w, r.Context(), "failed to copy playlist"+err.Error(), err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -24,7 +24,7 @@ var testUser = models.User{
 	Password: "76453647fvd",
 	Name:     "TestName",
 	Login:    "nnnagibator",
-	Sex:      "Man",
+	Sex:      models.SexMale,
 	Image:    "/static/avatar/default.png",
 	Email:    "klsJDLKfj@mail.ru",
 }
@@ -80,7 +80,7 @@ func TestGetUserPlaylists(t *testing.T) {
 		resBody := fmt.Sprintf(`{"playlists":%s}`, string(plMarshal))
 
 		m.EXPECT().
-			GetUserPlaylists(testUser.Id).
+			GetUserPlaylists(gomock.Any(), testUser.Id).
 			Return(playlists, nil)
 
 		apitest.New("GetUserPlaylists-OK").
@@ -105,7 +105,7 @@ func TestGetUserPlaylists(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			GetUserPlaylists(testUser.Id).
+			GetUserPlaylists(gomock.Any(), testUser.Id).
 			Return([]models.Playlist{}, testError)
 
 		apitest.New("GetUserPlaylists-UseCaseError").
@@ -113,7 +113,7 @@ func TestGetUserPlaylists(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/users/playlists").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 	t.Run("GetUserPlaylists-NoAuth", func(t *testing.T) {
@@ -153,11 +153,11 @@ func TestGetFullPlaylistById(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(true, nil)
 
 		m.EXPECT().
-			GetPlaylistById(testPl.Id).
+			GetPlaylistById(gomock.Any(), testPl.Id).
 			Return(testPl, nil)
 
 		apitest.New("GetFullPlaylistById-OK").
@@ -208,7 +208,7 @@ func TestGetFullPlaylistById(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(false, testError)
 
 		apitest.New("GetFullPlaylistById-CheckAccessError").
@@ -216,7 +216,7 @@ func TestGetFullPlaylistById(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/playlists/1234").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 
@@ -245,7 +245,7 @@ func TestGetFullPlaylistById(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(false, nil)
 
 		apitest.New("GetFullPlaylistById-NoAccess").
@@ -282,11 +282,11 @@ func TestGetFullPlaylistById(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(true, nil)
 
 		m.EXPECT().
-			GetPlaylistById(testPl.Id).
+			GetPlaylistById(gomock.Any(), testPl.Id).
 			Return(models.Playlist{}, testError)
 
 		apitest.New("GetFullPlaylistById-GetPlError").
@@ -294,7 +294,7 @@ func TestGetFullPlaylistById(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/playlists/1234").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -332,7 +332,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(true, nil)
 
 		var startUint uint64 = 0
@@ -348,7 +348,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 		assert.NoError(t, err)
 
 		tr.EXPECT().
-			GetBoundedTracksByPlaylistId(id, startUint, endUint, testUser.Id).
+			GetBoundedTracksByPlaylistId(gomock.Any(), id, startUint, endUint, testUser.Id).
 			Return(tracks, nil)
 
 		apitest.New("GetBoundedPlaylistTracks-OK").
@@ -401,7 +401,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(false, nil)
 
 		apitest.New("GetBoundedPlaylistTracks-CheckAccessErr").
@@ -444,7 +444,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 		}
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, testPl.Id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, testPl.Id, false).
 			Return(true, nil)
 
 		var startUint uint64 = 0
@@ -453,7 +453,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 		testError := errors.New("testError")
 
 		tr.EXPECT().
-			GetBoundedTracksByPlaylistId(id, startUint, endUint, testUser.Id).
+			GetBoundedTracksByPlaylistId(gomock.Any(), id, startUint, endUint, testUser.Id).
 			Return([]models.Track{}, testError)
 
 		apitest.New("GetBoundedPlaylistTracks-Error").
@@ -461,7 +461,7 @@ func TestGetBoundedPlaylistTracks(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/playlists/1234/0/50").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -485,7 +485,7 @@ func TestCreatePlaylist(t *testing.T) { //todo check errors
 		plID := "12314"
 
 		m.EXPECT().
-			CreatePlaylist(name, testUser.Id).
+			CreatePlaylist(gomock.Any(), name, testUser.Id).
 			Return(plID, nil)
 
 		apitest.New("CreatePlaylist-OK").
@@ -547,11 +547,11 @@ func TestAddTrackToPlaylist(t *testing.T) { //todo check errors, body check
 		assert.NoError(t, err)
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, plTracks.PlaylistID, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, plTracks.PlaylistID, true).
 			Return(true, nil)
 
 		m.EXPECT().
-			AddTrackToPlaylist(plTracks).
+			AddTrackToPlaylist(gomock.Any(), plTracks).
 			Return(nil)
 
 		apitest.New("AddTrackToPlaylist-OK").
@@ -597,7 +597,7 @@ func TestAddTrackToPlaylist(t *testing.T) { //todo check errors, body check
 		assert.NoError(t, err)
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, plTracks.PlaylistID, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, plTracks.PlaylistID, true).
 			Return(false, nil)
 
 		apitest.New("AddTrackToPlaylist-NoAccess").
@@ -634,11 +634,11 @@ func TestAddTrackToPlaylist(t *testing.T) { //todo check errors, body check
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, plTracks.PlaylistID, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, plTracks.PlaylistID, true).
 			Return(true, nil)
 
 		m.EXPECT().
-			AddTrackToPlaylist(plTracks).
+			AddTrackToPlaylist(gomock.Any(), plTracks).
 			Return(testError)
 
 		apitest.New("AddTrackToPlaylist-Error").
@@ -647,7 +647,7 @@ func TestAddTrackToPlaylist(t *testing.T) { //todo check errors, body check
 			URL("/api/v1/playlists/tracks").
 			Body(string(jsonData)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -671,7 +671,7 @@ func TestGetPlaylistsIDByTrack(t *testing.T) { //todo check errors, body check
 		IDs := []string{"1", "2", "3"}
 
 		m.EXPECT().
-			GetUserPlaylistsIdByTrack(testUser.Id, id).
+			GetUserPlaylistsIdByTrack(gomock.Any(), testUser.Id, id).
 			Return(IDs, nil)
 
 		jsonData, err := json.Marshal(models.PlaylistsID{IDs: IDs})
@@ -728,7 +728,7 @@ func TestGetPlaylistsIDByTrack(t *testing.T) { //todo check errors, body check
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetUserPlaylistsIdByTrack(testUser.Id, id).
+			GetUserPlaylistsIdByTrack(gomock.Any(), testUser.Id, id).
 			Return([]string{}, testError)
 
 		apitest.New("GetPlaylistsIDByTrack-Error").
@@ -758,11 +758,11 @@ func TestDeletePlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, id, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, id, true).
 			Return(true, nil)
 
 		m.EXPECT().
-			DeletePlaylist(id).
+			DeletePlaylist(gomock.Any(), id).
 			Return(nil)
 
 		apitest.New("DeletePlaylist-OK").
@@ -798,7 +798,7 @@ func TestDeletePlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist("0", id, true).
+			CheckAccessToPlaylist(gomock.Any(), "0", id, true).
 			Return(false, nil)
 
 		apitest.New("DeletePlaylist-NoAccess").
@@ -825,13 +825,13 @@ func TestDeletePlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, id, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, id, true).
 			Return(true, nil)
 
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			DeletePlaylist(id).
+			DeletePlaylist(gomock.Any(), id).
 			Return(testError)
 
 		apitest.New("DeletePlaylist-Error").
@@ -839,7 +839,7 @@ func TestDeletePlaylist(t *testing.T) { //todo check errors, body check
 			Method("Delete").
 			URL("/api/v1/playlists/tracks").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -868,11 +868,11 @@ func TestDeleteTrackFromPlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, pl.Value, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, pl.Value, true).
 			Return(true, nil)
 
 		m.EXPECT().
-			DeleteTrackFromPlaylist(pl.Value, tr.Value).
+			DeleteTrackFromPlaylist(gomock.Any(), pl.Value, tr.Value).
 			Return(nil)
 
 		apitest.New("DeleteTrackFromPlaylist-OK").
@@ -944,7 +944,7 @@ func TestDeleteTrackFromPlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist("0", pl.Value, true).
+			CheckAccessToPlaylist(gomock.Any(), "0", pl.Value, true).
 			Return(false, nil)
 
 		apitest.New("DeleteTrackFromPlaylist-NoAccess").
@@ -978,13 +978,13 @@ func TestDeleteTrackFromPlaylist(t *testing.T) { //todo check errors, body check
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, pl.Value, true).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, pl.Value, true).
 			Return(true, nil)
 
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			DeleteTrackFromPlaylist(pl.Value, tr.Value).
+			DeleteTrackFromPlaylist(gomock.Any(), pl.Value, tr.Value).
 			Return(testError)
 
 		apitest.New("DeleteTrackFromPlaylist-Error").
@@ -992,7 +992,7 @@ func TestDeleteTrackFromPlaylist(t *testing.T) { //todo check errors, body check
 			Method("Delete").
 			URL("/api/v1/playlists/tracks").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -1016,13 +1016,13 @@ func TestAddSharedPlaylist(t *testing.T) {
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, id, false).
 			Return(true, nil)
 
 		newID := "1234243"
 
 		m.EXPECT().
-			AddSharedPlaylist(id, testUser.Id).
+			AddSharedPlaylist(gomock.Any(), id, testUser.Id).
 			Return(newID, nil)
 
 		apitest.New("AddSharedPlaylist-OK").
@@ -1061,7 +1061,7 @@ func TestAddSharedPlaylist(t *testing.T) {
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist("", id, false).
+			CheckAccessToPlaylist(gomock.Any(), "", id, false).
 			Return(false, nil)
 
 		apitest.New("AddSharedPlaylist-NoAuth").
@@ -1090,20 +1090,20 @@ func TestAddSharedPlaylist(t *testing.T) {
 		plHandler.PlaylistUC = m
 
 		m.EXPECT().
-			CheckAccessToPlaylist(testUser.Id, id, false).
+			CheckAccessToPlaylist(gomock.Any(), testUser.Id, id, false).
 			Return(true, nil)
 
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			AddSharedPlaylist(id, testUser.Id).
+			AddSharedPlaylist(gomock.Any(), id, testUser.Id).
 			Return("", testError)
 
 		apitest.New("AddSharedPlaylist-Error").
 			Handler(handler).
 			Method("POST").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -1,15 +1,18 @@
 package playlist
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type Repository interface {
-	GetUserPlaylists(uId string) ([]models.Playlist, error)
-	GetPlaylistById(pId string) (models.Playlist, error)
-	CreatePlaylist(name string, uID string) (plID string, err error)
-	AddTrackToPlaylist(plTracks models.PlaylistTracks) error
-	GetUserPlaylistsIdByTrack(userID, trackID string) ([]string, error)
-	DeleteTrackFromPlaylist(plID, trackID string) error
-	DeletePlaylist(plID string) error
-	ChangePrivacy(plID string) error
-	GetAllPlaylistTracks(plID string) ([]models.PlaylistTracks, error)
+	GetUserPlaylists(ctx context.Context, uId string) ([]models.Playlist, error)
+	GetPlaylistById(ctx context.Context, pId string) (models.Playlist, error)
+	CreatePlaylist(ctx context.Context, name string, uID string) (plID string, err error)
+	AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error
+	GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error)
+	DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error
+	DeletePlaylist(ctx context.Context, plID string) error
+	ChangePrivacy(ctx context.Context, plID string) error
+	GetAllPlaylistTracks(ctx context.Context, plID string) ([]models.PlaylistTracks, error)
 }
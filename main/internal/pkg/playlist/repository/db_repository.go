@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"context"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/jinzhu/gorm"
 	"strconv"
@@ -42,10 +45,10 @@ func toModel(pl Playlists) models.Playlist {
 	}
 }
 
-func (pr *DbPlaylistRepository) GetUserPlaylists(uId string) ([]models.Playlist, error) {
+func (pr *DbPlaylistRepository) GetUserPlaylists(ctx context.Context, uId string) ([]models.Playlist, error) {
 	var dbPlaylists []Playlists
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Where("user_ID = ?", uId).
 		Find(&dbPlaylists)
 
@@ -61,10 +64,10 @@ func (pr *DbPlaylistRepository) GetUserPlaylists(uId string) ([]models.Playlist,
 	return playlists, nil
 }
 
-func (pr *DbPlaylistRepository) GetPlaylistById(pId string) (models.Playlist, error) {
+func (pr *DbPlaylistRepository) GetPlaylistById(ctx context.Context, pId string) (models.Playlist, error) {
 	var dbPlaylists Playlists
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Where("id = ?", pId).
 		First(&dbPlaylists)
 
@@ -75,10 +78,10 @@ func (pr *DbPlaylistRepository) GetPlaylistById(pId string) (models.Playlist, er
 	return toModel(dbPlaylists), nil
 }
 
-func (pr *DbPlaylistRepository) CreatePlaylist(name string, uID string) (plID string, err error) {
+func (pr *DbPlaylistRepository) CreatePlaylist(ctx context.Context, name string, uID string) (plID string, err error) {
 	userID, err := strconv.ParseUint(uID, 10, 64)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse uID: %v", err)
+		return "", apperrors.Errorf(apperrors.Validation, "failed to parse uID: %w", err)
 	}
 
 	newPlaylist := Playlists{
@@ -86,21 +89,21 @@ func (pr *DbPlaylistRepository) CreatePlaylist(name string, uID string) (plID st
 		UserId: userID,
 	}
 
-	if err := pr.db.Create(&newPlaylist).Error; err != nil {
-		return "", fmt.Errorf("failed to create playlist: %v", err)
+	if err := database.WithContext(ctx, pr.db).Create(&newPlaylist).Error; err != nil {
+		return "", fmt.Errorf("failed to create playlist: %w", err)
 	}
 
 	return strconv.FormatUint(newPlaylist.Id, 10), nil
 }
 
-func (pr *DbPlaylistRepository) AddTrackToPlaylist(plTracks models.PlaylistTracks) error {
+func (pr *DbPlaylistRepository) AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error {
 	playlistID, err := strconv.ParseUint(plTracks.PlaylistID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to parse plID: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to parse plID: %w", err)
 	}
 	trackID, err := strconv.ParseUint(plTracks.TrackID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to parse trackID: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to parse trackID: %w", err)
 	}
 
 	newRelation := TrackInPlaylist{
@@ -109,17 +112,17 @@ func (pr *DbPlaylistRepository) AddTrackToPlaylist(plTracks models.PlaylistTrack
 		Image:      plTracks.Image,
 	}
 
-	if err := pr.db.Table("playlist_tracks").Create(&newRelation).Error; err != nil {
-		return fmt.Errorf("failed to create playlist:track relation: %v", err)
+	if err := database.WithContext(ctx, pr.db).Table("playlist_tracks").Create(&newRelation).Error; err != nil {
+		return fmt.Errorf("failed to create playlist:track relation: %w", err)
 	}
 
 	return nil
 }
 
-func (pr *DbPlaylistRepository) GetUserPlaylistsIdByTrack(userID, trackID string) ([]string, error) {
+func (pr *DbPlaylistRepository) GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error) {
 	var dbPlaylists []Playlists
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Table("playlist_tracks as pt").
 		Select("playlist_ID as id").
 		Joins("join playlists as p on p.id = pt.playlist_id").
@@ -127,7 +130,7 @@ func (pr *DbPlaylistRepository) GetUserPlaylistsIdByTrack(userID, trackID string
 		Scan(&dbPlaylists)
 
 	if err := db.Error; err != nil && err != gorm.ErrRecordNotFound {
-		return nil, fmt.Errorf("query failed: %v", err)
+		return nil, fmt.Errorf("query failed: %w", err)
 	}
 
 	playlists := make([]string, len(dbPlaylists))
@@ -138,14 +141,14 @@ func (pr *DbPlaylistRepository) GetUserPlaylistsIdByTrack(userID, trackID string
 	return playlists, nil
 }
 
-func (pr *DbPlaylistRepository) DeleteTrackFromPlaylist(plID, trackID string) error {
+func (pr *DbPlaylistRepository) DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error {
 	playlist, err := strconv.ParseUint(plID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to parse plID: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to parse plID: %w", err)
 	}
 	track, err := strconv.ParseUint(trackID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to parse trackID: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to parse trackID: %w", err)
 	}
 
 	dbPlaylist := TrackInPlaylist{
@@ -153,64 +156,64 @@ func (pr *DbPlaylistRepository) DeleteTrackFromPlaylist(plID, trackID string) er
 		TrackID:    track,
 	}
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Table("playlist_tracks").
 		Delete(&dbPlaylist)
 
 	if err := db.Error; err != nil {
-		return fmt.Errorf("delete failed: %v", err)
+		return fmt.Errorf("delete failed: %w", err)
 	}
 
 	return nil
 }
 
-func (pr *DbPlaylistRepository) DeletePlaylist(plID string) error {
+func (pr *DbPlaylistRepository) DeletePlaylist(ctx context.Context, plID string) error {
 	playlist, err := strconv.ParseUint(plID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to parse plID: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to parse plID: %w", err)
 	}
 
 	dbPlaylist := Playlists{
 		Id: playlist,
 	}
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Table("playlists").
 		Delete(&dbPlaylist)
 
 	if err := db.Error; err != nil {
-		return fmt.Errorf("delete failed: %v", err)
+		return fmt.Errorf("delete failed: %w", err)
 	}
 
 	return nil
 }
 
-func (pr *DbPlaylistRepository) ChangePrivacy(plID string) error {
+func (pr *DbPlaylistRepository) ChangePrivacy(ctx context.Context, plID string) error {
 	id, err := strconv.ParseUint(plID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to convert playlist id: %v", err)
+		return apperrors.Errorf(apperrors.Validation, "failed to convert playlist id: %w", err)
 	}
 
-	db := pr.db.Exec("update playlists set private = not private where id = ?", id)
+	db := database.WithContext(ctx, pr.db).Exec("update playlists set private = not private where id = ?", id)
 
 	if err := db.Error; err != nil {
-		return fmt.Errorf("query failed: %v", err)
+		return fmt.Errorf("query failed: %w", err)
 	}
 
 	return nil
 }
 
-func (pr *DbPlaylistRepository) GetAllPlaylistTracks(plID string) ([]models.PlaylistTracks, error) {
+func (pr *DbPlaylistRepository) GetAllPlaylistTracks(ctx context.Context, plID string) ([]models.PlaylistTracks, error) {
 	var tracks []models.PlaylistTracks
 
-	db := pr.db.
+	db := database.WithContext(ctx, pr.db).
 		Table("playlist_tracks").
 		Where("playlist_id = ?", plID).
 		Find(&tracks)
 
 	err := db.Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to select query: %e", err)
+		return nil, fmt.Errorf("failed to select query: %w", err)
 	}
 
 	return tracks, nil
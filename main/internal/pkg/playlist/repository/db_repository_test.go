@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -68,7 +69,7 @@ func (s *Suite) TestGetUserPlaylists() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "user_id"}).
 			AddRow(pl1.Id, pl1.Name, pl1.Image, pl1.UserId).AddRow(pl2.Id, pl2.Name, pl2.Image, pl2.UserId))
 
-	res, err := s.repository.GetUserPlaylists(pl1.UserId)
+	res, err := s.repository.GetUserPlaylists(context.Background(), pl1.UserId)
 
 	require.NoError(s.T(), err)
 
@@ -81,7 +82,7 @@ func (s *Suite) TestGetUserPlaylists() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
 		WithArgs(pl1.UserId).WillReturnError(dbError)
 
-	_, err = s.repository.GetUserPlaylists(pl1.UserId)
+	_, err = s.repository.GetUserPlaylists(context.Background(), pl1.UserId)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, dbError)
@@ -100,7 +101,7 @@ func (s *Suite) TestGetPlaylistById() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "user_id"}).
 			AddRow(pl1.Id, pl1.Name, pl1.Image, pl1.UserId))
 
-	res, err := s.repository.GetPlaylistById(pl1.Id)
+	res, err := s.repository.GetPlaylistById(context.Background(), pl1.Id)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(pl1, res))
@@ -110,7 +111,7 @@ func (s *Suite) TestGetPlaylistById() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
 		WithArgs(pl1.Id).WillReturnError(dbError)
 
-	_, err = s.repository.GetPlaylistById(pl1.Id)
+	_, err = s.repository.GetPlaylistById(context.Background(), pl1.Id)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, dbError)
@@ -131,7 +132,7 @@ func (s *Suite) TestGetUserPlaylistsIdByTrack() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "image", "user_id"}).
 			AddRow(pl1.Id, pl1.Name, pl1.Image, pl1.UserId))
 
-	res, err := s.repository.GetUserPlaylistsIdByTrack(pl1.UserId, trID)
+	res, err := s.repository.GetUserPlaylistsIdByTrack(context.Background(), pl1.UserId, trID)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal([]string{pl1.Id}, res))
@@ -141,7 +142,7 @@ func (s *Suite) TestGetUserPlaylistsIdByTrack() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
 		WithArgs(pl1.UserId, trID).WillReturnError(dbError)
 
-	_, err = s.repository.GetUserPlaylistsIdByTrack(pl1.UserId, trID)
+	_, err = s.repository.GetUserPlaylistsIdByTrack(context.Background(), pl1.UserId, trID)
 
 	require.Error(s.T(), err)
 }
@@ -160,7 +161,7 @@ func (s *Suite) TestDeleteTrackFromPlaylist() {
 	s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM`)).
 		WithArgs(plID, trID).WillReturnResult(driver.RowsAffected(1))
 	s.mock.ExpectCommit()
-	err := s.repository.DeleteTrackFromPlaylist(pl1.Id, strconv.FormatInt(trID, 10))
+	err := s.repository.DeleteTrackFromPlaylist(context.Background(), pl1.Id, strconv.FormatInt(trID, 10))
 
 	require.NoError(s.T(), err)
 
@@ -171,7 +172,7 @@ func (s *Suite) TestDeleteTrackFromPlaylist() {
 		WithArgs(plID, trID).WillReturnError(dbError)
 	s.mock.ExpectRollback()
 
-	err = s.repository.DeleteTrackFromPlaylist(pl1.Id, strconv.FormatInt(trID, 10))
+	err = s.repository.DeleteTrackFromPlaylist(context.Background(), pl1.Id, strconv.FormatInt(trID, 10))
 
 	require.Error(s.T(), err)
 }
@@ -199,7 +200,7 @@ func (s *Suite) TestAddTrackToPlaylist() {
 
 	s.mock.ExpectCommit()
 
-	err := s.repository.AddTrackToPlaylist(pl)
+	err := s.repository.AddTrackToPlaylist(context.Background(), pl)
 
 	require.NoError(s.T(), err)
 
@@ -213,7 +214,7 @@ func (s *Suite) TestAddTrackToPlaylist() {
 
 	s.mock.ExpectRollback()
 
-	err = s.repository.AddTrackToPlaylist(pl)
+	err = s.repository.AddTrackToPlaylist(context.Background(), pl)
 
 	require.Error(s.T(), err)
 }
@@ -230,7 +231,7 @@ func (s *Suite) TestDeletePlaylist() {
 
 	s.mock.ExpectCommit()
 
-	err := s.repository.DeletePlaylist(fmt.Sprint(plID))
+	err := s.repository.DeletePlaylist(context.Background(), fmt.Sprint(plID))
 
 	require.NoError(s.T(), err)
 
@@ -244,7 +245,7 @@ func (s *Suite) TestDeletePlaylist() {
 
 	s.mock.ExpectRollback()
 
-	err = s.repository.DeletePlaylist(fmt.Sprint(plID))
+	err = s.repository.DeletePlaylist(context.Background(), fmt.Sprint(plID))
 
 	require.Error(s.T(), err)
 }
@@ -257,7 +258,7 @@ func (s *Suite) TestChangePrivacy() {
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
 		WithArgs(plID).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := s.repository.ChangePrivacy(fmt.Sprint(plID))
+	err := s.repository.ChangePrivacy(context.Background(), fmt.Sprint(plID))
 
 	require.NoError(s.T(), err)
 
@@ -267,7 +268,7 @@ func (s *Suite) TestChangePrivacy() {
 	s.mock.ExpectExec(regexp.QuoteMeta(query)).
 		WithArgs(plID).WillReturnError(dbError)
 
-	err = s.repository.ChangePrivacy(fmt.Sprint(plID))
+	err = s.repository.ChangePrivacy(context.Background(), fmt.Sprint(plID))
 
 	require.Error(s.T(), err)
 }
@@ -293,7 +294,7 @@ func (s *Suite) TestGetAllPlaylistTracks() {
 			AddRow(tracks[0].PlaylistID, tracks[0].TrackID, tracks[0].Index, tracks[0].Image),
 	)
 
-	res, err := s.repository.GetAllPlaylistTracks(fmt.Sprint(plID))
+	res, err := s.repository.GetAllPlaylistTracks(context.Background(), fmt.Sprint(plID))
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(res, tracks))
@@ -304,7 +305,7 @@ func (s *Suite) TestGetAllPlaylistTracks() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(query)).
 		WithArgs(fmt.Sprint(plID)).WillReturnError(dbError)
 
-	_, err = s.repository.GetAllPlaylistTracks(fmt.Sprint(plID))
+	_, err = s.repository.GetAllPlaylistTracks(context.Background(), fmt.Sprint(plID))
 
 	require.Error(s.T(), err)
 }
@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package playlist is a generated GoMock package.
+package playlist
+
+import (
+	context "context"
+	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRepository is a mock of Repository interface
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUserPlaylists mocks base method
+func (m *MockRepository) GetUserPlaylists(ctx context.Context, uId string) ([]models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPlaylists", ctx, uId)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPlaylists indicates an expected call of GetUserPlaylists
+func (mr *MockRepositoryMockRecorder) GetUserPlaylists(ctx, uId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylists", reflect.TypeOf((*MockRepository)(nil).GetUserPlaylists), ctx, uId)
+}
+
+// GetPlaylistById mocks base method
+func (m *MockRepository) GetPlaylistById(ctx context.Context, pId string) (models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistById", ctx, pId)
+	ret0, _ := ret[0].(models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistById indicates an expected call of GetPlaylistById
+func (mr *MockRepositoryMockRecorder) GetPlaylistById(ctx, pId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistById", reflect.TypeOf((*MockRepository)(nil).GetPlaylistById), ctx, pId)
+}
+
+// CreatePlaylist mocks base method
+func (m *MockRepository) CreatePlaylist(ctx context.Context, name, uID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, name, uID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlaylist indicates an expected call of CreatePlaylist
+func (mr *MockRepositoryMockRecorder) CreatePlaylist(ctx, name, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockRepository)(nil).CreatePlaylist), ctx, name, uID)
+}
+
+// AddTrackToPlaylist mocks base method
+func (m *MockRepository) AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrackToPlaylist", ctx, plTracks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTrackToPlaylist indicates an expected call of AddTrackToPlaylist
+func (mr *MockRepositoryMockRecorder) AddTrackToPlaylist(ctx, plTracks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrackToPlaylist", reflect.TypeOf((*MockRepository)(nil).AddTrackToPlaylist), ctx, plTracks)
+}
+
+// GetUserPlaylistsIdByTrack mocks base method
+func (m *MockRepository) GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPlaylistsIdByTrack", ctx, userID, trackID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPlaylistsIdByTrack indicates an expected call of GetUserPlaylistsIdByTrack
+func (mr *MockRepositoryMockRecorder) GetUserPlaylistsIdByTrack(ctx, userID, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylistsIdByTrack", reflect.TypeOf((*MockRepository)(nil).GetUserPlaylistsIdByTrack), ctx, userID, trackID)
+}
+
+// DeleteTrackFromPlaylist mocks base method
+func (m *MockRepository) DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackFromPlaylist", ctx, plID, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrackFromPlaylist indicates an expected call of DeleteTrackFromPlaylist
+func (mr *MockRepositoryMockRecorder) DeleteTrackFromPlaylist(ctx, plID, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackFromPlaylist", reflect.TypeOf((*MockRepository)(nil).DeleteTrackFromPlaylist), ctx, plID, trackID)
+}
+
+// DeletePlaylist mocks base method
+func (m *MockRepository) DeletePlaylist(ctx context.Context, plID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlaylist", ctx, plID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlaylist indicates an expected call of DeletePlaylist
+func (mr *MockRepositoryMockRecorder) DeletePlaylist(ctx, plID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockRepository)(nil).DeletePlaylist), ctx, plID)
+}
+
+// ChangePrivacy mocks base method
+func (m *MockRepository) ChangePrivacy(ctx context.Context, plID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePrivacy", ctx, plID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePrivacy indicates an expected call of ChangePrivacy
+func (mr *MockRepositoryMockRecorder) ChangePrivacy(ctx, plID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePrivacy", reflect.TypeOf((*MockRepository)(nil).ChangePrivacy), ctx, plID)
+}
+
+// GetAllPlaylistTracks mocks base method
+func (m *MockRepository) GetAllPlaylistTracks(ctx context.Context, plID string) ([]models.PlaylistTracks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPlaylistTracks", ctx, plID)
+	ret0, _ := ret[0].([]models.PlaylistTracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPlaylistTracks indicates an expected call of GetAllPlaylistTracks
+func (mr *MockRepositoryMockRecorder) GetAllPlaylistTracks(ctx, plID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPlaylistTracks", reflect.TypeOf((*MockRepository)(nil).GetAllPlaylistTracks), ctx, plID)
+}
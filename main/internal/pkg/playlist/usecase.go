@@ -1,16 +1,19 @@
 package playlist
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type UseCase interface {
-	GetUserPlaylists(id string) ([]models.Playlist, error)
-	GetPlaylistById(id string) (models.Playlist, error)
-	CreatePlaylist(name string, uID string) (plID string, err error)
-	CheckAccessToPlaylist(userId string, playlistId string, isStrict bool) (bool, error)
-	AddTrackToPlaylist(plTracks models.PlaylistTracks) error
-	GetUserPlaylistsIdByTrack(userID, trackID string) ([]string, error)
-	DeleteTrackFromPlaylist(plID, trackID string) error
-	DeletePlaylist(plID string) error
-	ChangePrivacy(plID string) error
-	AddSharedPlaylist(plID string, uID string) (string, error)
+	GetUserPlaylists(ctx context.Context, id string) ([]models.Playlist, error)
+	GetPlaylistById(ctx context.Context, id string) (models.Playlist, error)
+	CreatePlaylist(ctx context.Context, name string, uID string) (plID string, err error)
+	CheckAccessToPlaylist(ctx context.Context, userId string, playlistId string, isStrict bool) (bool, error)
+	AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error
+	GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error)
+	DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error
+	DeletePlaylist(ctx context.Context, plID string) error
+	ChangePrivacy(ctx context.Context, plID string) error
+	AddSharedPlaylist(ctx context.Context, plID string, uID string) (string, error)
 }
@@ -1,61 +1,66 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/notification"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 )
 
 type PlaylistUseCase struct {
-	PlRepository playlist.Repository
+	PlRepository   playlist.Repository
+	NotificationUC notification.UseCase
+	Log            *logger.MainLogger
 }
 
-func (uc PlaylistUseCase) GetUserPlaylists(id string) ([]models.Playlist, error) {
-	return uc.PlRepository.GetUserPlaylists(id)
+func (uc PlaylistUseCase) GetUserPlaylists(ctx context.Context, id string) ([]models.Playlist, error) {
+	return uc.PlRepository.GetUserPlaylists(ctx, id)
 }
 
-func (uc PlaylistUseCase) GetPlaylistById(id string) (models.Playlist, error) {
-	return uc.PlRepository.GetPlaylistById(id)
+func (uc PlaylistUseCase) GetPlaylistById(ctx context.Context, id string) (models.Playlist, error) {
+	return uc.PlRepository.GetPlaylistById(ctx, id)
 }
 
-func (uc PlaylistUseCase) CreatePlaylist(name string, uID string) (plID string, err error) {
-	return uc.PlRepository.CreatePlaylist(name, uID)
+func (uc PlaylistUseCase) CreatePlaylist(ctx context.Context, name string, uID string) (plID string, err error) {
+	return uc.PlRepository.CreatePlaylist(ctx, name, uID)
 }
 
-func (uc PlaylistUseCase) AddTrackToPlaylist(plTracks models.PlaylistTracks) error {
-	return uc.PlRepository.AddTrackToPlaylist(plTracks)
+func (uc PlaylistUseCase) AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error {
+	return uc.PlRepository.AddTrackToPlaylist(ctx, plTracks)
 }
 
-func (uc PlaylistUseCase) GetUserPlaylistsIdByTrack(userID, trackID string) ([]string, error) {
-	return uc.PlRepository.GetUserPlaylistsIdByTrack(userID, trackID)
+func (uc PlaylistUseCase) GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error) {
+	return uc.PlRepository.GetUserPlaylistsIdByTrack(ctx, userID, trackID)
 }
 
-func (uc PlaylistUseCase) DeletePlaylist(plID string) error {
-	return uc.PlRepository.DeletePlaylist(plID)
+func (uc PlaylistUseCase) DeletePlaylist(ctx context.Context, plID string) error {
+	return uc.PlRepository.DeletePlaylist(ctx, plID)
 }
 
-func (uc PlaylistUseCase) DeleteTrackFromPlaylist(plID, trackID string) error {
-	return uc.PlRepository.DeleteTrackFromPlaylist(plID, trackID)
+func (uc PlaylistUseCase) DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error {
+	return uc.PlRepository.DeleteTrackFromPlaylist(ctx, plID, trackID)
 }
 
-func (uc PlaylistUseCase) ChangePrivacy(plID string) error {
-	return uc.PlRepository.ChangePrivacy(plID)
+func (uc PlaylistUseCase) ChangePrivacy(ctx context.Context, plID string) error {
+	return uc.PlRepository.ChangePrivacy(ctx, plID)
 }
 
-func (uc PlaylistUseCase) AddSharedPlaylist(plID string, uID string) (string, error) {
-	pl, err := uc.PlRepository.GetPlaylistById(plID)
+func (uc PlaylistUseCase) AddSharedPlaylist(ctx context.Context, plID string, uID string) (string, error) {
+	pl, err := uc.PlRepository.GetPlaylistById(ctx, plID)
 	if err != nil {
-		return "", fmt.Errorf("cant get playlist: %v", err)
+		return "", fmt.Errorf("cant get playlist: %w", err)
 	}
 
-	newPl, err := uc.PlRepository.CreatePlaylist(pl.Name, uID)
+	newPl, err := uc.PlRepository.CreatePlaylist(ctx, pl.Name, uID)
 	if err != nil {
-		return "", fmt.Errorf("cant create playlist: %v", err)
+		return "", fmt.Errorf("cant create playlist: %w", err)
 	}
 
-	tracks, err := uc.PlRepository.GetAllPlaylistTracks(plID)
+	tracks, err := uc.PlRepository.GetAllPlaylistTracks(ctx, plID)
 	if err != nil {
-		return "", fmt.Errorf("cant get playlist tracks: %v", err)
+		return "", fmt.Errorf("cant get playlist tracks: %w", err)
 	}
 
 	for _, elem := range tracks {
@@ -65,19 +70,31 @@ func (uc PlaylistUseCase) AddSharedPlaylist(plID string, uID string) (string, er
 			Index:      elem.Index,
 			Image:      elem.Image,
 		}
-		err := uc.PlRepository.AddTrackToPlaylist(plTracks)
+		err := uc.PlRepository.AddTrackToPlaylist(ctx, plTracks)
 		if err != nil {
-			return "", fmt.Errorf("failed to add track to playlist: %v", err)
+			return "", fmt.Errorf("failed to add track to playlist: %w", err)
+		}
+	}
+
+	if pl.UserId != uID {
+		err = uc.NotificationUC.Notify(ctx, pl.UserId, models.NotificationPlaylistShare, models.PlaylistSharePayload{
+			PlaylistId:   plID,
+			PlaylistName: pl.Name,
+			UserId:       uID,
+		})
+		// the copy is already made, an error would make the client copy the playlist again
+		if err != nil {
+			uc.Log.LogWarning(ctx, "playlist usecase", "AddSharedPlaylist", "failed to notify playlist owner: "+err.Error())
 		}
 	}
 
 	return newPl, nil
 }
 
-func (uc PlaylistUseCase) CheckAccessToPlaylist(userId string, playlistId string, isStrict bool) (bool, error) {
-	pl, err := uc.PlRepository.GetPlaylistById(playlistId)
+func (uc PlaylistUseCase) CheckAccessToPlaylist(ctx context.Context, userId string, playlistId string, isStrict bool) (bool, error) {
+	pl, err := uc.PlRepository.GetPlaylistById(ctx, playlistId)
 	if err != nil {
-		return false, fmt.Errorf("cant get playlist: %v", err)
+		return false, fmt.Errorf("cant get playlist: %w", err)
 	}
 
 	if isStrict {
@@ -5,6 +5,7 @@
 package playlist
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,147 +35,147 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // GetUserPlaylists mocks base method
-func (m *MockUseCase) GetUserPlaylists(id string) ([]models.Playlist, error) {
+func (m *MockUseCase) GetUserPlaylists(ctx context.Context, id string) ([]models.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPlaylists", id)
+	ret := m.ctrl.Call(m, "GetUserPlaylists", ctx, id)
 	ret0, _ := ret[0].([]models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPlaylists indicates an expected call of GetUserPlaylists
-func (mr *MockUseCaseMockRecorder) GetUserPlaylists(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserPlaylists(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylists", reflect.TypeOf((*MockUseCase)(nil).GetUserPlaylists), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylists", reflect.TypeOf((*MockUseCase)(nil).GetUserPlaylists), ctx, id)
 }
 
 // GetPlaylistById mocks base method
-func (m *MockUseCase) GetPlaylistById(id string) (models.Playlist, error) {
+func (m *MockUseCase) GetPlaylistById(ctx context.Context, id string) (models.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPlaylistById", id)
+	ret := m.ctrl.Call(m, "GetPlaylistById", ctx, id)
 	ret0, _ := ret[0].(models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPlaylistById indicates an expected call of GetPlaylistById
-func (mr *MockUseCaseMockRecorder) GetPlaylistById(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetPlaylistById(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistById", reflect.TypeOf((*MockUseCase)(nil).GetPlaylistById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistById", reflect.TypeOf((*MockUseCase)(nil).GetPlaylistById), ctx, id)
 }
 
 // CreatePlaylist mocks base method
-func (m *MockUseCase) CreatePlaylist(name, uID string) (string, error) {
+func (m *MockUseCase) CreatePlaylist(ctx context.Context, name, uID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePlaylist", name, uID)
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, name, uID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePlaylist indicates an expected call of CreatePlaylist
-func (mr *MockUseCaseMockRecorder) CreatePlaylist(name, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) CreatePlaylist(ctx, name, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockUseCase)(nil).CreatePlaylist), name, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockUseCase)(nil).CreatePlaylist), ctx, name, uID)
 }
 
 // CheckAccessToPlaylist mocks base method
-func (m *MockUseCase) CheckAccessToPlaylist(userId, playlistId string, isStrict bool) (bool, error) {
+func (m *MockUseCase) CheckAccessToPlaylist(ctx context.Context, userId, playlistId string, isStrict bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckAccessToPlaylist", userId, playlistId, isStrict)
+	ret := m.ctrl.Call(m, "CheckAccessToPlaylist", ctx, userId, playlistId, isStrict)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckAccessToPlaylist indicates an expected call of CheckAccessToPlaylist
-func (mr *MockUseCaseMockRecorder) CheckAccessToPlaylist(userId, playlistId, isStrict interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) CheckAccessToPlaylist(ctx, userId, playlistId, isStrict interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccessToPlaylist", reflect.TypeOf((*MockUseCase)(nil).CheckAccessToPlaylist), userId, playlistId, isStrict)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccessToPlaylist", reflect.TypeOf((*MockUseCase)(nil).CheckAccessToPlaylist), ctx, userId, playlistId, isStrict)
 }
 
 // AddTrackToPlaylist mocks base method
-func (m *MockUseCase) AddTrackToPlaylist(plTracks models.PlaylistTracks) error {
+func (m *MockUseCase) AddTrackToPlaylist(ctx context.Context, plTracks models.PlaylistTracks) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTrackToPlaylist", plTracks)
+	ret := m.ctrl.Call(m, "AddTrackToPlaylist", ctx, plTracks)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddTrackToPlaylist indicates an expected call of AddTrackToPlaylist
-func (mr *MockUseCaseMockRecorder) AddTrackToPlaylist(plTracks interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) AddTrackToPlaylist(ctx, plTracks interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrackToPlaylist", reflect.TypeOf((*MockUseCase)(nil).AddTrackToPlaylist), plTracks)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrackToPlaylist", reflect.TypeOf((*MockUseCase)(nil).AddTrackToPlaylist), ctx, plTracks)
 }
 
 // GetUserPlaylistsIdByTrack mocks base method
-func (m *MockUseCase) GetUserPlaylistsIdByTrack(userID, trackID string) ([]string, error) {
+func (m *MockUseCase) GetUserPlaylistsIdByTrack(ctx context.Context, userID, trackID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPlaylistsIdByTrack", userID, trackID)
+	ret := m.ctrl.Call(m, "GetUserPlaylistsIdByTrack", ctx, userID, trackID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserPlaylistsIdByTrack indicates an expected call of GetUserPlaylistsIdByTrack
-func (mr *MockUseCaseMockRecorder) GetUserPlaylistsIdByTrack(userID, trackID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserPlaylistsIdByTrack(ctx, userID, trackID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylistsIdByTrack", reflect.TypeOf((*MockUseCase)(nil).GetUserPlaylistsIdByTrack), userID, trackID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylistsIdByTrack", reflect.TypeOf((*MockUseCase)(nil).GetUserPlaylistsIdByTrack), ctx, userID, trackID)
 }
 
 // DeleteTrackFromPlaylist mocks base method
-func (m *MockUseCase) DeleteTrackFromPlaylist(plID, trackID string) error {
+func (m *MockUseCase) DeleteTrackFromPlaylist(ctx context.Context, plID, trackID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrackFromPlaylist", plID, trackID)
+	ret := m.ctrl.Call(m, "DeleteTrackFromPlaylist", ctx, plID, trackID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTrackFromPlaylist indicates an expected call of DeleteTrackFromPlaylist
-func (mr *MockUseCaseMockRecorder) DeleteTrackFromPlaylist(plID, trackID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) DeleteTrackFromPlaylist(ctx, plID, trackID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackFromPlaylist", reflect.TypeOf((*MockUseCase)(nil).DeleteTrackFromPlaylist), plID, trackID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackFromPlaylist", reflect.TypeOf((*MockUseCase)(nil).DeleteTrackFromPlaylist), ctx, plID, trackID)
 }
 
 // DeletePlaylist mocks base method
-func (m *MockUseCase) DeletePlaylist(plID string) error {
+func (m *MockUseCase) DeletePlaylist(ctx context.Context, plID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePlaylist", plID)
+	ret := m.ctrl.Call(m, "DeletePlaylist", ctx, plID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePlaylist indicates an expected call of DeletePlaylist
-func (mr *MockUseCaseMockRecorder) DeletePlaylist(plID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) DeletePlaylist(ctx, plID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockUseCase)(nil).DeletePlaylist), plID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockUseCase)(nil).DeletePlaylist), ctx, plID)
 }
 
 // ChangePrivacy mocks base method
-func (m *MockUseCase) ChangePrivacy(plID string) error {
+func (m *MockUseCase) ChangePrivacy(ctx context.Context, plID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangePrivacy", plID)
+	ret := m.ctrl.Call(m, "ChangePrivacy", ctx, plID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ChangePrivacy indicates an expected call of ChangePrivacy
-func (mr *MockUseCaseMockRecorder) ChangePrivacy(plID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) ChangePrivacy(ctx, plID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePrivacy", reflect.TypeOf((*MockUseCase)(nil).ChangePrivacy), plID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePrivacy", reflect.TypeOf((*MockUseCase)(nil).ChangePrivacy), ctx, plID)
 }
 
 // AddSharedPlaylist mocks base method
-func (m *MockUseCase) AddSharedPlaylist(plID, uID string) (string, error) {
+func (m *MockUseCase) AddSharedPlaylist(ctx context.Context, plID, uID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSharedPlaylist", plID, uID)
+	ret := m.ctrl.Call(m, "AddSharedPlaylist", ctx, plID, uID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddSharedPlaylist indicates an expected call of AddSharedPlaylist
-func (mr *MockUseCaseMockRecorder) AddSharedPlaylist(plID, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) AddSharedPlaylist(ctx, plID, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSharedPlaylist", reflect.TypeOf((*MockUseCase)(nil).AddSharedPlaylist), plID, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSharedPlaylist", reflect.TypeOf((*MockUseCase)(nil).AddSharedPlaylist), ctx, plID, uID)
 }
@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit is a token bucket: it holds up to Burst requests and refills Rate requests per second
+type Limit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int64   `mapstructure:"burst"`
+}
+
+type Result struct {
+	Allowed   bool
+	Remaining int64
+	// RetryAfter is the time until the next request is allowed, zero for allowed requests
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full again
+	Reset time.Duration
+}
+
+type Limiter interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
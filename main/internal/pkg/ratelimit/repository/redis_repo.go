@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/tracing"
+	"github.com/gomodule/redigo/redis"
+)
+
+// takeScript refills the bucket for the time passed since the last request and takes a token from it.
+// Replicas share buckets, so the read and the write must be atomic, and the time is taken from redis,
+// so the clocks of replicas don't matter. Tokens are returned as a string, redis truncates lua numbers to integers
+var takeScript = redis.NewScript(1, `
+redis.replicate_commands()
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.max(1, math.ceil((burst - tokens) * 1000 / rate)))
+return {allowed, tostring(tokens)}
+`)
+
+type RedisLimiter struct {
+	redisPool *redis.Pool
+}
+
+func NewRedisLimiter(conn *redis.Pool) RedisLimiter {
+	return RedisLimiter{
+		redisPool: conn,
+	}
+}
+
+func bucketKey(key string) string {
+	return "ratelimit:" + key
+}
+
+func (rl *RedisLimiter) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return ratelimit.Result{}, fmt.Errorf("invalid limit: rate %v, burst %d", limit.Rate, limit.Burst)
+	}
+
+	conn, err := tracing.RedisConn(ctx, rl.redisPool)
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("failed to get redis connection: %w", err)
+	}
+	defer conn.Close()
+
+	reply, err := redis.Values(takeScript.Do(conn, bucketKey(key), limit.Rate, limit.Burst))
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("failed to take token: %w", err)
+	}
+	var allowed int64
+	var tokensStr string
+	if _, err := redis.Scan(reply, &allowed, &tokensStr); err != nil {
+		return ratelimit.Result{}, fmt.Errorf("failed to scan reply: %w", err)
+	}
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("failed to parse tokens: %w", err)
+	}
+
+	result := ratelimit.Result{
+		Allowed:   allowed == 1,
+		Remaining: int64(math.Floor(tokens)),
+		Reset:     secondsToDuration((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !result.Allowed {
+		result.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+	return result, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
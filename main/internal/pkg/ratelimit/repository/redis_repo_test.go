@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/ratelimit"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type Suite struct {
+	suite.Suite
+	redisServer *miniredis.Miniredis
+	limiter     RedisLimiter
+	now         time.Time
+}
+
+func (s *Suite) SetupSuite() {
+	var err error
+	s.redisServer, err = miniredis.Run()
+	require.NoError(s.T(), err)
+
+	addr := s.redisServer.Addr()
+	redisConn := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	s.now = time.Unix(1600000000, 0)
+	s.redisServer.SetTime(s.now)
+	s.limiter = NewRedisLimiter(redisConn)
+}
+
+// Need to restore connection after each func with closed connection testing
+func (s *Suite) AfterTest(_, _ string) {
+	s.SetupSuite()
+}
+
+func (s *Suite) TearDownSuite() {
+	s.redisServer.Close()
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+func (s *Suite) TestTake() {
+	limit := ratelimit.Limit{Rate: 2, Burst: 3}
+	key := "search:ip:127.0.0.1"
+
+	for i := int64(2); i >= 0; i-- {
+		result, err := s.limiter.Take(context.Background(), key, limit)
+		require.NoError(s.T(), err)
+		require.True(s.T(), result.Allowed)
+		require.Equal(s.T(), i, result.Remaining)
+		require.Equal(s.T(), time.Duration(0), result.RetryAfter)
+	}
+
+	//test on empty bucket
+	result, err := s.limiter.Take(context.Background(), key, limit)
+	require.NoError(s.T(), err)
+	require.False(s.T(), result.Allowed)
+	require.Equal(s.T(), int64(0), result.Remaining)
+	require.Equal(s.T(), 500*time.Millisecond, result.RetryAfter)
+	require.Equal(s.T(), 1500*time.Millisecond, result.Reset)
+
+	//test on refill
+	s.now = s.now.Add(500 * time.Millisecond)
+	s.redisServer.SetTime(s.now)
+	result, err = s.limiter.Take(context.Background(), key, limit)
+	require.NoError(s.T(), err)
+	require.True(s.T(), result.Allowed)
+
+	//other keys have own buckets
+	result, err = s.limiter.Take(context.Background(), "search:user:1", limit)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(2), result.Remaining)
+
+	//idle buckets expire once they are full again
+	require.True(s.T(), s.redisServer.Exists(bucketKey(key)))
+	s.redisServer.FastForward(1500 * time.Millisecond)
+	require.False(s.T(), s.redisServer.Exists(bucketKey(key)))
+}
+
+func (s *Suite) TestTakeInvalidLimit() {
+	_, err := s.limiter.Take(context.Background(), "auth:ip:127.0.0.1", ratelimit.Limit{Rate: 0, Burst: 3})
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestTakeClosedConnection() {
+	s.redisServer.Close()
+
+	_, err := s.limiter.Take(context.Background(), "auth:ip:127.0.0.1", ratelimit.Limit{Rate: 1, Burst: 3})
+	require.Error(s.T(), err)
+}
@@ -2,6 +2,7 @@ package delivery
 
 import (
 	"encoding/json"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/search"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/gorilla/mux"
@@ -36,10 +37,9 @@ func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	searchResult, err := h.SearchUC.Search(varText, uint(count))
+	searchResult, err := h.SearchUC.Search(r.Context(), varText, uint(count))
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to search", http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to search", apperrors.WriteHTTP(w, err))
 		return
 	}
 
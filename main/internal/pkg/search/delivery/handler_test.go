@@ -69,7 +69,7 @@ func TestSearch(t *testing.T) {
 		assert.NoError(t, err)
 
 		m.EXPECT().
-			Search(someText, uint(count)).
+			Search(gomock.Any(), someText, uint(count)).
 			Return(searchRes, nil)
 
 		searchHandler.SearchUC = m
@@ -148,7 +148,7 @@ func TestSearch(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			Search(someText, uint(count)).
+			Search(gomock.Any(), someText, uint(count)).
 			Return(models.SearchResult{}, testError)
 
 		searchHandler.SearchUC = m
@@ -158,7 +158,7 @@ func TestSearch(t *testing.T) {
 			Method("Get").
 			URL("/media/RandomSearchRequest/5").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 
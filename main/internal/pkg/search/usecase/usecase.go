@@ -1,9 +1,11 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 )
@@ -12,28 +14,35 @@ type SearchUseCase struct {
 	ArtistRepo artist.Repository
 	AlbumRepo  album.Repository
 	TrackRepo  track.Repository
+	LyricsRepo lyrics.Repository
 }
 
-func (uc SearchUseCase) Search(text string, count uint) (models.SearchResult, error) {
-	artistSearch, err := uc.ArtistRepo.Search(text, count)
+func (uc SearchUseCase) Search(ctx context.Context, text string, count uint) (models.SearchResult, error) {
+	artistSearch, err := uc.ArtistRepo.Search(ctx, text, count)
 	if err != nil {
 		return models.SearchResult{}, fmt.Errorf("failed to search in artists")
 	}
 
-	albumSearch, err := uc.AlbumRepo.Search(text, count)
+	albumSearch, err := uc.AlbumRepo.Search(ctx, text, count)
 	if err != nil {
 		return models.SearchResult{}, fmt.Errorf("failed to search in artists")
 	}
 
-	trackSearch, err := uc.TrackRepo.Search(text, count)
+	trackSearch, err := uc.TrackRepo.Search(ctx, text, count)
 	if err != nil {
 		return models.SearchResult{}, fmt.Errorf("failed to search in artists")
 	}
 
+	lyricsSearch, err := uc.LyricsRepo.Search(ctx, text, count)
+	if err != nil {
+		return models.SearchResult{}, fmt.Errorf("failed to search in lyrics")
+	}
+
 	search := models.SearchResult{
 		Artists: artistSearch,
 		Albums:  albumSearch,
 		Tracks:  trackSearch,
+		Lyrics:  lyricsSearch,
 	}
 
 	return search, nil
@@ -1,8 +1,10 @@
 package usecase
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/lyrics"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 	"github.com/golang/mock/gomock"
@@ -20,6 +22,7 @@ func TestSearch(t *testing.T) {
 		artistMock := artist.NewMockRepository(ctrl)
 		albumMock := album.NewMockRepository(ctrl)
 		trackMock := track.NewMockRepository(ctrl)
+		lyricsMock := lyrics.NewMockRepository(ctrl)
 
 		text := "testText"
 		var count uint = 5
@@ -49,35 +52,52 @@ func TestSearch(t *testing.T) {
 				Image:      "default.png",
 			},
 		}
+		lyricsRes := []models.TrackSearch{
+			{
+				TrackID:    "534",
+				TrackName:  "song",
+				ArtistName: "artisttest",
+				ArtistID:   "234234",
+				Image:      "default.png",
+				Lyrics:     "some testText line",
+			},
+		}
 
 		artistMock.
 			EXPECT().
-			Search(text, count).
+			Search(gomock.Any(), text, count).
 			Return(artistRes, nil)
 
 		albumMock.
 			EXPECT().
-			Search(text, count).
+			Search(gomock.Any(), text, count).
 			Return(albumRes, nil)
 
 		trackMock.
 			EXPECT().
-			Search(text, count).
+			Search(gomock.Any(), text, count).
 			Return(trackRes, nil)
 
+		lyricsMock.
+			EXPECT().
+			Search(gomock.Any(), text, count).
+			Return(lyricsRes, nil)
+
 		useCase := SearchUseCase{
 			ArtistRepo: artistMock,
 			AlbumRepo:  albumMock,
 			TrackRepo:  trackMock,
+			LyricsRepo: lyricsMock,
 		}
 
-		res, err := useCase.Search(text, count)
+		res, err := useCase.Search(context.Background(), text, count)
 		assert.NoError(t, err)
 
 		result := models.SearchResult{
 			Artists: artistRes,
 			Albums:  albumRes,
 			Tracks:  trackRes,
+			Lyrics:  lyricsRes,
 		}
 
 		assert.Equal(t, res, result)
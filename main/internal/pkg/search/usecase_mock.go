@@ -5,6 +5,7 @@
 package search
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,16 +35,16 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // Search mocks base method
-func (m *MockUseCase) Search(text string, count uint) (models.SearchResult, error) {
+func (m *MockUseCase) Search(ctx context.Context, text string, count uint) (models.SearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].(models.SearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockUseCaseMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), ctx, text, count)
 }
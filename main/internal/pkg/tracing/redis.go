@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/metrics"
+	"github.com/gomodule/redigo/redis"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type redisConn struct {
+	redis.Conn
+	ctx context.Context
+}
+
+func (c redisConn) Do(command string, args ...interface{}) (interface{}, error) {
+	_, span := Tracer().Start(c.ctx, "redis "+command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemRedis, semconv.DBOperationKey.String(command)),
+	)
+	defer span.End()
+
+	start := time.Now()
+	reply, err := c.Conn.Do(command, args...)
+	metrics.ObserveRedisCommand(command, start, err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return reply, err
+}
+
+// RedisConn takes a connection from pool within ctx, every command sent through it
+// becomes a child span of the span in ctx and is timed in redis_command_duration_seconds
+func RedisConn(ctx context.Context, pool *redis.Pool) (redis.Conn, error) {
+	conn, err := pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return redisConn{Conn: conn, ctx: ctx}, nil
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func installExporter(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider("test", sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+	})
+	return exporter
+}
+
+func TestTraceID(t *testing.T) {
+	installExporter(t)
+
+	assert.Empty(t, TraceID(context.Background()))
+
+	ctx, span := Tracer().Start(context.Background(), "root")
+	defer span.End()
+	assert.Equal(t, span.SpanContext().TraceID().String(), TraceID(ctx))
+}
+
+func TestRedisConn(t *testing.T) {
+	exporter := installExporter(t)
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", mr.Addr())
+		},
+	}
+
+	ctx, root := Tracer().Start(context.Background(), "root")
+	conn, err := RedisConn(ctx, pool)
+	assert.NoError(t, err)
+	_, err = conn.Do("SET", "key", "value")
+	assert.NoError(t, err)
+	_, err = conn.Do("HGET", "key", "field")
+	assert.Error(t, err)
+	assert.NoError(t, conn.Close())
+	root.End()
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 3) {
+		return
+	}
+	assert.Equal(t, "redis SET", spans[0].Name)
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+	assert.Contains(t, spans[0].Attributes, semconv.DBSystemRedis)
+	assert.Equal(t, root.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, "redis HGET", spans[1].Name)
+	assert.Len(t, spans[1].Events, 1)
+}
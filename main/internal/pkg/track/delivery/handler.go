@@ -2,6 +2,7 @@ package delivery
 
 import (
 	"encoding/json"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	track "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
@@ -22,10 +23,9 @@ func (h *TrackHandler) GetTrack(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	trackData, err := h.TrackUC.GetTrackById(varId)
+	trackData, err := h.TrackUC.GetTrackById(r.Context(), varId)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed get trackData"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed get trackData"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -55,10 +55,9 @@ func (h *TrackHandler) GetBoundedArtistTracks(w http.ResponseWriter, r *http.Req
 		user = models.User{Id: ""}
 	}
 
-	tracks, err := h.TrackUC.GetBoundedTracksByArtistId(id, start, end, user.Id)
+	tracks, err := h.TrackUC.GetBoundedTracksByArtistId(r.Context(), id, start, end, user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -93,10 +92,9 @@ func (h *TrackHandler) GetBoundedAlbumTracks(w http.ResponseWriter, r *http.Requ
 		user = models.User{Id: ""}
 	}
 
-	tracks, err := h.TrackUC.GetBoundedTracksByAlbumId(id, start, end, user.Id)
+	tracks, err := h.TrackUC.GetBoundedTracksByAlbumId(r.Context(), id, start, end, user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -115,6 +113,43 @@ func (h *TrackHandler) GetBoundedAlbumTracks(w http.ResponseWriter, r *http.Requ
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *TrackHandler) GetBoundedGenreTracks(w http.ResponseWriter, r *http.Request) {
+	id, okId := r.Context().Value(middleware.Id).(string)
+	start, okStart := r.Context().Value(middleware.Start).(uint64)
+	end, okEnd := r.Context().Value(middleware.End).(uint64)
+
+	if !okId || !okStart || !okEnd {
+		h.Log.LogWarning(r.Context(), "track delivery", "GetBoundedGenreTracks", "failed to get vars")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		user = models.User{Id: ""}
+	}
+
+	tracks, err := h.TrackUC.GetBoundedTracksByGenre(r.Context(), id, start, end, user.Id)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Id     string         `json:"id"`
+		Tracks []models.Track `json:"tracks"`
+	}{id, tracks})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "track delivery", "GetBoundedGenreTracks", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
 func (h *TrackHandler) GetUserTracks(w http.ResponseWriter, r *http.Request) {
 	user, ok := r.Context().Value(middleware.UserKey).(models.User)
 	if !ok {
@@ -123,10 +158,9 @@ func (h *TrackHandler) GetUserTracks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tracks, err := h.TrackUC.GetUserTracks(user.Id)
+	tracks, err := h.TrackUC.GetUserTracks(r.Context(), user.Id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 
@@ -159,10 +193,9 @@ func (h *TrackHandler) RateTrack(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.TrackUC.RateTrack(user.Id, varId)
+	err := h.TrackUC.RateTrack(r.Context(), user.Id, varId)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get tracks"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 
@@ -65,7 +65,7 @@ func TestGetTrack(t *testing.T) {
 		trackHandler.TrackUC = m
 
 		m.EXPECT().
-			GetTrackById(tId).
+			GetTrackById(gomock.Any(), tId).
 			Return(testTrack, nil)
 
 		apitest.New("GetTrack-OK").
@@ -101,7 +101,7 @@ func TestGetTrack(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetTrackById(tId).
+			GetTrackById(gomock.Any(), tId).
 			Return(models.Track{}, testError)
 
 		apitest.New("GetTrack-UseCaseError").
@@ -109,7 +109,7 @@ func TestGetTrack(t *testing.T) {
 			Method("Get").
 			URL("/api/v1/tracks/2123").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -137,7 +137,7 @@ func TestGetBoundedArtistTracks(t *testing.T) {
 		var endUint uint64 = 2
 
 		m.EXPECT().
-			GetBoundedTracksByArtistId(artistId, startUint, endUint, "").
+			GetBoundedTracksByArtistId(gomock.Any(), artistId, startUint, endUint, "").
 			Return(tracksArray, nil)
 
 		str := fmt.Sprintf(`{"id":"%v","tracks":%v}`, artistId, string(tracksMarshal))
@@ -180,14 +180,14 @@ func TestGetBoundedArtistTracks(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetBoundedTracksByArtistId(artistId, startUint, endUint, "").
+			GetBoundedTracksByArtistId(gomock.Any(), artistId, startUint, endUint, "").
 			Return([]models.Track{}, testError)
 
 		apitest.New("GetBoundedArtistTracks-UseCaseError").
 			Handler(vars).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -215,7 +215,7 @@ func TestGetBoundedAlbumTracks(t *testing.T) {
 		var endUint uint64 = 2
 
 		m.EXPECT().
-			GetBoundedTracksByAlbumId(artistId, startUint, endUint, "").
+			GetBoundedTracksByAlbumId(gomock.Any(), artistId, startUint, endUint, "").
 			Return(tracksArray, nil)
 
 		str := fmt.Sprintf(`{"id":"%v","tracks":%v}`, artistId, string(tracksMarshal))
@@ -258,14 +258,14 @@ func TestGetBoundedAlbumTracks(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetBoundedTracksByAlbumId(artistId, startUint, endUint, "").
+			GetBoundedTracksByAlbumId(gomock.Any(), artistId, startUint, endUint, "").
 			Return([]models.Track{}, testError)
 
 		apitest.New("GetBoundedAlbumTracks-UseCaseError").
 			Handler(vars).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -295,7 +295,7 @@ func TestGetUserTracks(t *testing.T) {
 		handler := middleware.AuthMiddlewareMock(trackHandler.GetUserTracks, true, testUser, "")
 
 		m.EXPECT().
-			GetUserTracks(testUser.Id).
+			GetUserTracks(gomock.Any(), testUser.Id).
 			Return(tracksArray, nil)
 
 		str := fmt.Sprintf(`{"tracks":%v}`, string(tracksMarshal))
@@ -330,14 +330,14 @@ func TestGetUserTracks(t *testing.T) {
 		handler := middleware.AuthMiddlewareMock(trackHandler.GetUserTracks, true, testUser, "")
 
 		m.EXPECT().
-			GetUserTracks(testUser.Id).
+			GetUserTracks(gomock.Any(), testUser.Id).
 			Return([]models.Track{}, testError)
 
 		apitest.New("GetUserTracks-Error").
 			Handler(handler).
 			Method("Get").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -359,7 +359,7 @@ func TestRateTrack(t *testing.T) {
 			"")
 
 		m.EXPECT().
-			RateTrack(testUser.Id, testTrack.Id).
+			RateTrack(gomock.Any(), testUser.Id, testTrack.Id).
 			Return(nil)
 
 		apitest.New("RateTrack-OK").
@@ -418,14 +418,54 @@ func TestRateTrack(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			RateTrack(testUser.Id, testTrack.Id).
+			RateTrack(gomock.Any(), testUser.Id, testTrack.Id).
 			Return(testError)
 
 		apitest.New("RateTrack-Error").
 			Handler(handler).
 			Method("POST").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestGetBoundedGenreTracks(t *testing.T) {
+	t.Run("GetBoundedGenreTracks-OK", func(t *testing.T) {
+		genreId := "3"
+
+		boundedVars := middleware.BoundedVars(trackHandler.GetBoundedGenreTracks, trackHandler.Log)
+		vars := middleware.SetTripleVars(boundedVars, genreId, "0", "2")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := track.NewMockUseCase(ctrl)
+		trackHandler.TrackUC = m
+
+		tracksArray := []models.Track{testTrack, testTrack2}
+		tracksMarshal, err := json.Marshal(tracksArray)
+		assert.NoError(t, err)
+
+		m.EXPECT().
+			GetBoundedTracksByGenre(gomock.Any(), genreId, uint64(0), uint64(2), "").
+			Return(tracksArray, nil)
+
+		apitest.New("GetBoundedGenreTracks-OK").
+			Handler(vars).
+			Method("Get").
+			Expect(t).
+			Body(fmt.Sprintf(`{"id":"%v","tracks":%v}`, genreId, string(tracksMarshal))).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetBoundedGenreTracks-NoVars", func(t *testing.T) {
+		apitest.New("GetBoundedGenreTracks-NoVars").
+			Handler(http.HandlerFunc(trackHandler.GetBoundedGenreTracks)).
+			Method("Get").
+			Expect(t).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
@@ -1,14 +1,23 @@
 package track
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type Repository interface {
-	GetTrackById(id string) (models.Track, error)
-	GetBoundedTracksByPlaylistId(plId string, start, end uint64) ([]models.Track, error)
-	GetBoundedTracksByAlbumId(aId string, start, end uint64) ([]models.Track, error)
-	GetBoundedTracksByArtistId(id string, start, end uint64) ([]models.Track, error)
-	Search(text string, count uint) ([]models.TrackSearch, error)
-	GetUserTracks(uID string) ([]models.Track, error)
-	GetUserLikedTracksIDs(uID string) ([]int64, error)
-	RateTrack(uID string, tID string) error
+	GetTrackById(ctx context.Context, id string) (models.Track, error)
+	GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64) ([]models.Track, error)
+	GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64) ([]models.Track, error)
+	GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Track, error)
+	GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Track, error)
+	GetSimilarTracks(ctx context.Context, tID string, exclude []string, count uint64) ([]models.Track, error)
+	Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error)
+	GetUserTracks(ctx context.Context, uID string) ([]models.Track, error)
+	CheckLikes(ctx context.Context, uID string, tIDs []string) (map[string]bool, error)
+	RateTrack(ctx context.Context, uID string, tID string) error
+	CreateTrack(ctx context.Context, track models.Track) (string, error)
+	UpdateTrack(ctx context.Context, track models.Track) error
+	SetTrackArtists(ctx context.Context, tID string, credits []models.ArtistCredit) error
+	DeleteTrack(ctx context.Context, id string) error
 }
@@ -1,20 +1,39 @@
 package repository
 
 import (
+	"context"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"strconv"
+	"time"
 )
 
 type Tracks struct {
-	Id       uint64 `gorm:"column:track_id"`
-	Name     string `gorm:"column:track_name"`
-	Artist   string `gorm:"column:artist_name"`
-	ArtistID uint64 `gorm:"column:artist_id"`
-	Duration uint   `gorm:"column:duration"`
-	Image    string `gorm:"column:track_image"`
-	Link     string `gorm:"column:link"`
+	Id          uint64         `gorm:"column:track_id"`
+	Name        string         `gorm:"column:track_name"`
+	Artist      string         `gorm:"column:artist_name"`
+	ArtistID    uint64         `gorm:"column:artist_id"`
+	Duration    uint           `gorm:"column:duration"`
+	Image       string         `gorm:"column:track_image"`
+	Link        string         `gorm:"column:link"`
+	CreditIds   pq.Int64Array  `gorm:"column:credit_ids"`
+	CreditNames pq.StringArray `gorm:"column:credit_names"`
+	CreditRoles pq.StringArray `gorm:"column:credit_roles"`
+}
+
+// TrackRecord is a row of the tracks table, Tracks is a row of the track views
+type TrackRecord struct {
+	Id        uint64     `gorm:"column:id"`
+	Name      string     `gorm:"column:name"`
+	Duration  uint       `gorm:"column:duration"`
+	Image     string     `gorm:"column:image"`
+	Link      string     `gorm:"column:link"`
+	ArtistID  uint64     `gorm:"column:artist_id"`
+	DeletedAt *time.Time `gorm:"column:deleted_at"`
 }
 
 type DbTrackRepository struct {
@@ -46,31 +65,33 @@ func toModel(dbTrack Tracks) models.Track {
 		Duration: dbTrack.Duration,
 		Image:    dbTrack.Image,
 		Link:     dbTrack.Link,
+		Artists:  models.NewArtistCredits(dbTrack.CreditIds, dbTrack.CreditNames, dbTrack.CreditRoles),
 	}
 }
 
-func (tr *DbTrackRepository) GetTrackById(id string) (models.Track, error) {
+func (tr *DbTrackRepository) GetTrackById(ctx context.Context, id string) (models.Track, error) {
 	var track Tracks
 
-	db := tr.db.
+	db := database.WithContext(ctx, tr.db).
 		Table("full_track_info").
 		Where("track_id = ?", id).
 		Find(&track)
 
 	err := db.Error
 	if err != nil {
-		return models.Track{}, fmt.Errorf("query error: %v", err)
+		return models.Track{}, fmt.Errorf("query error: %w", err)
 	}
 	return toModel(track), nil
 }
 
-func (tr *DbTrackRepository) GetBoundedTracksByArtistId(id string, start, end uint64) ([]models.Track, error) {
+func (tr *DbTrackRepository) GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Track, error) {
 	var tracks []Tracks
 	limit := end - start
 
-	db := tr.db.
+	db := database.WithContext(ctx, tr.db).
 		Table("full_track_info").
-		Where("artist_id = ?", id).
+		Where("track_id IN (SELECT track_ID FROM track_artists WHERE artist_ID = ? AND role IN (?))",
+			id, []string{models.CreditPrimary, models.CreditFeatured}).
 		Order("track_name").
 		Limit(limit).
 		Offset(start).
@@ -78,7 +99,7 @@ func (tr *DbTrackRepository) GetBoundedTracksByArtistId(id string, start, end ui
 
 	err := db.Error
 	if err != nil {
-		return nil, fmt.Errorf("query error: %v", err)
+		return nil, fmt.Errorf("query error: %w", err)
 	}
 
 	modTracks := make([]models.Track, len(tracks))
@@ -88,11 +109,11 @@ func (tr *DbTrackRepository) GetBoundedTracksByArtistId(id string, start, end ui
 	return modTracks, nil
 }
 
-func (tr *DbTrackRepository) GetBoundedTracksByPlaylistId(plId string, start, end uint64) ([]models.Track, error) {
+func (tr *DbTrackRepository) GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64) ([]models.Track, error) {
 	var tracks []Tracks
 	limit := end - start
 
-	db := tr.db.
+	db := database.WithContext(ctx, tr.db).
 		Table("tracks_in_playlist").
 		Where("playlist_id = ?", plId).
 		Order("index").
@@ -102,7 +123,7 @@ func (tr *DbTrackRepository) GetBoundedTracksByPlaylistId(plId string, start, en
 
 	err := db.Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to select query: %e", err)
+		return nil, fmt.Errorf("failed to select query: %w", err)
 	}
 
 	modTracks := make([]models.Track, len(tracks))
@@ -112,11 +133,11 @@ func (tr *DbTrackRepository) GetBoundedTracksByPlaylistId(plId string, start, en
 	return modTracks, nil
 }
 
-func (tr *DbTrackRepository) GetBoundedTracksByAlbumId(aId string, start, end uint64) ([]models.Track, error) {
+func (tr *DbTrackRepository) GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64) ([]models.Track, error) {
 	var tracks []Tracks
 	limit := end - start
 
-	db := tr.db.
+	db := database.WithContext(ctx, tr.db).
 		Table("tracks_in_album").
 		Where("album_id = ?", aId).
 		Order("index").
@@ -126,7 +147,7 @@ func (tr *DbTrackRepository) GetBoundedTracksByAlbumId(aId string, start, end ui
 
 	err := db.Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to select query: %e", err)
+		return nil, fmt.Errorf("failed to select query: %w", err)
 	}
 
 	modTracks := make([]models.Track, len(tracks))
@@ -136,17 +157,83 @@ func (tr *DbTrackRepository) GetBoundedTracksByAlbumId(aId string, start, end ui
 	return modTracks, nil
 }
 
-func (tr *DbTrackRepository) Search(text string, count uint) ([]models.TrackSearch, error) {
+// GetBoundedTracksByGenre includes tracks of all subgenres and tracks of albums tagged with them
+func (tr *DbTrackRepository) GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Track, error) {
 	var tracks []Tracks
+	limit := end - start
 
-	db := tr.db.
+	db := database.WithContext(ctx, tr.db).
+		Table("full_track_info").
+		Where("track_id IN (SELECT track_id FROM genre_tracks WHERE genre_id = ?)", gID).
+		Order("track_name").
+		Limit(limit).
+		Offset(start).
+		Find(&tracks)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get genre tracks: %w", err)
+	}
+
+	modTracks := make([]models.Track, len(tracks))
+	for i, elem := range tracks {
+		modTracks[i] = toModel(elem)
+	}
+	return modTracks, nil
+}
+
+// similarTracksQuery picks tracks of the same artist or sharing a genre tag with the track or its artist.
+// Instead of sorting every candidate randomly it walks them in id order from a random id and wraps around,
+// %[1]s takes the exclude filter
+const similarTracksQuery = `WITH source_track AS (SELECT ID, artist_ID FROM tracks WHERE ID = ?),
+pivot AS (SELECT floor(random() * (max(ID) + 1))::bigint AS id FROM tracks),
+similar_genres AS (SELECT gt.genre_id FROM genre_tracks gt JOIN source_track s ON gt.track_id = s.ID
+UNION SELECT ga.genre_id FROM genre_artists ga JOIN source_track s ON ga.artist_id = s.artist_ID)
+(SELECT t.* FROM full_track_info t, source_track s, pivot p WHERE t.track_id >= p.id%[1]s
+AND (t.artist_id = s.artist_ID OR t.track_id IN (SELECT track_id FROM genre_tracks WHERE genre_id IN (SELECT genre_id FROM similar_genres)))
+ORDER BY t.track_id LIMIT ?)
+UNION ALL
+(SELECT t.* FROM full_track_info t, source_track s, pivot p WHERE t.track_id < p.id%[1]s
+AND (t.artist_id = s.artist_ID OR t.track_id IN (SELECT track_id FROM genre_tracks WHERE genre_id IN (SELECT genre_id FROM similar_genres)))
+ORDER BY t.track_id LIMIT ?)
+LIMIT ?`
+
+// GetSimilarTracks returns tracks of the same artist or the same genres as the track, starting from a random one
+func (tr *DbTrackRepository) GetSimilarTracks(ctx context.Context, tID string, exclude []string, count uint64) ([]models.Track, error) {
+	var tracks []Tracks
+
+	filter := ""
+	args := []interface{}{tID, count, count, count}
+	if len(exclude) > 0 {
+		filter = " AND t.track_id NOT IN (?)"
+		args = []interface{}{tID, exclude, count, exclude, count, count}
+	}
+
+	db := database.WithContext(ctx, tr.db).
+		Raw(fmt.Sprintf(similarTracksQuery, filter), args...).
+		Scan(&tracks)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get similar tracks: %w", err)
+	}
+
+	modTracks := make([]models.Track, len(tracks))
+	for i, elem := range tracks {
+		modTracks[i] = toModel(elem)
+	}
+	return modTracks, nil
+}
+
+func (tr *DbTrackRepository) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
+	var tracks []Tracks
+
+	db := database.WithContext(ctx, tr.db).
 		Table("full_track_info").
 		Where("track_name ILIKE ?", "%"+text+"%").
 		Limit(count).
 		Find(&tracks)
 
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to search tracks: %v", err)
+		return nil, fmt.Errorf("failed to search tracks: %w", err)
 	}
 
 	trackSearch := make([]models.TrackSearch, len(tracks))
@@ -156,70 +243,176 @@ func (tr *DbTrackRepository) Search(text string, count uint) ([]models.TrackSear
 	return trackSearch, nil
 }
 
-func (tr *DbTrackRepository) GetUserTracks(uID string) ([]models.Track, error) {
-	var tracks []models.Track
+func (tr *DbTrackRepository) GetUserTracks(ctx context.Context, uID string) ([]models.Track, error) {
+	var tracks []Tracks
+
+	db := database.WithContext(ctx, tr.db).
+		Table("liked_tracks l").
+		Select("t.*").
+		Joins("JOIN full_track_info t ON t.track_id = l.track_ID").
+		Where("l.user_ID = ?", uID).
+		Order("l.liked_at desc, l.track_ID desc").
+		Find(&tracks)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get user tracks: %w", err)
+	}
+
+	modTracks := make([]models.Track, len(tracks))
+	for i, elem := range tracks {
+		modTracks[i] = toModel(elem)
+		modTracks[i].IsLiked = true
+	}
+	return modTracks, nil
+}
+
+// CheckLikes returns the subset of tIDs liked by the user, the lookup only touches the given ids,
+// so it doesn't depend on how many tracks the user has liked
+func (tr *DbTrackRepository) CheckLikes(ctx context.Context, uID string, tIDs []string) (map[string]bool, error) {
+	liked := make(map[string]bool)
+	if len(tIDs) == 0 {
+		return liked, nil
+	}
+
+	var dbLikes []struct {
+		ID uint64 `gorm:"column:track_id"`
+	}
+	db := database.WithContext(ctx, tr.db).Raw("select track_ID from liked_tracks where user_ID = ? and track_ID in (?)", uID, tIDs).Scan(&dbLikes)
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to check likes: %w", err)
+	}
 
-	db := tr.db.Raw(
-		"with liked as (select unnest(liked_tracks) as liked_id from users where id = ?)"+
-			"select row_number() over () as index,"+
-			" t.ID as id,"+
-			" t.name as name,"+
-			" a.name as artist,"+
-			" t.duration as duration,"+
-			" t.image as image,"+
-			" artist_id as artist_id,"+
-			" t.link as link"+
-			" from liked join tracks as t on liked.liked_id = t.ID join artists as a on t.artist_id = a.ID"+
-			" order by index desc", uID).Scan(&tracks)
+	for _, elem := range dbLikes {
+		liked[strconv.FormatUint(elem.ID, 10)] = true
+	}
+	return liked, nil
+}
 
+// RateTrack toggles the like, removing a like that exists and setting it otherwise
+func (tr *DbTrackRepository) RateTrack(ctx context.Context, uID string, tID string) error {
+	db := database.WithContext(ctx, tr.db).Exec("delete from liked_tracks where user_ID = ? and track_ID = ?", uID, tID)
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to get user tracks: %v", err)
+		return fmt.Errorf("failed to delete like: %w", err)
 	}
-	for i := range tracks {
-		tracks[i].IsLiked = true
+	if db.RowsAffected > 0 {
+		return nil
 	}
 
-	return tracks, nil
+	db = database.WithContext(ctx, tr.db).Exec("insert into liked_tracks (user_ID, track_ID) values (?, ?) on conflict do nothing", uID, tID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to set like: %w", err)
+	}
+
+	return nil
 }
 
-func (tr *DbTrackRepository) GetUserLikedTracksIDs(uID string) ([]int64, error) {
-	var dbTracks []struct {
-		ID int64 `gorm:"column:liked_id"`
+// checkArtist makes sure tracks are not attached to deleted artists,
+// missing ones are rejected by the foreign key anyway
+func (tr *DbTrackRepository) checkArtist(ctx context.Context, artistID string) error {
+	var artist struct {
+		Id uint64 `gorm:"column:id"`
 	}
 
-	db := tr.db.Raw("select unnest(liked_tracks) as liked_id from users where id = ?", uID).Scan(&dbTracks)
+	db := database.WithContext(ctx, tr.db).
+		Table("artists").
+		Select("id").
+		Where("id = ? and deleted_at is null", artistID).
+		Find(&artist)
+
 	if err := db.Error; err != nil {
-		return nil, fmt.Errorf("failed to get user liked tracks: %v", err)
+		return fmt.Errorf("failed to get artist: %w", err)
+	}
+	return nil
+}
+
+func (tr *DbTrackRepository) CreateTrack(ctx context.Context, track models.Track) (string, error) {
+	artistID, err := strconv.ParseUint(track.ArtistID, 10, 64)
+	if err != nil {
+		return "", apperrors.Errorf(apperrors.Validation, "failed to parse artist id: %w", err)
+	}
+	if err := tr.checkArtist(ctx, track.ArtistID); err != nil {
+		return "", err
 	}
 
-	tracks := make([]int64, len(dbTracks))
-	for i, elem := range dbTracks {
-		tracks[i] = elem.ID
+	dbTrack := TrackRecord{
+		Name:     track.Name,
+		Duration: track.Duration,
+		Image:    track.Image,
+		Link:     track.Link,
+		ArtistID: artistID,
 	}
-	return tracks, nil
+
+	db := database.WithContext(ctx, tr.db).Table("tracks")
+	if dbTrack.Image == "" {
+		db = db.Omit("image")
+	}
+	if err := db.Create(&dbTrack).Error; err != nil {
+		return "", fmt.Errorf("failed to create track: %w", err)
+	}
+	return strconv.FormatUint(dbTrack.Id, 10), nil
 }
 
-func (tr *DbTrackRepository) RateTrack(uID string, tID string) error {
-	var isLiked struct {
-		IsLiked bool `gorm:"column:is_liked"`
+func (tr *DbTrackRepository) UpdateTrack(ctx context.Context, track models.Track) error {
+	if err := tr.checkArtist(ctx, track.ArtistID); err != nil {
+		return err
 	}
 
-	db := tr.db.Raw("select ? = any(liked_tracks) as is_liked from users where id = ?", tID, uID).Scan(&isLiked)
+	db := database.WithContext(ctx, tr.db).Exec("update tracks set name = ?, duration = ?, image = coalesce(nullif(?, ''), image), "+
+		"link = ?, artist_id = ? where id = ? and deleted_at is null",
+		track.Name, track.Duration, track.Image, track.Link, track.ArtistID, track.Id)
 	if err := db.Error; err != nil {
-		return fmt.Errorf("failed to check like: %v", err)
+		return fmt.Errorf("failed to update track: %w", err)
 	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "track not found")
+	}
+	return nil
+}
 
-	if isLiked.IsLiked {
-		db = tr.db.Exec("update users set liked_tracks = array_remove(liked_tracks, ?::INTEGER) where id = ?", tID, uID)
-		if err := db.Error; err != nil {
-			return fmt.Errorf("failed to delete like: %v", err)
-		}
-	} else {
-		db = tr.db.Exec("update users set liked_tracks = liked_tracks || ?::INTEGER where id = ?", tID, uID)
+// SetTrackArtists replaces featured and producer credits of the track,
+// the primary credit follows tracks.artist_id
+func (tr *DbTrackRepository) SetTrackArtists(ctx context.Context, tID string, credits []models.ArtistCredit) error {
+	tx := database.WithContext(ctx, tr.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// locking the track keeps it from being deleted while its credits are rewritten
+	db := tx.Exec("select id from tracks where id = ? and deleted_at is null for update", tID)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to lock track: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.NotFound, "track not found")
+	}
+
+	db = tx.Exec("delete from track_artists where track_id = ? and role <> ?", tID, models.CreditPrimary)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear track artists: %w", err)
+	}
+
+	for i, elem := range credits {
+		db = tx.Exec("insert into track_artists (track_id, artist_id, role, position) values (?, ?, ?, ?)",
+			tID, elem.Id, elem.Role, i+1)
 		if err := db.Error; err != nil {
-			return fmt.Errorf("failed to set like: %v", err)
+			tx.Rollback()
+			return fmt.Errorf("failed to insert track artist: %w", err)
 		}
 	}
 
+	return tx.Commit().Error
+}
+
+func (tr *DbTrackRepository) DeleteTrack(ctx context.Context, id string) error {
+	db := database.WithContext(ctx, tr.db).Exec("update tracks set deleted_at = now() where id = ? and deleted_at is null", id)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to delete track: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "track not found")
+	}
 	return nil
 }
@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-test/deep"
@@ -78,10 +80,12 @@ func (s *Suite) TestGetTrackByID() {
 
 	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "full_track_info" WHERE (track_id = $1)`)).
 		WithArgs(testTrack.Id).
-		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "duration", "link", "artist_id"}).
-			AddRow(testTrack.Id, testTrack.Name, testTrack.Artist, testTrack.Duration, testTrack.Link, testTrack.ArtistID))
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "duration", "link", "artist_id",
+			"credit_ids", "credit_names", "credit_roles"}).
+			AddRow(testTrack.Id, testTrack.Name, testTrack.Artist, testTrack.Duration, testTrack.Link, testTrack.ArtistID,
+				"{41342,7}", `{artist-name,"feat artist"}`, "{primary,featured}"))
 
-	res, err := s.repository.GetTrackById(testTrack.Id)
+	res, err := s.repository.GetTrackById(context.Background(), testTrack.Id)
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(models.Track{
@@ -91,6 +95,10 @@ func (s *Suite) TestGetTrackByID() {
 		Duration: testTrack.Duration,
 		Link:     testTrack.Link,
 		ArtistID: testTrack.ArtistID,
+		Artists: []models.ArtistCredit{
+			{Id: testTrack.ArtistID, Name: testTrack.Artist, Role: models.CreditPrimary},
+			{Id: "7", Name: "feat artist", Role: models.CreditFeatured},
+		},
 	}, res))
 
 	//test on db error
@@ -98,7 +106,7 @@ func (s *Suite) TestGetTrackByID() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(testTrack.Id).WillReturnError(dbError)
 
-	_, err = s.repository.GetTrackById(testTrack.Id)
+	_, err = s.repository.GetTrackById(context.Background(), testTrack.Id)
 
 	require.Error(s.T(), err)
 }
@@ -117,7 +125,7 @@ func (s *Suite) TestGetBoundedPlaylistTracks() {
 			AddRow(tr2.Id, tr2.Name, tr2.Artist, tr2.Duration, tr2.Link, tr2.ArtistID).
 			AddRow(tr3.Id, tr3.Name, tr3.Artist, tr3.Duration, tr3.Link, tr3.ArtistID))
 
-	res, err := s.repository.GetBoundedTracksByPlaylistId(plId, 0, 3)
+	res, err := s.repository.GetBoundedTracksByPlaylistId(context.Background(), plId, 0, 3)
 
 	require.NoError(s.T(), err)
 	for i, elem := range res {
@@ -129,7 +137,7 @@ func (s *Suite) TestGetBoundedPlaylistTracks() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(plId).WillReturnError(dbError)
 
-	_, err = s.repository.GetBoundedTracksByPlaylistId(plId, 0, 3)
+	_, err = s.repository.GetBoundedTracksByPlaylistId(context.Background(), plId, 0, 3)
 
 	require.Error(s.T(), err)
 }
@@ -145,7 +153,7 @@ func (s *Suite) TestGetAlbumTracks() {
 		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "duration", "link", "artist_id"}).
 			AddRow(tr1.Id, tr1.Name, tr1.Artist, tr1.Duration, tr1.Link, tr2.ArtistID).AddRow(tr2.Id, tr2.Name, tr2.Artist, tr2.Duration, tr2.Link, tr2.ArtistID))
 
-	res, err := s.repository.GetBoundedTracksByAlbumId(aId, 0, 2)
+	res, err := s.repository.GetBoundedTracksByAlbumId(context.Background(), aId, 0, 2)
 
 	require.NoError(s.T(), err)
 	for i, elem := range res {
@@ -157,7 +165,7 @@ func (s *Suite) TestGetAlbumTracks() {
 	s.mock.ExpectQuery("SELECT").
 		WithArgs(aId).WillReturnError(dbError)
 
-	_, err = s.repository.GetBoundedTracksByAlbumId(aId, 0, 2)
+	_, err = s.repository.GetBoundedTracksByAlbumId(context.Background(), aId, 0, 2)
 
 	require.Error(s.T(), err)
 }
@@ -168,13 +176,14 @@ func (s *Suite) TestGetBoundedTracksByArtistId() {
 	tr1 := s.tracks[0]
 	tr2 := s.tracks[1]
 
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "full_track_info"`)).
-		WithArgs(aId).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "full_track_info" WHERE (track_id IN `+
+		`(SELECT track_ID FROM track_artists WHERE artist_ID = $1 AND role IN ($2,$3))) ORDER BY track_name`)).
+		WithArgs(aId, models.CreditPrimary, models.CreditFeatured).
 		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "artist_id", "duration", "track_image", "link"}).
 			AddRow(tr1.Id, tr1.Name, tr1.Artist, tr2.ArtistID, tr1.Duration, tr1.Image, tr1.Link).
 			AddRow(tr2.Id, tr2.Name, tr2.Artist, tr2.ArtistID, tr2.Duration, tr2.Image, tr2.Link))
 
-	res, err := s.repository.GetBoundedTracksByArtistId(aId, 0, 2)
+	res, err := s.repository.GetBoundedTracksByArtistId(context.Background(), aId, 0, 2)
 
 	require.NoError(s.T(), err)
 	for i, elem := range res {
@@ -184,10 +193,31 @@ func (s *Suite) TestGetBoundedTracksByArtistId() {
 	//test on db error
 	dbError := errors.New("db_error")
 	s.mock.ExpectQuery("SELECT").
-		WithArgs(aId).WillReturnError(dbError)
+		WithArgs(aId, models.CreditPrimary, models.CreditFeatured).WillReturnError(dbError)
+
+	_, err = s.repository.GetBoundedTracksByArtistId(context.Background(), aId, 0, 2)
+
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestGetSimilarTracks() {
+	tID := "12345"
+	tr := s.tracks[1]
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.track_id >= p.id AND t.track_id NOT IN ($2,$3)`)).
+		WithArgs(tID, tID, "2452345", 5, tID, "2452345", 5, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "artist_id", "duration", "track_image", "link"}).
+			AddRow(tr.Id, tr.Name, tr.Artist, tr.ArtistID, tr.Duration, tr.Image, tr.Link))
 
-	_, err = s.repository.GetBoundedTracksByArtistId(aId, 0, 2)
+	res, err := s.repository.GetSimilarTracks(context.Background(), tID, []string{tID, "2452345"}, 5)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.Track{tr}, res))
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.track_id < p.id AND (t.artist_id = s.artist_ID`)).
+		WithArgs(tID, 5, 5, 5).
+		WillReturnError(errors.New("db_error"))
 
+	_, err = s.repository.GetSimilarTracks(context.Background(), tID, nil, 5)
 	require.Error(s.T(), err)
 }
 
@@ -213,7 +243,7 @@ func (s *Suite) TestSearch() {
 		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "artist_id", "track_image"}).
 			AddRow(trackID, search[0].TrackName, search[0].ArtistName, artistID, search[0].Image))
 
-	res, err := s.repository.Search(text, uint(count))
+	res, err := s.repository.Search(context.Background(), text, uint(count))
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(search, res))
@@ -223,7 +253,7 @@ func (s *Suite) TestSearch() {
 	s.mock.ExpectQuery("SELECT").
 		WillReturnError(dbError)
 
-	_, err = s.repository.Search(text, uint(count))
+	_, err = s.repository.Search(context.Background(), text, uint(count))
 
 	require.Error(s.T(), err)
 }
@@ -232,9 +262,12 @@ func (s *Suite) TestGetUserTracks() {
 	uID := "23423"
 	tracks := []models.Track{s.tracks[0]}
 
-	s.mock.ExpectQuery("").
+	//the latest like goes first, ties are broken by the track id
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT t.* FROM liked_tracks l JOIN full_track_info t ON t.track_id = l.track_ID ` +
+		`WHERE (l.user_ID = $1) ORDER BY l.liked_at desc, l.track_ID desc`)).
 		WithArgs(uID).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "artist", "duration", "image", "artist_id", "link"}).
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "duration", "track_image", "artist_id", "link",
+			"credit_ids", "credit_names", "credit_roles"}).
 			AddRow(tracks[0].Id,
 				tracks[0].Name,
 				tracks[0].Artist,
@@ -242,11 +275,18 @@ func (s *Suite) TestGetUserTracks() {
 				tracks[0].Image,
 				tracks[0].ArtistID,
 				tracks[0].Link,
+				"{41342,7}",
+				`{artist-name,"feat artist"}`,
+				"{primary,featured}",
 			))
 
-	res, err := s.repository.GetUserTracks(uID)
+	res, err := s.repository.GetUserTracks(context.Background(), uID)
 
 	tracks[0].IsLiked = true
+	tracks[0].Artists = []models.ArtistCredit{
+		{Id: tracks[0].ArtistID, Name: tracks[0].Artist, Role: models.CreditPrimary},
+		{Id: "7", Name: "feat artist", Role: models.CreditFeatured},
+	}
 
 	require.NoError(s.T(), err)
 	require.Nil(s.T(), deep.Equal(tracks, res))
@@ -256,7 +296,236 @@ func (s *Suite) TestGetUserTracks() {
 	s.mock.ExpectQuery("").
 		WillReturnError(dbError)
 
-	_, err = s.repository.GetUserTracks(uID)
+	_, err = s.repository.GetUserTracks(context.Background(), uID)
+
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestCreateTrack() {
+	track := s.tracks[0]
+	track.Image = "/static/img/track/test.png"
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM "artists" WHERE (id = $1 and deleted_at is null)`)).
+		WithArgs(track.ArtistID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(track.ArtistID))
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(`INSERT INTO "tracks"`).
+		WithArgs(track.Name, track.Duration, track.Image, track.Link, sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(track.Id))
+	s.mock.ExpectCommit()
+
+	id, err := s.repository.CreateTrack(context.Background(), track)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), track.Id, id)
+
+	//test on default image
+	track.Image = ""
+
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(track.ArtistID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(track.ArtistID))
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "tracks" ("name","duration","link","artist_id","deleted_at")`)).
+		WithArgs(track.Name, track.Duration, track.Link, sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(track.Id))
+	s.mock.ExpectCommit()
+
+	_, err = s.repository.CreateTrack(context.Background(), track)
+	require.NoError(s.T(), err)
+
+	//test on deleted artist
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(track.ArtistID).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err = s.repository.CreateTrack(context.Background(), track)
+	require.True(s.T(), apperrors.Is(err, apperrors.NotFound))
 
+	//test on wrong artist id
+	track.ArtistID = "artist"
+
+	_, err = s.repository.CreateTrack(context.Background(), track)
 	require.Error(s.T(), err)
 }
+
+func (s *Suite) TestUpdateTrack() {
+	track := s.tracks[1]
+	artistRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id"}).AddRow(track.ArtistID)
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM "artists" WHERE (id = $1 and deleted_at is null)`)).
+		WithArgs(track.ArtistID).
+		WillReturnRows(artistRows())
+	s.mock.ExpectExec("update tracks set name").
+		WithArgs(track.Name, track.Duration, track.Image, track.Link, track.ArtistID, track.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.UpdateTrack(context.Background(), track))
+
+	//test on not found
+	s.mock.ExpectQuery("SELECT id").
+		WillReturnRows(artistRows())
+	s.mock.ExpectExec("update tracks set name").
+		WithArgs(track.Name, track.Duration, track.Image, track.Link, track.ArtistID, track.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.UpdateTrack(context.Background(), track))
+
+	//test on deleted artist
+	s.mock.ExpectQuery("SELECT id").
+		WithArgs(track.ArtistID).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	require.True(s.T(), apperrors.Is(s.repository.UpdateTrack(context.Background(), track), apperrors.NotFound))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT id").
+		WillReturnRows(artistRows())
+	s.mock.ExpectExec("update tracks set name").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.UpdateTrack(context.Background(), track))
+}
+
+func (s *Suite) TestSetTrackArtists() {
+	tID := s.tracks[0].Id
+	credits := []models.ArtistCredit{
+		{Id: "7", Role: models.CreditFeatured},
+		{Id: "8", Role: models.CreditProducer},
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from tracks").
+		WithArgs(tID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from track_artists").
+		WithArgs(tID, models.CreditPrimary).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	for i, elem := range credits {
+		s.mock.ExpectExec("insert into track_artists").
+			WithArgs(tID, elem.Id, elem.Role, i+1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.SetTrackArtists(context.Background(), tID, credits))
+
+	//test on insert error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from tracks").
+		WithArgs(tID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from track_artists").
+		WithArgs(tID, models.CreditPrimary).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("insert into track_artists").
+		WillReturnError(errors.New("fk violation"))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.SetTrackArtists(context.Background(), tID, credits))
+
+	//test on deleted track
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("select id from tracks").
+		WithArgs(tID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectRollback()
+
+	err := s.repository.SetTrackArtists(context.Background(), tID, credits)
+	require.Error(s.T(), err)
+	require.True(s.T(), apperrors.Is(err, apperrors.NotFound))
+}
+
+func (s *Suite) TestDeleteTrack() {
+	id := s.tracks[2].Id
+
+	s.mock.ExpectExec("update tracks set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.DeleteTrack(context.Background(), id))
+
+	//test on already deleted
+	s.mock.ExpectExec("update tracks set deleted_at").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.DeleteTrack(context.Background(), id))
+}
+
+func (s *Suite) TestGetBoundedTracksByGenre() {
+	tr := s.tracks[0]
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "full_track_info" WHERE ` +
+		`(track_id IN (SELECT track_id FROM genre_tracks WHERE genre_id = $1)) ORDER BY track_name LIMIT 3 OFFSET 0`)).
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"track_id", "track_name", "artist_name", "duration", "link", "artist_id"}).
+			AddRow(tr.Id, tr.Name, tr.Artist, tr.Duration, tr.Link, tr.ArtistID))
+
+	res, err := s.repository.GetBoundedTracksByGenre(context.Background(), "3", 0, 3)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal([]models.Track{tr}, res))
+
+	//test on db error
+	s.mock.ExpectQuery("SELECT").
+		WithArgs("3").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.GetBoundedTracksByGenre(context.Background(), "3", 0, 3)
+
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestCheckLikes() {
+	uID := "23423"
+	tIDs := []string{s.tracks[0].Id, s.tracks[1].Id}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta("select track_ID from liked_tracks where user_ID = $1 and track_ID in ($2,$3)")).
+		WithArgs(uID, tIDs[0], tIDs[1]).
+		WillReturnRows(sqlmock.NewRows([]string{"track_id"}).AddRow(tIDs[1]))
+
+	liked, err := s.repository.CheckLikes(context.Background(), uID, tIDs)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), map[string]bool{tIDs[1]: true}, liked)
+
+	//no ids, no query
+	liked, err = s.repository.CheckLikes(context.Background(), uID, nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), liked)
+
+	//test on db error
+	s.mock.ExpectQuery("select track_ID from liked_tracks").
+		WillReturnError(errors.New("db_error"))
+
+	_, err = s.repository.CheckLikes(context.Background(), uID, tIDs)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestRateTrack() {
+	uID, tID := "23423", s.tracks[0].Id
+
+	//like is set when there is nothing to delete
+	s.mock.ExpectExec(regexp.QuoteMeta("delete from liked_tracks where user_ID = $1 and track_ID = $2")).
+		WithArgs(uID, tID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec(regexp.QuoteMeta("insert into liked_tracks (user_ID, track_ID) values ($1, $2) on conflict do nothing")).
+		WithArgs(uID, tID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.RateTrack(context.Background(), uID, tID))
+
+	//existing like is removed
+	s.mock.ExpectExec("delete from liked_tracks").
+		WithArgs(uID, tID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.RateTrack(context.Background(), uID, tID))
+
+	//test on db error
+	s.mock.ExpectExec("delete from liked_tracks").
+		WillReturnError(errors.New("db_error"))
+
+	require.Error(s.T(), s.repository.RateTrack(context.Background(), uID, tID))
+}
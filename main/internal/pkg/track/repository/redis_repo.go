@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+)
+
+// RedisTrackRepository caches catalog reads of the wrapped repository. Playlists, likes,
+// recommendations and search change too often or depend on the user, so they pass through.
+// Writes invalidate the whole catalog cache
+type RedisTrackRepository struct {
+	track.Repository
+	cache *cache.Cache
+	log   *logger.MainLogger
+}
+
+func NewRedisTrackRepository(repository track.Repository, cache *cache.Cache, log *logger.MainLogger) RedisTrackRepository {
+	return RedisTrackRepository{
+		Repository: repository,
+		cache:      cache,
+		log:        log,
+	}
+}
+
+func (tr *RedisTrackRepository) GetTrackById(ctx context.Context, id string) (models.Track, error) {
+	var result models.Track
+	err := tr.cache.Get(ctx, "track:"+id, &result, func() (interface{}, error) {
+		return tr.Repository.GetTrackById(ctx, id)
+	})
+	return result, err
+}
+
+func (tr *RedisTrackRepository) GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64) ([]models.Track, error) {
+	var result []models.Track
+	err := tr.cache.Get(ctx, fmt.Sprintf("album:%s:%d:%d", aId, start, end), &result, func() (interface{}, error) {
+		return tr.Repository.GetBoundedTracksByAlbumId(ctx, aId, start, end)
+	})
+	return result, err
+}
+
+func (tr *RedisTrackRepository) GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Track, error) {
+	var result []models.Track
+	err := tr.cache.Get(ctx, fmt.Sprintf("artist:%s:%d:%d", id, start, end), &result, func() (interface{}, error) {
+		return tr.Repository.GetBoundedTracksByArtistId(ctx, id, start, end)
+	})
+	return result, err
+}
+
+func (tr *RedisTrackRepository) GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Track, error) {
+	var result []models.Track
+	err := tr.cache.Get(ctx, fmt.Sprintf("genre:%s:%d:%d", gID, start, end), &result, func() (interface{}, error) {
+		return tr.Repository.GetBoundedTracksByGenre(ctx, gID, start, end)
+	})
+	return result, err
+}
+
+func (tr *RedisTrackRepository) CreateTrack(ctx context.Context, track models.Track) (string, error) {
+	id, err := tr.Repository.CreateTrack(ctx, track)
+	if err != nil {
+		return "", err
+	}
+	tr.invalidate(ctx)
+	return id, nil
+}
+
+func (tr *RedisTrackRepository) UpdateTrack(ctx context.Context, track models.Track) error {
+	if err := tr.Repository.UpdateTrack(ctx, track); err != nil {
+		return err
+	}
+	tr.invalidate(ctx)
+	return nil
+}
+
+func (tr *RedisTrackRepository) SetTrackArtists(ctx context.Context, tID string, credits []models.ArtistCredit) error {
+	if err := tr.Repository.SetTrackArtists(ctx, tID, credits); err != nil {
+		return err
+	}
+	tr.invalidate(ctx)
+	return nil
+}
+
+func (tr *RedisTrackRepository) DeleteTrack(ctx context.Context, id string) error {
+	if err := tr.Repository.DeleteTrack(ctx, id); err != nil {
+		return err
+	}
+	tr.invalidate(ctx)
+	return nil
+}
+
+// invalidate never fails the write, it is committed already. The stale entries expire after ttl
+func (tr *RedisTrackRepository) invalidate(ctx context.Context) {
+	if err := tr.cache.Invalidate(ctx); err != nil {
+		tr.log.LogWarning(ctx, "track repository", "invalidate", "track is saved, but the cache is stale: "+err.Error())
+	}
+}
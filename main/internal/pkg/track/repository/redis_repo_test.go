@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/cache"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisTrackRepository(t *testing.T) {
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	defer redisServer.Close()
+
+	addr := redisServer.Addr()
+	redisConn := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := track.NewMockRepository(ctrl)
+	repository := NewRedisTrackRepository(m, cache.New(redisConn, "tracks", time.Minute), logger.NewLogger(os.Stdout))
+
+	old := models.Track{Id: "1", Name: "old"}
+	updated := models.Track{Id: "1", Name: "new"}
+	gomock.InOrder(
+		m.EXPECT().GetTrackById(gomock.Any(), "1").Return(old, nil).Times(1),
+		m.EXPECT().UpdateTrack(gomock.Any(), updated).Return(nil),
+		m.EXPECT().GetTrackById(gomock.Any(), "1").Return(updated, nil).Times(1),
+	)
+
+	for i := 0; i < 2; i++ {
+		result, err := repository.GetTrackById(context.Background(), "1")
+		require.NoError(t, err)
+		require.Equal(t, old, result)
+	}
+
+	//update drops the cached track
+	require.NoError(t, repository.UpdateTrack(context.Background(), updated))
+	result, err := repository.GetTrackById(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, updated, result)
+
+	//likes are not cached
+	m.EXPECT().CheckLikes(gomock.Any(), "2", []string{"1"}).Return(map[string]bool{"1": true}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := repository.CheckLikes(context.Background(), "2", []string{"1"})
+		require.NoError(t, err)
+	}
+
+	//a failed invalidation doesn't fail the saved update
+	redisServer.Close()
+	m.EXPECT().UpdateTrack(gomock.Any(), old).Return(nil)
+	require.NoError(t, repository.UpdateTrack(context.Background(), old))
+}
@@ -5,6 +5,7 @@
 package track
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,120 +35,207 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // GetTrackById mocks base method
-func (m *MockRepository) GetTrackById(id string) (models.Track, error) {
+func (m *MockRepository) GetTrackById(ctx context.Context, id string) (models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrackById", id)
+	ret := m.ctrl.Call(m, "GetTrackById", ctx, id)
 	ret0, _ := ret[0].(models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTrackById indicates an expected call of GetTrackById
-func (mr *MockRepositoryMockRecorder) GetTrackById(id interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetTrackById(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackById", reflect.TypeOf((*MockRepository)(nil).GetTrackById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackById", reflect.TypeOf((*MockRepository)(nil).GetTrackById), ctx, id)
 }
 
 // GetBoundedTracksByPlaylistId mocks base method
-func (m *MockRepository) GetBoundedTracksByPlaylistId(plId string, start, end uint64) ([]models.Track, error) {
+func (m *MockRepository) GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByPlaylistId", plId, start, end)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByPlaylistId", ctx, plId, start, end)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByPlaylistId indicates an expected call of GetBoundedTracksByPlaylistId
-func (mr *MockRepositoryMockRecorder) GetBoundedTracksByPlaylistId(plId, start, end interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBoundedTracksByPlaylistId(ctx, plId, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByPlaylistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByPlaylistId), plId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByPlaylistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByPlaylistId), ctx, plId, start, end)
 }
 
 // GetBoundedTracksByAlbumId mocks base method
-func (m *MockRepository) GetBoundedTracksByAlbumId(aId string, start, end uint64) ([]models.Track, error) {
+func (m *MockRepository) GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByAlbumId", aId, start, end)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByAlbumId", ctx, aId, start, end)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByAlbumId indicates an expected call of GetBoundedTracksByAlbumId
-func (mr *MockRepositoryMockRecorder) GetBoundedTracksByAlbumId(aId, start, end interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBoundedTracksByAlbumId(ctx, aId, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByAlbumId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByAlbumId), aId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByAlbumId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByAlbumId), ctx, aId, start, end)
 }
 
 // GetBoundedTracksByArtistId mocks base method
-func (m *MockRepository) GetBoundedTracksByArtistId(id string, start, end uint64) ([]models.Track, error) {
+func (m *MockRepository) GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByArtistId", id, start, end)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByArtistId", ctx, id, start, end)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByArtistId indicates an expected call of GetBoundedTracksByArtistId
-func (mr *MockRepositoryMockRecorder) GetBoundedTracksByArtistId(id, start, end interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetBoundedTracksByArtistId(ctx, id, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByArtistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByArtistId), id, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByArtistId", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByArtistId), ctx, id, start, end)
+}
+
+// GetBoundedTracksByGenre mocks base method
+func (m *MockRepository) GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedTracksByGenre", ctx, gID, start, end)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedTracksByGenre indicates an expected call of GetBoundedTracksByGenre
+func (mr *MockRepositoryMockRecorder) GetBoundedTracksByGenre(ctx, gID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByGenre", reflect.TypeOf((*MockRepository)(nil).GetBoundedTracksByGenre), ctx, gID, start, end)
+}
+
+// GetSimilarTracks mocks base method
+func (m *MockRepository) GetSimilarTracks(ctx context.Context, tID string, exclude []string, count uint64) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarTracks", ctx, tID, exclude, count)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarTracks indicates an expected call of GetSimilarTracks
+func (mr *MockRepositoryMockRecorder) GetSimilarTracks(ctx, tID, exclude, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarTracks", reflect.TypeOf((*MockRepository)(nil).GetSimilarTracks), ctx, tID, exclude, count)
 }
 
 // Search mocks base method
-func (m *MockRepository) Search(text string, count uint) ([]models.TrackSearch, error) {
+func (m *MockRepository) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.TrackSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockRepositoryMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), ctx, text, count)
 }
 
 // GetUserTracks mocks base method
-func (m *MockRepository) GetUserTracks(uID string) ([]models.Track, error) {
+func (m *MockRepository) GetUserTracks(ctx context.Context, uID string) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTracks", uID)
+	ret := m.ctrl.Call(m, "GetUserTracks", ctx, uID)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserTracks indicates an expected call of GetUserTracks
-func (mr *MockRepositoryMockRecorder) GetUserTracks(uID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetUserTracks(ctx, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTracks", reflect.TypeOf((*MockRepository)(nil).GetUserTracks), uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTracks", reflect.TypeOf((*MockRepository)(nil).GetUserTracks), ctx, uID)
 }
 
-// GetUserLikedTracksIDs mocks base method
-func (m *MockRepository) GetUserLikedTracksIDs(uID string) ([]int64, error) {
+// CheckLikes mocks base method
+func (m *MockRepository) CheckLikes(ctx context.Context, uID string, tIDs []string) (map[string]bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserLikedTracksIDs", uID)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "CheckLikes", ctx, uID, tIDs)
+	ret0, _ := ret[0].(map[string]bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserLikedTracksIDs indicates an expected call of GetUserLikedTracksIDs
-func (mr *MockRepositoryMockRecorder) GetUserLikedTracksIDs(uID interface{}) *gomock.Call {
+// CheckLikes indicates an expected call of CheckLikes
+func (mr *MockRepositoryMockRecorder) CheckLikes(ctx, uID, tIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserLikedTracksIDs", reflect.TypeOf((*MockRepository)(nil).GetUserLikedTracksIDs), uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLikes", reflect.TypeOf((*MockRepository)(nil).CheckLikes), ctx, uID, tIDs)
 }
 
 // RateTrack mocks base method
-func (m *MockRepository) RateTrack(uID, tID string) error {
+func (m *MockRepository) RateTrack(ctx context.Context, uID, tID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RateTrack", uID, tID)
+	ret := m.ctrl.Call(m, "RateTrack", ctx, uID, tID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RateTrack indicates an expected call of RateTrack
-func (mr *MockRepositoryMockRecorder) RateTrack(uID, tID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) RateTrack(ctx, uID, tID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateTrack", reflect.TypeOf((*MockRepository)(nil).RateTrack), ctx, uID, tID)
+}
+
+// CreateTrack mocks base method
+func (m *MockRepository) CreateTrack(ctx context.Context, track models.Track) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrack", ctx, track)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrack indicates an expected call of CreateTrack
+func (mr *MockRepositoryMockRecorder) CreateTrack(ctx, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrack", reflect.TypeOf((*MockRepository)(nil).CreateTrack), ctx, track)
+}
+
+// UpdateTrack mocks base method
+func (m *MockRepository) UpdateTrack(ctx context.Context, track models.Track) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrack", ctx, track)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTrack indicates an expected call of UpdateTrack
+func (mr *MockRepositoryMockRecorder) UpdateTrack(ctx, track interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrack", reflect.TypeOf((*MockRepository)(nil).UpdateTrack), ctx, track)
+}
+
+// SetTrackArtists mocks base method
+func (m *MockRepository) SetTrackArtists(ctx context.Context, tID string, credits []models.ArtistCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackArtists", ctx, tID, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrackArtists indicates an expected call of SetTrackArtists
+func (mr *MockRepositoryMockRecorder) SetTrackArtists(ctx, tID, credits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtists", reflect.TypeOf((*MockRepository)(nil).SetTrackArtists), ctx, tID, credits)
+}
+
+// DeleteTrack mocks base method
+func (m *MockRepository) DeleteTrack(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrack", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrack indicates an expected call of DeleteTrack
+func (mr *MockRepositoryMockRecorder) DeleteTrack(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateTrack", reflect.TypeOf((*MockRepository)(nil).RateTrack), uID, tID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockRepository)(nil).DeleteTrack), ctx, id)
 }
@@ -1,13 +1,17 @@
 package track
 
-import "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+import (
+	"context"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+)
 
 type UseCase interface {
-	GetTrackById(id string) (models.Track, error)
-	GetBoundedTracksByArtistId(id string, start, end uint64, uID string) ([]models.Track, error)
-	GetBoundedTracksByAlbumId(aId string, start, end uint64, uID string) ([]models.Track, error)
-	GetBoundedTracksByPlaylistId(plId string, start, end uint64, uID string) ([]models.Track, error)
-	Search(text string, count uint) ([]models.TrackSearch, error)
-	GetUserTracks(uID string) ([]models.Track, error)
-	RateTrack(uID, tID string) error
+	GetTrackById(ctx context.Context, id string) (models.Track, error)
+	GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64, uID string) ([]models.Track, error)
+	GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64, uID string) ([]models.Track, error)
+	GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64, uID string) ([]models.Track, error)
+	GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64, uID string) ([]models.Track, error)
+	Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error)
+	GetUserTracks(ctx context.Context, uID string) ([]models.Track, error)
+	RateTrack(ctx context.Context, uID, tID string) error
 }
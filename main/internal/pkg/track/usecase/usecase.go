@@ -1,44 +1,44 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
-	"strconv"
 )
 
 type TrackUseCase struct {
 	Repository track.Repository
 }
 
-func (uc TrackUseCase) GetTrackById(id string) (models.Track, error) {
-	return uc.Repository.GetTrackById(id)
+func (uc TrackUseCase) GetTrackById(ctx context.Context, id string) (models.Track, error) {
+	return uc.Repository.GetTrackById(ctx, id)
 }
 
-func (uc TrackUseCase) RateTrack(uID, tID string) error {
-	return uc.Repository.RateTrack(uID, tID)
+func (uc TrackUseCase) RateTrack(ctx context.Context, uID, tID string) error {
+	return uc.Repository.RateTrack(ctx, uID, tID)
 }
 
-func (uc TrackUseCase) GetBoundedTracksByArtistId(id string, start, end uint64, uID string) ([]models.Track, error) {
-	dbTracks, err := uc.Repository.GetBoundedTracksByArtistId(id, start, end)
+func (uc TrackUseCase) GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64, uID string) ([]models.Track, error) {
+	dbTracks, err := uc.Repository.GetBoundedTracksByArtistId(ctx, id, start, end)
 	if err != nil {
 		return nil, err
 	}
 	if uID != "" {
-		if err = uc.setLikes(dbTracks, uID); err != nil {
+		if err = uc.setLikes(ctx, dbTracks, uID); err != nil {
 			return nil, err
 		}
 	}
 	return dbTracks, nil
 }
 
-func (uc TrackUseCase) GetBoundedTracksByAlbumId(id string, start, end uint64, uID string) ([]models.Track, error) {
-	dbTracks, err := uc.Repository.GetBoundedTracksByAlbumId(id, start, end)
+func (uc TrackUseCase) GetBoundedTracksByAlbumId(ctx context.Context, id string, start, end uint64, uID string) ([]models.Track, error) {
+	dbTracks, err := uc.Repository.GetBoundedTracksByAlbumId(ctx, id, start, end)
 	if err != nil {
 		return nil, err
 	}
 	if uID != "" {
-		if err = uc.setLikes(dbTracks, uID); err != nil {
+		if err = uc.setLikes(ctx, dbTracks, uID); err != nil {
 			return nil, err
 		}
 	}
@@ -46,42 +46,52 @@ func (uc TrackUseCase) GetBoundedTracksByAlbumId(id string, start, end uint64, u
 	return dbTracks, nil
 }
 
-func (uc TrackUseCase) GetBoundedTracksByPlaylistId(plId string, start, end uint64, uID string) ([]models.Track, error) {
-	dbTracks, err := uc.Repository.GetBoundedTracksByPlaylistId(plId, start, end)
+func (uc TrackUseCase) GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64, uID string) ([]models.Track, error) {
+	dbTracks, err := uc.Repository.GetBoundedTracksByPlaylistId(ctx, plId, start, end)
 	if err != nil {
 		return nil, err
 	}
 	if uID != "" {
-		if err = uc.setLikes(dbTracks, uID); err != nil {
+		if err = uc.setLikes(ctx, dbTracks, uID); err != nil {
 			return nil, err
 		}
 	}
 	return dbTracks, nil
 }
 
-func (uc TrackUseCase) Search(text string, count uint) ([]models.TrackSearch, error) {
-	return uc.Repository.Search(text, count)
+func (uc TrackUseCase) GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64, uID string) ([]models.Track, error) {
+	dbTracks, err := uc.Repository.GetBoundedTracksByGenre(ctx, gID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if uID != "" {
+		if err = uc.setLikes(ctx, dbTracks, uID); err != nil {
+			return nil, err
+		}
+	}
+	return dbTracks, nil
 }
 
-func (uc TrackUseCase) GetUserTracks(uID string) ([]models.Track, error) {
-	return uc.Repository.GetUserTracks(uID)
+func (uc TrackUseCase) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
+	return uc.Repository.Search(ctx, text, count)
 }
 
-func (uc TrackUseCase) setLikes(tracks []models.Track, uID string) error {
-	trackIDs, err := uc.Repository.GetUserLikedTracksIDs(uID)
+func (uc TrackUseCase) GetUserTracks(ctx context.Context, uID string) ([]models.Track, error) {
+	return uc.Repository.GetUserTracks(ctx, uID)
+}
+
+func (uc TrackUseCase) setLikes(ctx context.Context, tracks []models.Track, uID string) error {
+	tIDs := make([]string, len(tracks))
+	for i, elem := range tracks {
+		tIDs[i] = elem.Id
+	}
+	liked, err := uc.Repository.CheckLikes(ctx, uID, tIDs)
 	if err != nil {
 		return err
 	}
-	intersection := make(map[string]int)
-
-	for _, elem := range trackIDs {
-		intersection[strconv.FormatInt(elem, 10)] = 1
-	}
 
 	for i, elem := range tracks {
-		if _, ok := intersection[elem.Id]; ok {
-			tracks[i].IsLiked = true
-		}
+		tracks[i].IsLiked = liked[elem.Id]
 	}
 	return nil
 }
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testTracks() []models.Track {
+	return []models.Track{
+		{Id: "5", Name: "first"},
+		{Id: "6", Name: "second"},
+		{Id: "7", Name: "third"},
+	}
+}
+
+func TestGetBoundedTracksByAlbumId(t *testing.T) {
+	t.Run("GetBoundedTracksByAlbumId-Liked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := track.NewMockRepository(ctrl)
+		m.EXPECT().GetBoundedTracksByAlbumId(gomock.Any(), "1", uint64(0), uint64(3)).Return(testTracks(), nil)
+		m.EXPECT().
+			CheckLikes(gomock.Any(), "2", []string{"5", "6", "7"}).
+			Return(map[string]bool{"5": true, "7": true}, nil).
+			Times(1)
+
+		useCase := TrackUseCase{Repository: m}
+
+		res, err := useCase.GetBoundedTracksByAlbumId(context.Background(), "1", 0, 3, "2")
+		assert.NoError(t, err)
+		assert.True(t, res[0].IsLiked)
+		assert.False(t, res[1].IsLiked)
+		assert.True(t, res[2].IsLiked)
+	})
+
+	t.Run("GetBoundedTracksByAlbumId-Guest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := track.NewMockRepository(ctrl)
+		m.EXPECT().GetBoundedTracksByAlbumId(gomock.Any(), "1", uint64(0), uint64(3)).Return(testTracks(), nil)
+
+		useCase := TrackUseCase{Repository: m}
+
+		res, err := useCase.GetBoundedTracksByAlbumId(context.Background(), "1", 0, 3, "")
+		assert.NoError(t, err)
+		assert.Equal(t, testTracks(), res)
+	})
+
+	t.Run("GetBoundedTracksByAlbumId-LikesError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := track.NewMockRepository(ctrl)
+		m.EXPECT().GetBoundedTracksByAlbumId(gomock.Any(), "1", uint64(0), uint64(3)).Return(testTracks(), nil)
+		m.EXPECT().CheckLikes(gomock.Any(), "2", []string{"5", "6", "7"}).Return(nil, errors.New("db error"))
+
+		useCase := TrackUseCase{Repository: m}
+
+		_, err := useCase.GetBoundedTracksByAlbumId(context.Background(), "1", 0, 3, "2")
+		assert.Error(t, err)
+	})
+}
+
+func TestGetBoundedTracksByPlaylistId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := track.NewMockRepository(ctrl)
+	m.EXPECT().GetBoundedTracksByPlaylistId(gomock.Any(), "3", uint64(0), uint64(3)).Return(testTracks(), nil)
+	m.EXPECT().CheckLikes(gomock.Any(), "2", []string{"5", "6", "7"}).Return(map[string]bool{"6": true}, nil)
+
+	useCase := TrackUseCase{Repository: m}
+
+	res, err := useCase.GetBoundedTracksByPlaylistId(context.Background(), "3", 0, 3, "2")
+	assert.NoError(t, err)
+	assert.False(t, res[0].IsLiked)
+	assert.True(t, res[1].IsLiked)
+	assert.False(t, res[2].IsLiked)
+}
+
+func TestGetUserTracks(t *testing.T) {
+	t.Run("GetUserTracks-NewestFirst", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//the repository returns the latest like first, the order reaches the client as is
+		liked := []models.Track{
+			{Id: "7", Name: "liked today", IsLiked: true},
+			{Id: "5", Name: "liked yesterday", IsLiked: true},
+			{Id: "6", Name: "liked last year", IsLiked: true},
+		}
+		m := track.NewMockRepository(ctrl)
+		m.EXPECT().GetUserTracks(gomock.Any(), "2").Return(liked, nil)
+
+		useCase := TrackUseCase{Repository: m}
+
+		res, err := useCase.GetUserTracks(context.Background(), "2")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"7", "5", "6"}, []string{res[0].Id, res[1].Id, res[2].Id})
+		for _, elem := range res {
+			assert.True(t, elem.IsLiked)
+		}
+	})
+
+	t.Run("GetUserTracks-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := track.NewMockRepository(ctrl)
+		m.EXPECT().GetUserTracks(gomock.Any(), "2").Return(nil, errors.New("db error"))
+
+		useCase := TrackUseCase{Repository: m}
+
+		_, err := useCase.GetUserTracks(context.Background(), "2")
+		assert.Error(t, err)
+	})
+}
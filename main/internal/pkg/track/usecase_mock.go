@@ -5,6 +5,7 @@
 package track
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,105 +35,120 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // GetTrackById mocks base method
-func (m *MockUseCase) GetTrackById(id string) (models.Track, error) {
+func (m *MockUseCase) GetTrackById(ctx context.Context, id string) (models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrackById", id)
+	ret := m.ctrl.Call(m, "GetTrackById", ctx, id)
 	ret0, _ := ret[0].(models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTrackById indicates an expected call of GetTrackById
-func (mr *MockUseCaseMockRecorder) GetTrackById(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetTrackById(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackById", reflect.TypeOf((*MockUseCase)(nil).GetTrackById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackById", reflect.TypeOf((*MockUseCase)(nil).GetTrackById), ctx, id)
 }
 
 // GetBoundedTracksByArtistId mocks base method
-func (m *MockUseCase) GetBoundedTracksByArtistId(id string, start, end uint64, uID string) ([]models.Track, error) {
+func (m *MockUseCase) GetBoundedTracksByArtistId(ctx context.Context, id string, start, end uint64, uID string) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByArtistId", id, start, end, uID)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByArtistId", ctx, id, start, end, uID)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByArtistId indicates an expected call of GetBoundedTracksByArtistId
-func (mr *MockUseCaseMockRecorder) GetBoundedTracksByArtistId(id, start, end, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetBoundedTracksByArtistId(ctx, id, start, end, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByArtistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByArtistId), id, start, end, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByArtistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByArtistId), ctx, id, start, end, uID)
 }
 
 // GetBoundedTracksByAlbumId mocks base method
-func (m *MockUseCase) GetBoundedTracksByAlbumId(aId string, start, end uint64, uID string) ([]models.Track, error) {
+func (m *MockUseCase) GetBoundedTracksByAlbumId(ctx context.Context, aId string, start, end uint64, uID string) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByAlbumId", aId, start, end, uID)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByAlbumId", ctx, aId, start, end, uID)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByAlbumId indicates an expected call of GetBoundedTracksByAlbumId
-func (mr *MockUseCaseMockRecorder) GetBoundedTracksByAlbumId(aId, start, end, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetBoundedTracksByAlbumId(ctx, aId, start, end, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByAlbumId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByAlbumId), aId, start, end, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByAlbumId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByAlbumId), ctx, aId, start, end, uID)
 }
 
 // GetBoundedTracksByPlaylistId mocks base method
-func (m *MockUseCase) GetBoundedTracksByPlaylistId(plId string, start, end uint64, uID string) ([]models.Track, error) {
+func (m *MockUseCase) GetBoundedTracksByPlaylistId(ctx context.Context, plId string, start, end uint64, uID string) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoundedTracksByPlaylistId", plId, start, end, uID)
+	ret := m.ctrl.Call(m, "GetBoundedTracksByPlaylistId", ctx, plId, start, end, uID)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoundedTracksByPlaylistId indicates an expected call of GetBoundedTracksByPlaylistId
-func (mr *MockUseCaseMockRecorder) GetBoundedTracksByPlaylistId(plId, start, end, uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetBoundedTracksByPlaylistId(ctx, plId, start, end, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByPlaylistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByPlaylistId), plId, start, end, uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByPlaylistId", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByPlaylistId), ctx, plId, start, end, uID)
+}
+
+// GetBoundedTracksByGenre mocks base method
+func (m *MockUseCase) GetBoundedTracksByGenre(ctx context.Context, gID string, start, end uint64, uID string) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoundedTracksByGenre", ctx, gID, start, end, uID)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoundedTracksByGenre indicates an expected call of GetBoundedTracksByGenre
+func (mr *MockUseCaseMockRecorder) GetBoundedTracksByGenre(ctx, gID, start, end, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoundedTracksByGenre", reflect.TypeOf((*MockUseCase)(nil).GetBoundedTracksByGenre), ctx, gID, start, end, uID)
 }
 
 // Search mocks base method
-func (m *MockUseCase) Search(text string, count uint) ([]models.TrackSearch, error) {
+func (m *MockUseCase) Search(ctx context.Context, text string, count uint) ([]models.TrackSearch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", text, count)
+	ret := m.ctrl.Call(m, "Search", ctx, text, count)
 	ret0, _ := ret[0].([]models.TrackSearch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search
-func (mr *MockUseCaseMockRecorder) Search(text, count interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Search(ctx, text, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), text, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), ctx, text, count)
 }
 
 // GetUserTracks mocks base method
-func (m *MockUseCase) GetUserTracks(uID string) ([]models.Track, error) {
+func (m *MockUseCase) GetUserTracks(ctx context.Context, uID string) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTracks", uID)
+	ret := m.ctrl.Call(m, "GetUserTracks", ctx, uID)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserTracks indicates an expected call of GetUserTracks
-func (mr *MockUseCaseMockRecorder) GetUserTracks(uID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserTracks(ctx, uID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTracks", reflect.TypeOf((*MockUseCase)(nil).GetUserTracks), uID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTracks", reflect.TypeOf((*MockUseCase)(nil).GetUserTracks), ctx, uID)
 }
 
 // RateTrack mocks base method
-func (m *MockUseCase) RateTrack(uID, tID string) error {
+func (m *MockUseCase) RateTrack(ctx context.Context, uID, tID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RateTrack", uID, tID)
+	ret := m.ctrl.Call(m, "RateTrack", ctx, uID, tID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RateTrack indicates an expected call of RateTrack
-func (mr *MockUseCaseMockRecorder) RateTrack(uID, tID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) RateTrack(ctx, uID, tID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateTrack", reflect.TypeOf((*MockUseCase)(nil).RateTrack), uID, tID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateTrack", reflect.TypeOf((*MockUseCase)(nil).RateTrack), ctx, uID, tID)
 }
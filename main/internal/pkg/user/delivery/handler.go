@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/config"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/csrf"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	users "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/validation"
 	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/2020_1_no_homomorphism/no_homo_main/proto/session"
 	"github.com/spf13/viper"
 	"net/http"
+	"strconv"
 
 	"time"
 
@@ -23,6 +27,7 @@ type UserHandler struct {
 	SessionDelivery session.AuthCheckerClient
 	UserUC          users.UseCase
 	CSRF            csrf.UseCase
+	Attempts        attempts.UseCase
 	Log             *logger.MainLogger
 	ImgTypes        map[string]string
 }
@@ -43,15 +48,13 @@ func (h *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	input := models.UserSettings{}
-	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		h.Log.HttpInfo(r.Context(), "error while unmarshalling JSON:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
-	emailExists, err := h.UserUC.Update(user, input)
+	emailExists, err := h.UserUC.Update(r.Context(), user, input)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "can't update user:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "can't update user:"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	if emailExists != users.NO {
@@ -69,24 +72,21 @@ func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	user := models.User{}
-	decoder := json.NewDecoder(r.Body)
-	err = decoder.Decode(&user)
+	err = validation.DecodeJSON(r, &user)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "error while unmarshalling JSON:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
-	exists, err := h.UserUC.Create(user)
+	exists, err := h.UserUC.Create(r.Context(), user)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "error while creating User:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "error while creating User:"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	if exists != users.NO {
 		h.checkAndSendExisting(w, r.Context(), exists)
 		return
 	}
-	userSession, err := h.SessionDelivery.Create(context.Background(), &session.Session{Login: user.Login})
+	userSession, err := h.SessionDelivery.Create(r.Context(), &session.Session{Login: user.Login})
 	if err != nil {
 		h.Log.LogWarning(r.Context(), "user delivery", "Create", "failed to create session: "+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
@@ -141,24 +141,44 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	input := models.UserSignIn{}
-	err = json.NewDecoder(r.Body).Decode(&input)
+	err = validation.DecodeJSON(r, &input)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "error while unmarshalling JSON:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	ip := middleware.ClientIP(r)
+	retryAfter, err := h.Attempts.Check(r.Context(), input.Login, ip)
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", "Login", "failed to check login attempts: "+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if retryAfter > 0 {
+		h.sendTooManyAttempts(w, r.Context(), retryAfter)
 		return
 	}
-	userData, err := h.UserUC.Login(input)
+
+	userData, err := h.UserUC.Login(r.Context(), input)
 	if err != nil {
+		if h.failLogin(w, r.Context(), input.Login, ip, "password") {
+			return
+		}
 		h.Log.HttpInfo(r.Context(), "failed to login:"+err.Error(), http.StatusBadRequest)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	userSession, err := h.SessionDelivery.Create(context.Background(), &session.Session{Login: userData.Login})
+	if !h.checkSecondFactor(w, r.Context(), userData, input.Code, ip) {
+		return
+	}
+	userSession, err := h.SessionDelivery.Create(r.Context(), &session.Session{Login: userData.Login})
 	if err != nil {
 		h.Log.LogWarning(r.Context(), "delivery", "Login", "failed to create session: "+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if err := h.Attempts.Reset(r.Context(), userData.Login); err != nil {
+		h.Log.LogWarning(r.Context(), "delivery", "Login", "failed to reset login attempts: "+err.Error())
+	}
 
 	cookie := http.Cookie{
 		Name:     "session_id",
@@ -172,6 +192,64 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *UserHandler) failLogin(w http.ResponseWriter, ctx context.Context, login string, ip string, reason string) bool {
+	retryAfter, err := h.Attempts.Fail(ctx, login, ip, reason)
+	if err != nil {
+		h.Log.LogWarning(ctx, "user delivery", "failLogin", "failed to count login attempt: "+err.Error())
+		return false
+	}
+	if retryAfter > 0 {
+		h.sendTooManyAttempts(w, ctx, retryAfter)
+		return true
+	}
+	return false
+}
+
+func (h *UserHandler) sendTooManyAttempts(w http.ResponseWriter, ctx context.Context, retryAfter int64) {
+	w.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	h.Log.HttpInfo(ctx, "too many login attempts", http.StatusTooManyRequests)
+	w.WriteHeader(http.StatusTooManyRequests)
+}
+
+func (h *UserHandler) checkSecondFactor(w http.ResponseWriter, ctx context.Context, user models.User, code string, ip string) bool {
+	enabled, err := h.UserUC.IsTwoFactorEnabled(ctx, user.Id)
+	if err != nil {
+		h.Log.LogWarning(ctx, "user delivery", "checkSecondFactor", "failed to get two factor settings: "+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return false
+	}
+	if !enabled {
+		return true
+	}
+
+	if code != "" {
+		ok, err := h.UserUC.CheckSecondFactor(ctx, user.Id, code)
+		if err != nil {
+			h.Log.LogWarning(ctx, "user delivery", "checkSecondFactor", "failed to check two factor code: "+err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return false
+		}
+		if ok {
+			return true
+		}
+		if h.failLogin(w, ctx, user.Login, ip, "two_factor") {
+			return false
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	h.Log.HttpInfo(ctx, "two factor code is missing or wrong", http.StatusUnauthorized)
+	w.WriteHeader(http.StatusUnauthorized)
+
+	err = json.NewEncoder(w).Encode(struct {
+		TwoFactorRequired bool `json:"two_factor_required"`
+	}{true})
+	if err != nil {
+		h.Log.LogWarning(ctx, "user delivery", "checkSecondFactor", "failed to encode: "+err.Error())
+	}
+	return false
+}
+
 func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	cookie, err := r.Cookie("session_id")
 	if err == http.ErrNoCookie || cookie == nil {
@@ -179,10 +257,9 @@ func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	_, err = h.SessionDelivery.Delete(context.Background(), &session.SessionID{ID: cookie.Value})
+	_, err = h.SessionDelivery.Delete(r.Context(), &session.SessionID{ID: cookie.Value})
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "can't delete session:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "can't delete session:"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	cookie.Expires = time.Now().AddDate(0, 0, -1)
@@ -200,10 +277,9 @@ func (h *UserHandler) Profile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	profile, err := h.UserUC.GetProfileByLogin(login)
+	profile, err := h.UserUC.GetProfileByLogin(r.Context(), login)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "can't find profile:"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "can't find profile:"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 
@@ -270,10 +346,10 @@ func (h *UserHandler) UpdateAvatar(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	path, err := h.UserUC.UpdateAvatar(user, file, elem)
+	path, err := h.UserUC.UpdateAvatar(r.Context(), user, file, elem)
 	if err != nil {
 		h.Log.LogWarning(r.Context(), "delivery", "UpdateAvatar", "failed to update avatar:"+err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
+		apperrors.WriteHTTP(w, err)
 		return
 	}
 	h.Log.Info("new file created:", path)
@@ -287,10 +363,9 @@ func (h *UserHandler) GetUserStat(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	userStat, err := h.UserUC.GetUserStat(id)
+	userStat, err := h.UserUC.GetUserStat(r.Context(), id)
 	if err != nil {
-		h.Log.HttpInfo(r.Context(), "failed to get user's stat"+err.Error(), http.StatusBadRequest)
-		w.WriteHeader(http.StatusBadRequest)
+		h.Log.HttpInfo(r.Context(), "failed to get user's stat"+err.Error(), apperrors.WriteHTTP(w, err))
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -304,6 +379,277 @@ func (h *UserHandler) GetUserStat(w http.ResponseWriter, r *http.Request) {
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
 
+func (h *UserHandler) SetupTwoFactor(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", "SetupTwoFactor", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	setup, err := h.UserUC.SetupTwoFactor(r.Context(), user)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to setup two factor:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(setup)
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", "SetupTwoFactor", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) EnableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", "EnableTwoFactor", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	input := models.TwoFactorInput{}
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	codes, err := h.UserUC.EnableTwoFactor(r.Context(), user, input.Code)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to enable two factor:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(codes)
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", "EnableTwoFactor", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", "DisableTwoFactor", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	input := models.TwoFactorInput{}
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	if err := h.UserUC.DisableTwoFactor(r.Context(), user, input.Password); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to disable two factor:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", "ExportData", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	archive, err := h.UserUC.ExportData(r.Context(), user)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to export user data:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+user.Login+".zip\"")
+	w.Header().Set("Content-Length", strconv.Itoa(len(archive)))
+
+	if _, err := w.Write(archive); err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", "ExportData", "failed to write archive"+err.Error())
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", "DeleteAccount", "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	input := models.PasswordConfirm{}
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	if err := h.UserUC.CheckUserPassword(user.Password, input.Password); err != nil {
+		err = apperrors.Errorf(apperrors.Forbidden, "wrong password: %w", err)
+		h.Log.HttpInfo(r.Context(), "failed to delete account:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	// sessions go first: once the login is free it can be registered again, and sessions left
+	// by a failed revocation would authorize the new owner
+	if _, err := h.SessionDelivery.DeleteAll(r.Context(), &session.Session{Login: user.Login}); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to delete sessions:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	if err := h.UserUC.DeleteAccount(r.Context(), user); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to delete account:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Path:     "/",
+		Expires:  time.Now().AddDate(0, 0, -1),
+		HttpOnly: true,
+	})
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	h.changeFollow(w, r, "Follow", h.UserUC.Follow)
+}
+
+func (h *UserHandler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	h.changeFollow(w, r, "Unfollow", h.UserUC.Unfollow)
+}
+
+func (h *UserHandler) changeFollow(w http.ResponseWriter, r *http.Request, method string, change func(context.Context, models.User, string) error) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		h.Log.LogWarning(r.Context(), "user delivery", method, "failed to get from context")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := change(r.Context(), user, id); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to change follow:"+err.Error(), http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	h.sendBoundedFollows(w, r, "GetFollowers", h.UserUC.GetFollowers)
+}
+
+func (h *UserHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	h.sendBoundedFollows(w, r, "GetFollowing", h.UserUC.GetFollowing)
+}
+
+func (h *UserHandler) sendBoundedFollows(w http.ResponseWriter, r *http.Request, method string,
+	get func(context.Context, string, uint64, uint64) ([]models.UserPreview, error)) {
+	id, okId := r.Context().Value(middleware.Id).(string)
+	start, okStart := r.Context().Value(middleware.Start).(uint64)
+	end, okEnd := r.Context().Value(middleware.End).(uint64)
+
+	if !okId || !okStart || !okEnd {
+		h.Log.LogWarning(r.Context(), "user delivery", method, "failed to get vars")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	users, err := get(r.Context(), id, start, end)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to get users"+err.Error(), http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(struct {
+		Id    string               `json:"id"`
+		Users []models.UserPreview `json:"users"`
+	}{id, users})
+
+	if err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", method, "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
+func (h *UserHandler) GetFullProfile(w http.ResponseWriter, r *http.Request) {
+	login, ok := mux.Vars(r)["profile"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no profile in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	viewer, ok := r.Context().Value(middleware.UserKey).(models.User)
+	if !ok {
+		viewer = models.User{Id: ""}
+	}
+
+	profile, err := h.UserUC.GetFullProfile(r.Context(), login, viewer)
+	if err != nil {
+		h.Log.HttpInfo(r.Context(), "can't find profile:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(&profile); err != nil {
+		h.Log.LogWarning(r.Context(), "user delivery", "GetFullProfile", "failed to encode json"+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
+
 func (h *UserHandler) CheckAuth(w http.ResponseWriter, r *http.Request) {
 	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
 }
@@ -343,3 +689,30 @@ func (h *UserHandler) checkNoAuth(w http.ResponseWriter, r *http.Request) error
 	}
 	return nil
 }
+
+func (h *UserHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	token, ok := r.Context().Value(middleware.CSRFTokenCorrect).(bool)
+	if !token || !ok {
+		h.Log.HttpInfo(r.Context(), "permission denied: user has wrong csrf token", http.StatusUnauthorized)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		h.Log.HttpInfo(r.Context(), "no id in mux vars", http.StatusBadRequest)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	input := models.UserRole{}
+	if err := validation.DecodeJSON(r, &input); err != nil {
+		h.Log.HttpInfo(r.Context(), "invalid input: "+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+
+	if err := h.UserUC.SetRole(r.Context(), id, input); err != nil {
+		h.Log.HttpInfo(r.Context(), "failed to set role:"+err.Error(), apperrors.WriteHTTP(w, err))
+		return
+	}
+	h.Log.HttpInfo(r.Context(), "OK", http.StatusOK)
+}
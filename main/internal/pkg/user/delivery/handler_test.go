@@ -1,10 +1,10 @@
 package delivery
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/attempts"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/csrf"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/middleware"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
@@ -21,12 +21,15 @@ import (
 
 var userHandlers UserHandler
 
+// apitest requests have no remote address
+const testIP = ""
+
 var testUser = models.User{
 	Id:       "1234",
 	Password: "76453647fvd",
 	Name:     "TestName",
 	Login:    "nnnagibator",
-	Sex:      "Man",
+	Sex:      models.SexMale,
 	Image:    "/static/avatar/default.png",
 	Email:    "klsJDLKfj@mail.ru",
 }
@@ -50,16 +53,31 @@ func TestLogin(t *testing.T) {
 		}
 
 		m.EXPECT().
-			Login(testInput).
+			Login(gomock.Any(), testInput).
 			Return(testUser, nil)
 
+		m.EXPECT().
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(false, nil)
+
 		s.EXPECT().
-			Create(context.TODO(), &session.Session{Login: testUser.Login}).
+			Create(gomock.Any(), &session.Session{Login: testUser.Login}).
 			Return(&session.SessionID{ID: "test123"}, nil)
 		//Return(struct {
 		//		ID string
 		//	}{"testId123"}, nil)
 
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		a.EXPECT().
+			Reset(gomock.Any(), testUser.Login).
+			Return(nil)
+
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
 		userHandlers.SessionDelivery = s
 
@@ -105,9 +123,20 @@ func TestLogin(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			Login(testInput).
+			Login(gomock.Any(), testInput).
 			Return(models.User{}, testError)
 
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		a.EXPECT().
+			Fail(gomock.Any(), testUser.Login, testIP, "password").
+			Return(int64(0), nil)
+
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
 
 		apitest.New("Login-UseCaseError").
@@ -137,13 +166,24 @@ func TestLogin(t *testing.T) {
 		testError := errors.New("test error")
 
 		m.EXPECT().
-			Login(testInput).
+			Login(gomock.Any(), testInput).
 			Return(testUser, nil)
 
+		m.EXPECT().
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(false, nil)
+
 		s.EXPECT().
-			Create(context.TODO(), &session.Session{Login: testUser.Login}).
+			Create(gomock.Any(), &session.Session{Login: testUser.Login}).
 			Return(&session.SessionID{}, testError)
 
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
 		userHandlers.SessionDelivery = s
 
@@ -157,353 +197,1070 @@ func TestLogin(t *testing.T) {
 			End()
 	})
 
-	t.Run("Login-FailedToParseJSON", func(t *testing.T) {
+	t.Run("Login-Locked", func(t *testing.T) {
 		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
-		apitest.New("Login-FailedToParseJSON").
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(120), nil)
+
+		userHandlers.Attempts = a
+
+		apitest.New("Login-Locked").
 			Handler(middlewareMock).
 			Method("Post").
 			URL("/login").
-			Body(fmt.Sprintf(`{"login": "%s", "sdmfasd%so23}`, testUser.Login, testUser.Password)).
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s"}`, testUser.Login, testUser.Password)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Header("Retry-After", "120").
+			Status(http.StatusTooManyRequests).
 			End()
 	})
-}
 
-func TestCreate(t *testing.T) {
-	t.Run("Create-OK", func(t *testing.T) {
+	t.Run("Login-LockedAfterFail", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
+
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
-		s := session.NewMockAuthCheckerClient(ctrl)
+		a := attempts.NewMockUseCase(ctrl)
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		testInput := models.UserSignIn{
+			Login:    testUser.Login,
+			Password: testUser.Password,
+		}
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.NO, nil)
+			Login(gomock.Any(), testInput).
+			Return(models.User{}, errors.New("wrong password"))
 
-		s.EXPECT().
-			Create(context.TODO(), &session.Session{Login: testUser.Login}).
-			Return(&session.SessionID{}, nil)
+		a.EXPECT().
+			Fail(gomock.Any(), testUser.Login, testIP, "password").
+			Return(int64(30), nil)
 
 		userHandlers.UserUC = m
-		userHandlers.SessionDelivery = s
-
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+		userHandlers.Attempts = a
 
-		apitest.New("Create-OK").
+		apitest.New("Login-LockedAfterFail").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s"}`, testUser.Login, testUser.Password)).
 			Expect(t).
-			Status(http.StatusCreated).
+			Header("Retry-After", "30").
+			Status(http.StatusTooManyRequests).
 			End()
 	})
-	t.Run("Create-ErrorJSON", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
 
-		apitest.New("Create-ErrorJSON").
+	t.Run("Login-AttemptsError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), errors.New("redis is down"))
+
+		userHandlers.Attempts = a
+
+		apitest.New("Login-AttemptsError").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(`{"loginsdf":sdf%sSkd}`).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s"}`, testUser.Login, testUser.Password)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 
-	t.Run("Create-auth", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, true, testUser, "")
+	t.Run("Login-FailedToParseJSON", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
-		apitest.New("Create-auth").
+		apitest.New("Login-FailedToParseJSON").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "sdmfasd%so23}`, testUser.Login, testUser.Password)).
 			Expect(t).
-			Status(http.StatusForbidden).
+			Status(http.StatusBadRequest).
 			End()
 	})
-	t.Run("Create-UseCaseError", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	testInput := models.UserSignIn{
+		Login:    testUser.Login,
+		Password: testUser.Password,
+		Code:     "123456",
+	}
+
+	t.Run("LoginTwoFactor-CodeRequired", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		testError := errors.New("test error")
+		input := testInput
+		input.Code = ""
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		m.EXPECT().
+			Login(gomock.Any(), input).
+			Return(testUser, nil)
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.NO, testError)
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(true, nil)
+
+		a := attempts.NewMockUseCase(ctrl)
 
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
 
-		apitest.New("Create-UseCaseError").
+		apitest.New("LoginTwoFactor-CodeRequired").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s"}`, testUser.Login, testUser.Password)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Body(`{"two_factor_required": true}`).
+			Status(http.StatusUnauthorized).
 			End()
 	})
 
-	t.Run("Create-SessionError", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+	t.Run("LoginTwoFactor-WrongCode", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
-		s := session.NewMockAuthCheckerClient(ctrl)
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		m.EXPECT().
+			Login(gomock.Any(), testInput).
+			Return(testUser, nil)
 
-		testError := errors.New("test error")
+		m.EXPECT().
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(true, nil)
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.NO, nil)
+			CheckSecondFactor(gomock.Any(), testUser.Id, testInput.Code).
+			Return(false, nil)
 
-		s.EXPECT().
-			Create(context.TODO(), &session.Session{Login: inputData.Login}).
-			Return(&session.SessionID{}, testError)
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		a.EXPECT().
+			Fail(gomock.Any(), testUser.Login, testIP, "two_factor").
+			Return(int64(0), nil)
 
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
-		userHandlers.SessionDelivery = s
 
-		apitest.New("Create-SessionError").
+		apitest.New("LoginTwoFactor-WrongCode").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "code": "%s"}`, testUser.Login, testUser.Password, testInput.Code)).
 			Expect(t).
-			Status(http.StatusInternalServerError).
+			Status(http.StatusUnauthorized).
 			End()
 	})
 
-	t.Run("Create-EmailExists", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+	t.Run("LoginTwoFactor-OK", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
+		s := session.NewMockAuthCheckerClient(ctrl)
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		m.EXPECT().
+			Login(gomock.Any(), testInput).
+			Return(testUser, nil)
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.EMAIL, nil)
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(true, nil)
+
+		m.EXPECT().
+			CheckSecondFactor(gomock.Any(), testUser.Id, testInput.Code).
+			Return(true, nil)
+
+		s.EXPECT().
+			Create(gomock.Any(), &session.Session{Login: testUser.Login}).
+			Return(&session.SessionID{ID: "test123"}, nil)
 
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
+
+		a.EXPECT().
+			Reset(gomock.Any(), testUser.Login).
+			Return(nil)
+
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
+		userHandlers.SessionDelivery = s
 
-		apitest.New("Create-EmailExists").
+		apitest.New("LoginTwoFactor-OK").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "code": "%s"}`, testUser.Login, testUser.Password, testInput.Code)).
 			Expect(t).
-			Body(`{"login_exists":false, "email_exists":true}`).
-			Status(http.StatusConflict).
+			Status(http.StatusOK).
 			End()
 	})
 
-	t.Run("Create-LoginExists", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+	t.Run("LoginTwoFactor-SettingsError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Login, false, models.User{}, "")
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		m.EXPECT().
+			Login(gomock.Any(), testInput).
+			Return(testUser, nil)
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.LOGIN, nil)
+			IsTwoFactorEnabled(gomock.Any(), testUser.Id).
+			Return(false, errors.New("test error"))
+
+		a := attempts.NewMockUseCase(ctrl)
+
+		a.EXPECT().
+			Check(gomock.Any(), testUser.Login, testIP).
+			Return(int64(0), nil)
 
+		userHandlers.Attempts = a
 		userHandlers.UserUC = m
 
-		apitest.New("Create-LoginExists").
+		apitest.New("LoginTwoFactor-SettingsError").
 			Handler(middlewareMock).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/login").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "code": "%s"}`, testUser.Login, testUser.Password, testInput.Code)).
 			Expect(t).
-			Body(`{"login_exists":true, "email_exists":false}`).
-			Status(http.StatusConflict).
+			Status(http.StatusInternalServerError).
 			End()
 	})
+}
 
-	t.Run("Create-ExistsFull", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
-
+func TestTwoFactorSettings(t *testing.T) {
+	t.Run("SetupTwoFactor-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		inputData := testUser
-		inputData.Id = ""
-		inputData.Image = ""
+		setup := models.TwoFactorSetup{
+			Secret: "JBSWY3DPEHPK3PXP",
+			URI:    "otpauth://totp/VirusMusic:nnnagibator?secret=JBSWY3DPEHPK3PXP",
+		}
 
 		m.EXPECT().
-			Create(inputData).
-			Return(user.FULL, nil)
+			SetupTwoFactor(gomock.Any(), testUser).
+			Return(setup, nil)
 
 		userHandlers.UserUC = m
 
-		apitest.New("Create-ExistsFull").
-			Handler(middlewareMock).
+		apitest.New("SetupTwoFactor-OK").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.SetupTwoFactor, true, testUser, "")).
 			Method("Post").
-			URL("/signup").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
-				testUser.Login,
-				testUser.Password,
-				testUser.Email,
-				testUser.Sex,
-				testUser.Name,
-			)).
+			URL("/users/2fa").
 			Expect(t).
-			Body(`{"login_exists":true, "email_exists":true}`).
-			Status(http.StatusConflict).
+			Body(fmt.Sprintf(`{"secret": "%s", "uri": "%s"}`, setup.Secret, setup.URI)).
+			Status(http.StatusOK).
 			End()
 	})
-}
-
-func TestSelfProfile(t *testing.T) {
-	t.Run("SelfProfile-OK", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.SelfProfile, true, testUser, "")
 
+	t.Run("SetupTwoFactor-Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		profile := models.User{
-			Id:    testUser.Id,
-			Name:  testUser.Name,
-			Login: testUser.Login,
-			Sex:   testUser.Sex,
-			Image: testUser.Image,
-			Email: testUser.Email,
-		}
-
 		m.EXPECT().
-			GetOutputUserData(testUser).
-			Return(profile)
+			SetupTwoFactor(gomock.Any(), testUser).
+			Return(models.TwoFactorSetup{}, errors.New("test error"))
 
 		userHandlers.UserUC = m
 
-		apitest.New("SelfProfile-OK").
-			Handler(middlewareMock).
-			Method("Get").
-			URL("/profile/me").
+		apitest.New("SetupTwoFactor-Error").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.SetupTwoFactor, true, testUser, "")).
+			Method("Post").
+			URL("/users/2fa").
 			Expect(t).
-			Body(fmt.Sprintf(`{"id":"%s", "name":"%s", "login":"%s", "sex":"%s", "image":"%s", "email":"%s"}`,
-				profile.Id,
-				profile.Name,
-				profile.Login,
-				profile.Sex,
-				profile.Image,
-				profile.Email,
-			)).
-			Status(http.StatusOK).
+			Status(http.StatusInternalServerError).
 			End()
 	})
-}
-
-func TestGetProfile(t *testing.T) {
-	t.Run("GetProfile-OK", func(t *testing.T) {
-		middlewareMock := middleware.SetMuxVars(userHandlers.Profile, "profile", testUser.Login)
 
+	t.Run("EnableTwoFactor-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		profile := models.User{
-			Id:    testUser.Id,
+		codes := models.RecoveryCodes{Codes: []string{"12345-abcde", "67890-fghij"}}
+
+		m.EXPECT().
+			EnableTwoFactor(gomock.Any(), testUser, "123456").
+			Return(codes, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("EnableTwoFactor-OK").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.EnableTwoFactor, true, testUser, "")).
+			Method("Post").
+			URL("/users/2fa/confirm").
+			Body(`{"code": "123456"}`).
+			Expect(t).
+			Body(`{"recovery_codes": ["12345-abcde", "67890-fghij"]}`).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("EnableTwoFactor-WrongCode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		m.EXPECT().
+			EnableTwoFactor(gomock.Any(), testUser, "000000").
+			Return(models.RecoveryCodes{}, errors.New("wrong two factor code"))
+
+		userHandlers.UserUC = m
+
+		apitest.New("EnableTwoFactor-WrongCode").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.EnableTwoFactor, true, testUser, "")).
+			Method("Post").
+			URL("/users/2fa/confirm").
+			Body(`{"code": "000000"}`).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("DisableTwoFactor-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		m.EXPECT().
+			DisableTwoFactor(gomock.Any(), testUser, testUser.Password).
+			Return(nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("DisableTwoFactor-OK").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DisableTwoFactor, true, testUser, "")).
+			Method("Delete").
+			URL("/users/2fa").
+			Body(fmt.Sprintf(`{"password": "%s"}`, testUser.Password)).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("DisableTwoFactor-WrongPassword", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		m.EXPECT().
+			DisableTwoFactor(gomock.Any(), testUser, "wrong").
+			Return(errors.New("wrong password"))
+
+		userHandlers.UserUC = m
+
+		apitest.New("DisableTwoFactor-WrongPassword").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DisableTwoFactor, true, testUser, "")).
+			Method("Delete").
+			URL("/users/2fa").
+			Body(`{"password": "wrong"}`).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Create-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+		s := session.NewMockAuthCheckerClient(ctrl)
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.NO, nil)
+
+		s.EXPECT().
+			Create(gomock.Any(), &session.Session{Login: testUser.Login}).
+			Return(&session.SessionID{}, nil)
+
+		userHandlers.UserUC = m
+		userHandlers.SessionDelivery = s
+
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		apitest.New("Create-OK").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Status(http.StatusCreated).
+			End()
+	})
+	t.Run("Create-InvalidFields", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userHandlers.UserUC = user.NewMockUseCase(ctrl)
+		userHandlers.SessionDelivery = session.NewMockAuthCheckerClient(ctrl)
+
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		apitest.New("Create-InvalidFields").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "qwerty", "email":"mail.ru", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			Body(`{"error":{"code":"validation","message":"invalid fields: password, email","fields":[` +
+				`{"field":"password","message":"must be at least 8 characters"},` +
+				`{"field":"email","message":"must be a valid email address"}]}}`).
+			End()
+	})
+	t.Run("Create-ErrorJSON", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		apitest.New("Create-ErrorJSON").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(`{"loginsdf":sdf%sSkd}`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("Create-auth", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, true, testUser, "")
+
+		apitest.New("Create-auth").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+	t.Run("Create-UseCaseError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		testError := errors.New("test error")
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.NO, testError)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Create-UseCaseError").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("Create-SessionError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+		s := session.NewMockAuthCheckerClient(ctrl)
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		testError := errors.New("test error")
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.NO, nil)
+
+		s.EXPECT().
+			Create(gomock.Any(), &session.Session{Login: inputData.Login}).
+			Return(&session.SessionID{}, testError)
+
+		userHandlers.UserUC = m
+		userHandlers.SessionDelivery = s
+
+		apitest.New("Create-SessionError").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("Create-EmailExists", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.EMAIL, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Create-EmailExists").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Body(`{"login_exists":false, "email_exists":true}`).
+			Status(http.StatusConflict).
+			End()
+	})
+
+	t.Run("Create-LoginExists", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.LOGIN, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Create-LoginExists").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Body(`{"login_exists":true, "email_exists":false}`).
+			Status(http.StatusConflict).
+			End()
+	})
+
+	t.Run("Create-ExistsFull", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Create, false, models.User{}, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := testUser
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Create(gomock.Any(), inputData).
+			Return(user.FULL, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Create-ExistsFull").
+			Handler(middlewareMock).
+			Method("Post").
+			URL("/signup").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s"}`,
+				testUser.Login,
+				testUser.Password,
+				testUser.Email,
+				testUser.Sex,
+				testUser.Name,
+			)).
+			Expect(t).
+			Body(`{"login_exists":true, "email_exists":true}`).
+			Status(http.StatusConflict).
+			End()
+	})
+}
+
+func TestSelfProfile(t *testing.T) {
+	t.Run("SelfProfile-OK", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.SelfProfile, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		profile := models.User{
+			Id:    testUser.Id,
+			Name:  testUser.Name,
+			Login: testUser.Login,
+			Sex:   testUser.Sex,
+			Image: testUser.Image,
+			Email: testUser.Email,
+			Role:  models.RoleListener,
+		}
+
+		m.EXPECT().
+			GetOutputUserData(testUser).
+			Return(profile)
+
+		userHandlers.UserUC = m
+
+		apitest.New("SelfProfile-OK").
+			Handler(middlewareMock).
+			Method("Get").
+			URL("/profile/me").
+			Expect(t).
+			Body(fmt.Sprintf(`{"id":"%s", "name":"%s", "login":"%s", "sex":"%s", "image":"%s", "email":"%s", "role":"%s"}`,
+				profile.Id,
+				profile.Name,
+				profile.Login,
+				profile.Sex,
+				profile.Image,
+				profile.Email,
+				profile.Role,
+			)).
+			Status(http.StatusOK).
+			End()
+	})
+}
+
+func TestGetProfile(t *testing.T) {
+	t.Run("GetProfile-OK", func(t *testing.T) {
+		middlewareMock := middleware.SetMuxVars(userHandlers.Profile, "profile", testUser.Login)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		profile := models.User{
+			Id:    testUser.Id,
 			Name:  testUser.Name,
 			Login: testUser.Login,
 			Sex:   testUser.Sex,
 			Image: testUser.Image,
 			Email: testUser.Email,
+			Role:  models.RoleListener,
+		}
+
+		m.EXPECT().
+			GetProfileByLogin(gomock.Any(), testUser.Login).
+			Return(profile, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("GetProfile-OK").
+			Handler(middlewareMock).
+			Method("Get").
+			URL("/profile/keklol").
+			Expect(t).
+			Body(fmt.Sprintf(`{"id":"%s", "name":"%s", "login":"%s", "sex":"%s", "image":"%s", "email":"%s", "role":"%s"}`,
+				profile.Id,
+				profile.Name,
+				profile.Login,
+				profile.Sex,
+				profile.Image,
+				profile.Email,
+				profile.Role,
+			)).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetProfile-UseCaseError", func(t *testing.T) {
+		middlewareMock := middleware.SetMuxVars(userHandlers.Profile, "profile", testUser.Login)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		testError := errors.New("testError")
+
+		m.EXPECT().
+			GetProfileByLogin(gomock.Any(), testUser.Login).
+			Return(models.User{}, testError)
+
+		userHandlers.UserUC = m
+
+		apitest.New("GetProfile-UseCaseError").
+			Handler(middlewareMock).
+			Method("Get").
+			URL("/profile/keklol").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetProfile-NoMuxVars", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apitest.New("GetProfile-NoMuxVars").
+			Handler(http.HandlerFunc(userHandlers.Profile)).
+			Method("Get").
+			URL("/profile/keklol").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestLogout(t *testing.T) {
+	t.Run("Logout-OK", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s := session.NewMockAuthCheckerClient(ctrl)
+
+		cookieValue := "89273894cjawiue983nc29384c2n23cu9"
+
+		s.EXPECT().
+			Delete(gomock.Any(), &session.SessionID{ID: cookieValue}).
+			Return(&session.Nothing{}, nil)
+
+		userHandlers.SessionDelivery = s
+
+		apitest.New("Logout-OK").
+			Handler(middlewareMock).
+			Method("Delete").
+			URL("/logout").
+			Cookie("session_id", cookieValue).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("Logout-NotAuth", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, false, models.User{}, "")
+
+		apitest.New("Logout-NotAuth").
+			Handler(middlewareMock).
+			Method("Delete").
+			URL("/logout").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("Logout-SessionDeleteError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s := session.NewMockAuthCheckerClient(ctrl)
+
+		cookieValue := "89273894cjawiue983nc29384c2n23cu9"
+		testError := errors.New("test error")
+
+		s.EXPECT().
+			Delete(gomock.Any(), &session.SessionID{ID: cookieValue}).
+			Return(&session.Nothing{}, testError)
+
+		userHandlers.SessionDelivery = s
+
+		apitest.New("Logout-SessionDeleteError").
+			Handler(middlewareMock).
+			Method("Delete").
+			URL("/logout").
+			Cookie("session_id", cookieValue).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Update-OK", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := models.UserSettings{
+			NewPassword: "23hf9823d9123d",
+			User:        testUser,
+		}
+		inputData.Id = ""
+		inputData.Image = ""
+
+		m.EXPECT().
+			Update(gomock.Any(), testUser, inputData).
+			Return(user.NO, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Update-OK").
+			Handler(middlewareMock).
+			Method("Put").
+			URL("/users/settings").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
+				inputData.Login,
+				inputData.Password,
+				inputData.Email,
+				inputData.Sex,
+				inputData.Name,
+				inputData.NewPassword,
+			)).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("Update-BadInput", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
+
+		apitest.New("Update-BadInput").
+			Handler(middlewareMock).
+			Method("Put").
+			URL("/users/settings").
+			Body(`{askd:"oaskdoepkwr23r:,kapod}"`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("Update-EmailExists", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := models.UserSettings{
+			NewPassword: "23hf9823d9123d",
+			User:        testUser,
 		}
+		inputData.Id = ""
+		inputData.Email = "exists@email.true"
+		inputData.Image = ""
 
 		m.EXPECT().
-			GetProfileByLogin(testUser.Login).
-			Return(profile, nil)
+			Update(gomock.Any(), testUser, inputData).
+			Return(user.EMAIL, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Update-EmailExists").
+			Handler(middlewareMock).
+			Method("Put").
+			URL("/users/settings").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
+				inputData.Login,
+				inputData.Password,
+				inputData.Email,
+				inputData.Sex,
+				inputData.Name,
+				inputData.NewPassword,
+			)).
+			Expect(t).
+			Status(http.StatusConflict).
+			End()
+	})
+
+	t.Run("Update-UseCaseError", func(t *testing.T) {
+		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		inputData := models.UserSettings{
+			NewPassword: "23hf9823d9123d",
+			User:        testUser,
+		}
+		inputData.Id = ""
+		inputData.Image = ""
+
+		testError := errors.New("test error")
+
+		m.EXPECT().
+			Update(gomock.Any(), testUser, inputData).
+			Return(user.NO, testError)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Update-UseCaseError").
+			Handler(middlewareMock).
+			Method("Put").
+			URL("/users/settings").
+			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
+				inputData.Login,
+				inputData.Password,
+				inputData.Email,
+				inputData.Sex,
+				inputData.Name,
+				inputData.NewPassword,
+			)).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("Update-NoCsrf", func(t *testing.T) {
+		apitest.New("Update-NoCsrf").
+			Handler(http.HandlerFunc(userHandlers.Update)).
+			Method("Put").
+			URL("/users/settings").
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
+
+func TestGetUserStat(t *testing.T) {
+	t.Run("GetUserStat-OK", func(t *testing.T) {
+		middlewareMock := middleware.SetMuxVars(userHandlers.GetUserStat, "id", testUser.Id)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		stat := models.UserStat{
+			UserId:    testUser.Id,
+			Tracks:    12,
+			Albums:    4234,
+			Playlists: 5345,
+			Artists:   123,
+		}
+
+		statMarshal, err := json.Marshal(stat)
+		assert.NoError(t, err)
+
+		m.EXPECT().
+			GetUserStat(gomock.Any(), testUser.Id).
+			Return(stat, nil)
 
 		userHandlers.UserUC = m
 
 		apitest.New("GetProfile-OK").
 			Handler(middlewareMock).
 			Method("Get").
-			URL("/profile/keklol").
+			URL("/stat/keklol").
 			Expect(t).
-			Body(fmt.Sprintf(`{"id":"%s", "name":"%s", "login":"%s", "sex":"%s", "image":"%s", "email":"%s"}`,
-				profile.Id,
-				profile.Name,
-				profile.Login,
-				profile.Sex,
-				profile.Image,
-				profile.Email,
-			)).
+			Body(string(statMarshal)).
 			Status(http.StatusOK).
 			End()
 	})
 
-	t.Run("GetProfile-UseCaseError", func(t *testing.T) {
-		middlewareMock := middleware.SetMuxVars(userHandlers.Profile, "profile", testUser.Login)
+	t.Run("GetUserStat-UseCaseError", func(t *testing.T) {
+		middlewareMock := middleware.SetMuxVars(userHandlers.GetUserStat, "id", testUser.Id)
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -513,373 +1270,507 @@ func TestGetProfile(t *testing.T) {
 		testError := errors.New("testError")
 
 		m.EXPECT().
-			GetProfileByLogin(testUser.Login).
-			Return(models.User{}, testError)
+			GetUserStat(gomock.Any(), testUser.Id).
+			Return(models.UserStat{}, testError)
 
 		userHandlers.UserUC = m
 
 		apitest.New("GetProfile-UseCaseError").
 			Handler(middlewareMock).
 			Method("Get").
-			URL("/profile/keklol").
+			URL("/stat/keklol").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetUserStat-NoMuxVars", func(t *testing.T) {
+		apitest.New("GetProfile-NoMuxVars").
+			Handler(http.HandlerFunc(userHandlers.GetUserStat)).
+			Method("Get").
+			URL("/stat/keklol").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+}
+
+func TestSetRole(t *testing.T) {
+	input := models.UserRole{
+		Role:     models.RoleArtist,
+		ArtistId: "42",
+	}
+
+	t.Run("SetRole-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+		m.EXPECT().
+			SetRole(gomock.Any(), testUser.Id, input).
+			Return(nil)
+
+		userHandlers.UserUC = m
+
+		handler := middleware.SetMuxVars(userHandlers.SetRole, "id", testUser.Id)
+
+		apitest.New("SetRole-OK").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testUser, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("SetRole-UseCaseError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+		m.EXPECT().
+			SetRole(gomock.Any(), testUser.Id, input).
+			Return(errors.New("testError"))
+
+		userHandlers.UserUC = m
+
+		handler := middleware.SetMuxVars(userHandlers.SetRole, "id", testUser.Id)
+
+		apitest.New("SetRole-UseCaseError").
+			Handler(middleware.AuthMiddlewareMock(handler, true, testUser, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("SetRole-NoMuxVars", func(t *testing.T) {
+		apitest.New("SetRole-NoMuxVars").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.SetRole, true, testUser, "")).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	t.Run("SetRole-NoCSRF", func(t *testing.T) {
+		apitest.New("SetRole-NoCSRF").
+			Handler(http.HandlerFunc(userHandlers.SetRole)).
+			Method("Put").
+			JSON(input).
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+}
+
+func TestGetCSRF(t *testing.T) {
+	t.Run("GetCSRF-OK", func(t *testing.T) {
+		sessionId := "asdasdwer6545"
+		handler := middleware.AuthMiddlewareMock(userHandlers.GetCSRF, true, models.User{}, sessionId)
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := csrf.NewMockUseCase(ctrl)
+
+		token := "jieimrc2mu49muc9824cu924cum9"
+
+		m.EXPECT().
+			Create(sessionId, gomock.Any()).
+			Return(token, nil)
+
+		userHandlers.CSRF = m
+
+		apitest.New("GetCSRF-OK").
+			Handler(handler).
+			Method("Get").
+			URL("/users/token").
+			Expect(t).
+			Header("Csrf-Token", token).
+			Status(http.StatusOK).
+			End()
+	})
+
+	t.Run("GetUserStat-UseCaseError", func(t *testing.T) {
+		sessionId := "asdasdwer6545"
+		handler := middleware.AuthMiddlewareMock(userHandlers.GetCSRF, true, models.User{}, sessionId)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := csrf.NewMockUseCase(ctrl)
+
+		testError := errors.New("testError")
+
+		m.EXPECT().
+			Create(sessionId, gomock.Any()).
+			Return("", testError)
+
+		userHandlers.CSRF = m
+
+		apitest.New("GetProfile-UseCaseError").
+			Handler(handler).
+			Method("Get").
+			URL("/users/token").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+
+	t.Run("GetUserStat-NoSession_id", func(t *testing.T) {
+		apitest.New("GetProfile-NoSession_id").
+			Handler(http.HandlerFunc(userHandlers.GetCSRF)).
+			Method("Get").
+			URL("/users/token").
+			Expect(t).
+			Status(http.StatusInternalServerError).
+			End()
+	})
+}
+
+func TestExportData(t *testing.T) {
+	t.Run("ExportData-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		archive := []byte("PK archive")
+
+		m.EXPECT().
+			ExportData(gomock.Any(), testUser).
+			Return(archive, nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("ExportData-OK").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.ExportData, true, testUser, "")).
+			Method("Get").
+			URL("/users/me/export").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusOK).
+			Header("Content-Type", "application/zip").
+			Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, testUser.Login)).
+			Body(string(archive)).
 			End()
 	})
 
-	t.Run("GetProfile-NoMuxVars", func(t *testing.T) {
+	t.Run("ExportData-Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		apitest.New("GetProfile-NoMuxVars").
-			Handler(http.HandlerFunc(userHandlers.Profile)).
+		m := user.NewMockUseCase(ctrl)
+
+		m.EXPECT().
+			ExportData(gomock.Any(), testUser).
+			Return(nil, errors.New("test error"))
+
+		userHandlers.UserUC = m
+
+		apitest.New("ExportData-Error").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.ExportData, true, testUser, "")).
 			Method("Get").
-			URL("/profile/keklol").
+			URL("/users/me/export").
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 }
 
-func TestLogout(t *testing.T) {
-	t.Run("Logout-OK", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, true, testUser, "")
-
+func TestDeleteAccount(t *testing.T) {
+	t.Run("DeleteAccount-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		m := user.NewMockUseCase(ctrl)
 		s := session.NewMockAuthCheckerClient(ctrl)
 
-		cookieValue := "89273894cjawiue983nc29384c2n23cu9"
-
-		s.EXPECT().
-			Delete(context.TODO(), &session.SessionID{ID: cookieValue}).
-			Return(&session.Nothing{}, nil)
+		//sessions are revoked before the account is deleted
+		gomock.InOrder(
+			m.EXPECT().
+				CheckUserPassword(testUser.Password, testUser.Password).
+				Return(nil),
+			s.EXPECT().
+				DeleteAll(gomock.Any(), &session.Session{Login: testUser.Login}).
+				Return(&session.Nothing{Dummy: true}, nil),
+			m.EXPECT().
+				DeleteAccount(gomock.Any(), testUser).
+				Return(nil),
+		)
 
+		userHandlers.UserUC = m
 		userHandlers.SessionDelivery = s
 
-		apitest.New("Logout-OK").
-			Handler(middlewareMock).
+		apitest.New("DeleteAccount-OK").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DeleteAccount, true, testUser, "")).
 			Method("Delete").
-			URL("/logout").
-			Cookie("session_id", cookieValue).
+			URL("/users/me").
+			Body(fmt.Sprintf(`{"password": "%s"}`, testUser.Password)).
 			Expect(t).
 			Status(http.StatusOK).
+			CookiePresent("session_id").
 			End()
 	})
 
-	t.Run("Logout-NotAuth", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, false, models.User{}, "")
+	t.Run("DeleteAccount-SessionsError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		apitest.New("Logout-NotAuth").
-			Handler(middlewareMock).
+		m := user.NewMockUseCase(ctrl)
+		s := session.NewMockAuthCheckerClient(ctrl)
+
+		m.EXPECT().
+			CheckUserPassword(testUser.Password, testUser.Password).
+			Return(nil)
+		s.EXPECT().
+			DeleteAll(gomock.Any(), &session.Session{Login: testUser.Login}).
+			Return(nil, errors.New("test error"))
+
+		userHandlers.UserUC = m
+		userHandlers.SessionDelivery = s
+
+		apitest.New("DeleteAccount-SessionsError").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DeleteAccount, true, testUser, "")).
 			Method("Delete").
-			URL("/logout").
+			URL("/users/me").
+			Body(fmt.Sprintf(`{"password": "%s"}`, testUser.Password)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
 
-	t.Run("Logout-SessionDeleteError", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Logout, true, testUser, "")
-
+	t.Run("DeleteAccount-Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		m := user.NewMockUseCase(ctrl)
 		s := session.NewMockAuthCheckerClient(ctrl)
 
-		cookieValue := "89273894cjawiue983nc29384c2n23cu9"
-		testError := errors.New("test error")
-
+		m.EXPECT().
+			CheckUserPassword(testUser.Password, testUser.Password).
+			Return(nil)
 		s.EXPECT().
-			Delete(context.TODO(), &session.SessionID{ID: cookieValue}).
-			Return(&session.Nothing{}, testError)
+			DeleteAll(gomock.Any(), &session.Session{Login: testUser.Login}).
+			Return(&session.Nothing{Dummy: true}, nil)
+		m.EXPECT().
+			DeleteAccount(gomock.Any(), testUser).
+			Return(errors.New("test error"))
 
+		userHandlers.UserUC = m
 		userHandlers.SessionDelivery = s
 
-		apitest.New("Logout-SessionDeleteError").
-			Handler(middlewareMock).
+		apitest.New("DeleteAccount-Error").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DeleteAccount, true, testUser, "")).
 			Method("Delete").
-			URL("/logout").
-			Cookie("session_id", cookieValue).
+			URL("/users/me").
+			Body(fmt.Sprintf(`{"password": "%s"}`, testUser.Password)).
 			Expect(t).
-			Status(http.StatusBadRequest).
+			Status(http.StatusInternalServerError).
 			End()
 	})
-}
-
-func TestUpdate(t *testing.T) {
-	t.Run("Update-OK", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
 
+	t.Run("DeleteAccount-WrongPassword", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		inputData := models.UserSettings{
-			NewPassword: "23hf9823d9123d",
-			User:        testUser,
-		}
-		inputData.Id = ""
-		inputData.Image = ""
-
 		m.EXPECT().
-			Update(testUser, inputData).
-			Return(user.NO, nil)
+			CheckUserPassword(testUser.Password, "wrong").
+			Return(errors.New("wrong password"))
 
 		userHandlers.UserUC = m
 
-		apitest.New("Update-OK").
-			Handler(middlewareMock).
-			Method("Put").
-			URL("/users/settings").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
-				inputData.Login,
-				inputData.Password,
-				inputData.Email,
-				inputData.Sex,
-				inputData.Name,
-				inputData.NewPassword,
-			)).
+		apitest.New("DeleteAccount-WrongPassword").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DeleteAccount, true, testUser, "")).
+			Method("Delete").
+			URL("/users/me").
+			Body(`{"password": "wrong"}`).
 			Expect(t).
-			Status(http.StatusOK).
+			Status(http.StatusForbidden).
 			End()
 	})
 
-	t.Run("Update-BadInput", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
-
-		apitest.New("Update-BadInput").
-			Handler(middlewareMock).
-			Method("Put").
-			URL("/users/settings").
-			Body(`{askd:"oaskdoepkwr23r:,kapod}"`).
+	t.Run("DeleteAccount-BadJSON", func(t *testing.T) {
+		apitest.New("DeleteAccount-BadJSON").
+			Handler(middleware.AuthMiddlewareMock(userHandlers.DeleteAccount, true, testUser, "")).
+			Method("Delete").
+			URL("/users/me").
+			Body(`{"password": `).
 			Expect(t).
 			Status(http.StatusBadRequest).
 			End()
 	})
+}
 
-	t.Run("Update-EmailExists", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
-
+func TestFollow(t *testing.T) {
+	t.Run("Follow-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		inputData := models.UserSettings{
-			NewPassword: "23hf9823d9123d",
-			User:        testUser,
-		}
-		inputData.Id = ""
-		inputData.Email = "exists@email.true"
-		inputData.Image = ""
-
 		m.EXPECT().
-			Update(testUser, inputData).
-			Return(user.EMAIL, nil)
+			Follow(gomock.Any(), testUser, "42").
+			Return(nil)
 
 		userHandlers.UserUC = m
 
-		apitest.New("Update-EmailExists").
-			Handler(middlewareMock).
-			Method("Put").
-			URL("/users/settings").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
-				inputData.Login,
-				inputData.Password,
-				inputData.Email,
-				inputData.Sex,
-				inputData.Name,
-				inputData.NewPassword,
-			)).
+		apitest.New("Follow-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(userHandlers.Follow, "id", "42"), true, testUser, "")).
+			Method("Post").
+			URL("/users/42/follow").
 			Expect(t).
-			Status(http.StatusConflict).
+			Status(http.StatusOK).
 			End()
 	})
 
-	t.Run("Update-UseCaseError", func(t *testing.T) {
-		middlewareMock := middleware.AuthMiddlewareMock(userHandlers.Update, true, testUser, "")
-
+	t.Run("Follow-Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		inputData := models.UserSettings{
-			NewPassword: "23hf9823d9123d",
-			User:        testUser,
-		}
-		inputData.Id = ""
-		inputData.Image = ""
-
-		testError := errors.New("test error")
-
 		m.EXPECT().
-			Update(testUser, inputData).
-			Return(user.NO, testError)
+			Follow(gomock.Any(), testUser, testUser.Id).
+			Return(errors.New("can't follow yourself"))
 
 		userHandlers.UserUC = m
 
-		apitest.New("Update-UseCaseError").
-			Handler(middlewareMock).
-			Method("Put").
-			URL("/users/settings").
-			Body(fmt.Sprintf(`{"login": "%s", "password": "%s", "email":"%s", "sex":"%s", "name":"%s", "new_password":"%s"}`,
-				inputData.Login,
-				inputData.Password,
-				inputData.Email,
-				inputData.Sex,
-				inputData.Name,
-				inputData.NewPassword,
-			)).
+		apitest.New("Follow-Error").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(userHandlers.Follow, "id", testUser.Id), true, testUser, "")).
+			Method("Post").
+			URL("/users/1234/follow").
 			Expect(t).
 			Status(http.StatusBadRequest).
 			End()
 	})
 
-	t.Run("Update-NoCsrf", func(t *testing.T) {
-		apitest.New("Update-NoCsrf").
-			Handler(http.HandlerFunc(userHandlers.Update)).
-			Method("Put").
-			URL("/users/settings").
+	t.Run("Unfollow-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockUseCase(ctrl)
+
+		m.EXPECT().
+			Unfollow(gomock.Any(), testUser, "42").
+			Return(nil)
+
+		userHandlers.UserUC = m
+
+		apitest.New("Unfollow-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(userHandlers.Unfollow, "id", "42"), true, testUser, "")).
+			Method("Delete").
+			URL("/users/42/follow").
 			Expect(t).
-			Status(http.StatusUnauthorized).
+			Status(http.StatusOK).
 			End()
 	})
 }
 
-func TestGetUserStat(t *testing.T) {
-	t.Run("GetUserStat-OK", func(t *testing.T) {
-		middlewareMock := middleware.SetMuxVars(userHandlers.GetUserStat, "id", testUser.Id)
+func TestGetFollowers(t *testing.T) {
+	users := []models.UserPreview{{Id: "42", Login: "follower", Name: "Follower", Image: "/img/42.png"}}
 
+	t.Run("GetFollowers-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		stat := models.UserStat{
-			UserId:    testUser.Id,
-			Tracks:    12,
-			Albums:    4234,
-			Playlists: 5345,
-			Artists:   123,
-		}
-
-		statMarshal, err := json.Marshal(stat)
-		assert.NoError(t, err)
-
 		m.EXPECT().
-			GetUserStat(testUser.Id).
-			Return(stat, nil)
+			GetFollowers(gomock.Any(), testUser.Id, uint64(0), uint64(10)).
+			Return(users, nil)
 
 		userHandlers.UserUC = m
 
-		apitest.New("GetProfile-OK").
-			Handler(middlewareMock).
+		boundedVars := middleware.BoundedVars(userHandlers.GetFollowers, userHandlers.Log)
+
+		apitest.New("GetFollowers-OK").
+			Handler(middleware.SetTripleVars(boundedVars, testUser.Id, "0", "10")).
 			Method("Get").
-			URL("/stat/keklol").
+			URL("/users/1234/followers/0/10").
 			Expect(t).
-			Body(string(statMarshal)).
 			Status(http.StatusOK).
+			Body(`{"id":"1234","users":[{"id":"42","login":"follower","name":"Follower","image":"/img/42.png"}]}`).
 			End()
 	})
 
-	t.Run("GetUserStat-UseCaseError", func(t *testing.T) {
-		middlewareMock := middleware.SetMuxVars(userHandlers.GetUserStat, "id", testUser.Id)
-
+	t.Run("GetFollowing-Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		m := user.NewMockUseCase(ctrl)
 
-		testError := errors.New("testError")
-
 		m.EXPECT().
-			GetUserStat(testUser.Id).
-			Return(models.UserStat{}, testError)
+			GetFollowing(gomock.Any(), testUser.Id, uint64(0), uint64(10)).
+			Return(nil, errors.New("test error"))
 
 		userHandlers.UserUC = m
 
-		apitest.New("GetProfile-UseCaseError").
-			Handler(middlewareMock).
-			Method("Get").
-			URL("/stat/keklol").
-			Expect(t).
-			Status(http.StatusBadRequest).
-			End()
-	})
+		boundedVars := middleware.BoundedVars(userHandlers.GetFollowing, userHandlers.Log)
 
-	t.Run("GetUserStat-NoMuxVars", func(t *testing.T) {
-		apitest.New("GetProfile-NoMuxVars").
-			Handler(http.HandlerFunc(userHandlers.GetUserStat)).
+		apitest.New("GetFollowing-Error").
+			Handler(middleware.SetTripleVars(boundedVars, testUser.Id, "0", "10")).
 			Method("Get").
-			URL("/stat/keklol").
+			URL("/users/1234/following/0/10").
 			Expect(t).
 			Status(http.StatusBadRequest).
 			End()
 	})
 }
 
-func TestGetCSRF(t *testing.T) {
-	t.Run("GetCSRF-OK", func(t *testing.T) {
-		sessionId := "asdasdwer6545"
-		handler := middleware.AuthMiddlewareMock(userHandlers.GetCSRF, true, models.User{}, sessionId)
+func TestGetFullProfile(t *testing.T) {
+	viewer := models.User{Id: "42", Login: "viewer"}
+
+	t.Run("GetFullProfile-OK", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		m := csrf.NewMockUseCase(ctrl)
+		m := user.NewMockUseCase(ctrl)
 
-		token := "jieimrc2mu49muc9824cu924cum9"
+		profile := models.UserProfile{
+			User:       models.User{Id: testUser.Id, Login: testUser.Login, Role: models.RoleListener},
+			Stat:       models.UserStat{UserId: testUser.Id, Followers: 1},
+			IsFollowed: true,
+		}
 
 		m.EXPECT().
-			Create(sessionId, gomock.Any()).
-			Return(token, nil)
+			GetFullProfile(gomock.Any(), testUser.Login, viewer).
+			Return(profile, nil)
 
-		userHandlers.CSRF = m
+		userHandlers.UserUC = m
 
-		apitest.New("GetCSRF-OK").
-			Handler(handler).
+		expected, err := json.Marshal(profile)
+		assert.NoError(t, err)
+
+		apitest.New("GetFullProfile-OK").
+			Handler(middleware.AuthMiddlewareMock(middleware.SetMuxVars(userHandlers.GetFullProfile, "profile", testUser.Login), true, viewer, "")).
 			Method("Get").
-			URL("/users/token").
+			URL("/users/profiles/nnnagibator/full").
 			Expect(t).
-			Header("Csrf-Token", token).
 			Status(http.StatusOK).
+			Body(string(expected)).
 			End()
 	})
 
-	t.Run("GetUserStat-UseCaseError", func(t *testing.T) {
-		sessionId := "asdasdwer6545"
-		handler := middleware.AuthMiddlewareMock(userHandlers.GetCSRF, true, models.User{}, sessionId)
-
+	t.Run("GetFullProfile-Anonymous", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		m := csrf.NewMockUseCase(ctrl)
-
-		testError := errors.New("testError")
+		m := user.NewMockUseCase(ctrl)
 
 		m.EXPECT().
-			Create(sessionId, gomock.Any()).
-			Return("", testError)
-
-		userHandlers.CSRF = m
+			GetFullProfile(gomock.Any(), testUser.Login, models.User{}).
+			Return(models.UserProfile{}, errors.New("not found"))
 
-		apitest.New("GetProfile-UseCaseError").
-			Handler(handler).
-			Method("Get").
-			URL("/users/token").
-			Expect(t).
-			Status(http.StatusInternalServerError).
-			End()
-	})
+		userHandlers.UserUC = m
 
-	t.Run("GetUserStat-NoSession_id", func(t *testing.T) {
-		apitest.New("GetProfile-NoSession_id").
-			Handler(http.HandlerFunc(userHandlers.GetCSRF)).
+		apitest.New("GetFullProfile-Anonymous").
+			Handler(middleware.SetMuxVars(userHandlers.GetFullProfile, "profile", testUser.Login)).
 			Method("Get").
-			URL("/users/token").
+			URL("/users/profiles/nnnagibator/full").
 			Expect(t).
 			Status(http.StatusInternalServerError).
 			End()
@@ -1,15 +1,29 @@
 package user
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 )
 
 type Repository interface {
-	Create(user models.User) error
-	Update(user models.User, input models.UserSettings) error
-	UpdateAvatar(user models.User, avatarDir string, fileType string) (string, error)
-	GetUserByLogin(login string) (models.User, error)
-	CheckIfExists(login string, email string) (loginExists bool, emailExists bool, err error)
+	Create(ctx context.Context, user models.User) error
+	Update(ctx context.Context, user models.User, input models.UserSettings) error
+	UpdateAvatar(ctx context.Context, user models.User, avatarDir string, fileType string) (string, error)
+	GetUserByLogin(ctx context.Context, login string) (models.User, error)
+	CheckIfExists(ctx context.Context, login string, email string) (loginExists bool, emailExists bool, err error)
 	CheckUserPassword(userPassword string, inputPassword string) error
-	GetUserStat(id string) (models.UserStat, error)
+	GetUserStat(ctx context.Context, id string) (models.UserStat, error)
+	SetRole(ctx context.Context, uID string, role string, artistID string) error
+	Delete(ctx context.Context, uID string) error
+	Follow(ctx context.Context, followerID string, followedID string) error
+	Unfollow(ctx context.Context, followerID string, followedID string) error
+	IsFollowing(ctx context.Context, followerID string, followedID string) bool
+	GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error)
+	GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error)
+	GetTwoFactor(ctx context.Context, uID string) (secret string, enabled bool, err error)
+	SetTwoFactorSecret(ctx context.Context, uID string, secret string) error
+	EnableTwoFactor(ctx context.Context, uID string, recoveryCodes []string) error
+	DisableTwoFactor(ctx context.Context, uID string) error
+	UseRecoveryCode(ctx context.Context, uID string, code string) (bool, error)
+	UseTotpCounter(ctx context.Context, uID string, counter uint64) (bool, error)
 }
@@ -1,25 +1,42 @@
 package repository
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/database"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"github.com/jinzhu/gorm"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type User struct {
-	Id       uint64 `gorm:"column:id"`
-	Login    string `gorm:"column:login"`
-	Password []byte `gorm:"column:password"`
-	Name     string `gorm:"column:name"`
-	Email    string `gorm:"column:email"`
-	Sex      string `gorm:"column:sex"`
-	Image    string `gorm:"column:image"`
+	Id       uint64  `gorm:"column:id"`
+	Login    string  `gorm:"column:login"`
+	Password []byte  `gorm:"column:password"`
+	Name     string  `gorm:"column:name"`
+	Email    string  `gorm:"column:email"`
+	Sex      string  `gorm:"column:sex"`
+	Image    string  `gorm:"column:image"`
+	Role     string  `gorm:"column:role"`
+	ArtistId *uint64 `gorm:"column:artist_id"`
+}
+
+type TwoFactor struct {
+	UserID  uint64 `gorm:"column:user_id"`
+	Secret  string `gorm:"column:secret"`
+	Enabled bool   `gorm:"column:enabled"`
+}
+
+type RecoveryCode struct {
+	Id     uint64 `gorm:"column:id"`
+	UserID uint64 `gorm:"column:user_id"`
+	Code   []byte `gorm:"column:code"`
+	Used   bool   `gorm:"column:used"`
 }
 
 type DbUserRepository struct {
@@ -34,10 +51,10 @@ func NewDbUserRepository(database *gorm.DB, defaultImage string) DbUserRepositor
 	}
 }
 
-func (ur *DbUserRepository) getUser(login string) (User, error) {
+func (ur *DbUserRepository) getUser(ctx context.Context, login string) (User, error) {
 	var results User
 
-	db := ur.db.
+	db := database.WithContext(ctx, ur.db).
 		Table("users").
 		Where("login = ?", login).
 		Find(&results)
@@ -52,7 +69,7 @@ func (ur *DbUserRepository) getUser(login string) (User, error) {
 func (ur *DbUserRepository) prepareDbUser(user models.User, hash []byte) (User, error) {
 	ok := IsModelFieldsNotEmpty(user)
 	if !ok {
-		return User{}, errors.New("some input fields are empty")
+		return User{}, apperrors.New(apperrors.Validation, "some input fields are empty")
 	}
 	return User{
 		Login:    user.Login,
@@ -61,10 +78,15 @@ func (ur *DbUserRepository) prepareDbUser(user models.User, hash []byte) (User,
 		Email:    user.Email,
 		Sex:      user.Sex,
 		Image:    ur.defaultImage,
+		Role:     models.RoleListener,
 	}, nil
 }
 
 func ToModel(user User) models.User {
+	var artistID string
+	if user.ArtistId != nil {
+		artistID = strconv.FormatUint(*user.ArtistId, 10)
+	}
 	return models.User{
 		Id:       strconv.FormatUint(user.Id, 10),
 		Login:    user.Login,
@@ -73,13 +95,15 @@ func ToModel(user User) models.User {
 		Email:    user.Email,
 		Sex:      user.Sex,
 		Image:    user.Image,
+		Role:     user.Role,
+		ArtistId: artistID,
 	}
 }
 
-func (ur *DbUserRepository) Create(user models.User) error {
+func (ur *DbUserRepository) Create(ctx context.Context, user models.User) error {
 	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.MinCost)
 	if err != nil {
-		return fmt.Errorf("error while password hashing: %v", err)
+		return fmt.Errorf("error while password hashing: %w", err)
 	}
 
 	dbUser, err := ur.prepareDbUser(user, hash)
@@ -87,7 +111,7 @@ func (ur *DbUserRepository) Create(user models.User) error {
 		return err
 	}
 
-	db := ur.db.Create(&dbUser)
+	db := database.WithContext(ctx, ur.db).Create(&dbUser)
 	err = db.Error
 	if err != nil {
 		return err
@@ -95,8 +119,8 @@ func (ur *DbUserRepository) Create(user models.User) error {
 	return nil
 }
 
-func (ur *DbUserRepository) Update(user models.User, input models.UserSettings) error {
-	dbUser, err := ur.getUser(user.Login)
+func (ur *DbUserRepository) Update(ctx context.Context, user models.User, input models.UserSettings) error {
+	dbUser, err := ur.getUser(ctx, user.Login)
 	if err != nil {
 		return err
 	}
@@ -105,49 +129,49 @@ func (ur *DbUserRepository) Update(user models.User, input models.UserSettings)
 
 	if input.NewPassword != "" {
 		if err := bcrypt.CompareHashAndPassword(dbUser.Password, []byte(input.Password)); err != nil {
-			return fmt.Errorf("old password is wrong : %v", err)
+			return apperrors.Errorf(apperrors.Forbidden, "old password is wrong : %w", err)
 		}
 		hash, err = bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.MinCost)
 		if err != nil {
-			return fmt.Errorf("error while password hashing: %v", err)
+			return fmt.Errorf("error while password hashing: %w", err)
 		}
 		dbUser.Password = hash
 	}
 	dbUser.Name = input.Name
 	dbUser.Email = input.Email
 
-	db := ur.db.Save(&dbUser)
+	db := database.WithContext(ctx, ur.db).Save(&dbUser)
 
 	return db.Error
 }
 
-func (ur *DbUserRepository) UpdateAvatar(user models.User, avatarDir string, fileName string) (string, error) {
+func (ur *DbUserRepository) UpdateAvatar(ctx context.Context, user models.User, avatarDir string, fileName string) (string, error) {
 
 	serverFilePath := os.Getenv("FILE_SERVER") + filepath.Join(avatarDir, fileName)
 
-	db := ur.db.
+	db := database.WithContext(ctx, ur.db).
 		Model(&user).
 		Update("image", serverFilePath)
 
 	err := db.Error
 	if err != nil {
-		return "", fmt.Errorf("failed to update user: %v", err)
+		return "", fmt.Errorf("failed to update user: %w", err)
 	}
 
 	return serverFilePath, nil
 }
 
-func (ur *DbUserRepository) GetUserByLogin(login string) (models.User, error) {
-	dbUser, err := ur.getUser(login)
+func (ur *DbUserRepository) GetUserByLogin(ctx context.Context, login string) (models.User, error) {
+	dbUser, err := ur.getUser(ctx, login)
 	if err != nil {
 		return models.User{}, err
 	}
 	return ToModel(dbUser), nil
 }
 
-func (ur *DbUserRepository) CheckIfExists(login string, email string) (loginExists bool, emailExists bool, err error) {
+func (ur *DbUserRepository) CheckIfExists(ctx context.Context, login string, email string) (loginExists bool, emailExists bool, err error) {
 	var results []User
-	db := ur.db.
+	db := database.WithContext(ctx, ur.db).
 		Raw("SELECT login, email FROM users WHERE login=? or email=?", login, email).
 		Scan(&results)
 
@@ -173,15 +197,15 @@ func (ur *DbUserRepository) CheckIfExists(login string, email string) (loginExis
 
 func (ur *DbUserRepository) CheckUserPassword(userPassword string, InputPassword string) error {
 	if err := bcrypt.CompareHashAndPassword([]byte(userPassword), []byte(InputPassword)); err != nil {
-		return errors.New("wrong password")
+		return apperrors.New(apperrors.Forbidden, "wrong password")
 	}
 	return nil
 }
 
-func (ur *DbUserRepository) GetUserStat(id string) (models.UserStat, error) {
+func (ur *DbUserRepository) GetUserStat(ctx context.Context, id string) (models.UserStat, error) {
 	var stat models.UserStat
 
-	db := ur.db.
+	db := database.WithContext(ctx, ur.db).
 		Table("user_stat").
 		Where("user_id = ?", id).
 		Find(&stat)
@@ -193,6 +217,231 @@ func (ur *DbUserRepository) GetUserStat(id string) (models.UserStat, error) {
 	return stat, nil
 }
 
+func (ur *DbUserRepository) SetRole(ctx context.Context, uID string, role string, artistID string) error {
+	var artist interface{}
+	if artistID != "" {
+		artist = artistID
+	}
+
+	db := database.WithContext(ctx, ur.db).Exec("update users set role = ?, artist_id = ? where id = ?", role, artist, uID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to set role: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.NotFound, "user not found")
+	}
+	return nil
+}
+
+func (ur *DbUserRepository) Follow(ctx context.Context, followerID string, followedID string) error {
+	db := database.WithContext(ctx, ur.db).Exec("insert into user_follows (follower_id, followed_id) values (?, ?) on conflict do nothing",
+		followerID, followedID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to insert in user_follows: %w", err)
+	}
+	return nil
+}
+
+func (ur *DbUserRepository) Unfollow(ctx context.Context, followerID string, followedID string) error {
+	db := database.WithContext(ctx, ur.db).Exec("delete from user_follows where follower_id = ? and followed_id = ?", followerID, followedID)
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to delete in user_follows: %w", err)
+	}
+	return nil
+}
+
+func (ur *DbUserRepository) IsFollowing(ctx context.Context, followerID string, followedID string) bool {
+	var count int
+
+	db := database.WithContext(ctx, ur.db).
+		Table("user_follows").
+		Where("follower_id = ? and followed_id = ?", followerID, followedID).
+		Count(&count)
+
+	return db.Error == nil && count > 0
+}
+
+func (ur *DbUserRepository) GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	return ur.getBoundedFollows(ctx, "user_followers", uID, start, end)
+}
+
+func (ur *DbUserRepository) GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	return ur.getBoundedFollows(ctx, "user_following", uID, start, end)
+}
+
+func (ur *DbUserRepository) getBoundedFollows(ctx context.Context, view string, uID string, start, end uint64) ([]models.UserPreview, error) {
+	var users []models.UserPreview
+	limit := end - start
+
+	db := database.WithContext(ctx, ur.db).
+		Table(view).
+		Where("user_id = ?", uID).
+		Order("created_at desc").
+		Limit(limit).
+		Offset(start).
+		Find(&users)
+
+	if err := db.Error; err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", view, err)
+	}
+	return users, nil
+}
+
+// Delete removes the user row, the rest of user data is removed by cascade
+func (ur *DbUserRepository) Delete(ctx context.Context, uID string) error {
+	tx := database.WithContext(ctx, ur.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := tx.Exec("delete from user_stat where user_id = ?", uID).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete user stat: %w", err)
+	}
+
+	db := tx.Exec("delete from users where id = ?", uID)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.NotFound, "user not found")
+	}
+
+	return tx.Commit().Error
+}
+
+func (ur *DbUserRepository) GetTwoFactor(ctx context.Context, uID string) (secret string, enabled bool, err error) {
+	var twoFactor TwoFactor
+
+	db := database.WithContext(ctx, ur.db).
+		Table("user_two_factor").
+		Where("user_id = ?", uID).
+		Find(&twoFactor)
+
+	err = db.Error
+	if err == gorm.ErrRecordNotFound {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get two factor settings: %w", err)
+	}
+	return twoFactor.Secret, twoFactor.Enabled, nil
+}
+
+func (ur *DbUserRepository) SetTwoFactorSecret(ctx context.Context, uID string, secret string) error {
+	db := database.WithContext(ctx, ur.db).Exec("insert into user_two_factor (user_id, secret, enabled) values (?, ?, false) "+
+		"on conflict (user_id) do update set secret = excluded.secret where user_two_factor.enabled = false", uID, secret)
+
+	if err := db.Error; err != nil {
+		return fmt.Errorf("failed to set two factor secret: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		return apperrors.New(apperrors.Conflict, "two factor authentication is already enabled")
+	}
+	return nil
+}
+
+func (ur *DbUserRepository) EnableTwoFactor(ctx context.Context, uID string, recoveryCodes []string) error {
+	userID, err := strconv.ParseUint(uID, 10, 64)
+	if err != nil {
+		return apperrors.Errorf(apperrors.Validation, "failed to parse uID: %w", err)
+	}
+
+	tx := database.WithContext(ctx, ur.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	db := tx.Exec("update user_two_factor set enabled = true where user_id = ?", userID)
+	if err := db.Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to enable two factor: %w", err)
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return apperrors.New(apperrors.Conflict, "two factor secret is not set")
+	}
+
+	if err := tx.Exec("delete from user_recovery_codes where user_id = ?", userID).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete old recovery codes: %w", err)
+	}
+
+	for _, elem := range recoveryCodes {
+		hash, err := bcrypt.GenerateFromPassword([]byte(elem), bcrypt.MinCost)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error while recovery code hashing: %w", err)
+		}
+		code := RecoveryCode{
+			UserID: userID,
+			Code:   hash,
+		}
+		if err := tx.Table("user_recovery_codes").Create(&code).Error; err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to save recovery code: %w", err)
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (ur *DbUserRepository) DisableTwoFactor(ctx context.Context, uID string) error {
+	tx := database.WithContext(ctx, ur.db).Begin()
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := tx.Exec("delete from user_recovery_codes where user_id = ?", uID).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if err := tx.Exec("delete from user_two_factor where user_id = ?", uID).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to disable two factor: %w", err)
+	}
+
+	return tx.Commit().Error
+}
+
+func (ur *DbUserRepository) UseRecoveryCode(ctx context.Context, uID string, code string) (bool, error) {
+	var codes []RecoveryCode
+
+	db := database.WithContext(ctx, ur.db).
+		Table("user_recovery_codes").
+		Where("user_id = ? and used = false", uID).
+		Find(&codes)
+
+	if err := db.Error; err != nil {
+		return false, fmt.Errorf("failed to get recovery codes: %w", err)
+	}
+
+	for _, elem := range codes {
+		if bcrypt.CompareHashAndPassword(elem.Code, []byte(code)) != nil {
+			continue
+		}
+		db = database.WithContext(ctx, ur.db).Exec("update user_recovery_codes set used = true where id = ? and used = false", elem.Id)
+		if err := db.Error; err != nil {
+			return false, fmt.Errorf("failed to use recovery code: %w", err)
+		}
+		return db.RowsAffected == 1, nil
+	}
+	return false, nil
+}
+
+// UseTotpCounter accepts the time step of a valid code only if it is later than the last accepted one,
+// so a code can't be replayed within its validity window
+func (ur *DbUserRepository) UseTotpCounter(ctx context.Context, uID string, counter uint64) (bool, error) {
+	db := database.WithContext(ctx, ur.db).Exec("update user_two_factor set last_counter = ? where user_id = ? and last_counter < ?",
+		counter, uID, counter)
+	if err := db.Error; err != nil {
+		return false, fmt.Errorf("failed to use two factor code: %w", err)
+	}
+	return db.RowsAffected == 1, nil
+}
+
 func IsModelFieldsNotEmpty(user models.User) bool {
 	return len(user.Login) > 0 &&
 		len(user.Password) > 0 &&
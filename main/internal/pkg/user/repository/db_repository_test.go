@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"regexp"
 	"testing"
@@ -36,6 +38,7 @@ func (s *Suite) SetupSuite() {
 		Sex:      "male",
 		Image:    "/img/default/png",
 		Email:    "test@email.test",
+		Role:     models.RoleListener,
 	}
 
 	s.bdError = errors.New("some bd error")
@@ -60,8 +63,8 @@ func TestInit(t *testing.T) {
 
 func (s *Suite) getMockSelectAll(user models.User, hash []byte) {
 	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE (login = $1)`)).WithArgs(user.Login).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "password", "name", "sex", "image", "email"}).
-			AddRow(user.Id, user.Login, hash, user.Name, user.Sex, user.Image, user.Email))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "password", "name", "sex", "image", "email", "role"}).
+			AddRow(user.Id, user.Login, hash, user.Name, user.Sex, user.Image, user.Email, user.Role))
 }
 
 func (s *Suite) TestGetUserByLogin() {
@@ -72,7 +75,7 @@ func (s *Suite) TestGetUserByLogin() {
 
 	s.getMockSelectAll(user, hash)
 
-	getUser, err := s.repository.GetUserByLogin(user.Login)
+	getUser, err := s.repository.GetUserByLogin(context.Background(), user.Login)
 
 	require.NoError(s.T(), err)
 	user.Password = string(hash)
@@ -81,7 +84,7 @@ func (s *Suite) TestGetUserByLogin() {
 	//test on bd error
 	s.mock.ExpectQuery("SELECT").WithArgs(user.Login).WillReturnError(s.bdError)
 
-	_, err = s.repository.GetUserByLogin(user.Login)
+	_, err = s.repository.GetUserByLogin(context.Background(), user.Login)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, s.bdError)
@@ -106,11 +109,11 @@ func (s *Suite) TestUpdate() {
 
 	var id int64 = 1
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, hash, userSettings.Name, userSettings.Email, user.Sex, user.Image, id).
+	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, hash, userSettings.Name, userSettings.Email, user.Sex, user.Image, user.Role, nil, id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	err = s.repository.Update(user, userSettings)
+	err = s.repository.Update(context.Background(), user, userSettings)
 
 	require.NoError(s.T(), err)
 
@@ -120,18 +123,18 @@ func (s *Suite) TestUpdate() {
 	userSettings.NewPassword = "1235jei23"
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, sqlmock.AnyArg(), userSettings.Name, userSettings.Email, user.Sex, user.Image, id).
+	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, sqlmock.AnyArg(), userSettings.Name, userSettings.Email, user.Sex, user.Image, user.Role, nil, id).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	err = s.repository.Update(user, userSettings)
+	err = s.repository.Update(context.Background(), user, userSettings)
 	require.NoError(s.T(), err)
 
 	userSettings.NewPassword = ""
 	//test on first query bd error
 	s.mock.ExpectQuery("SELECT").WithArgs(user.Login).WillReturnError(s.bdError)
 
-	err = s.repository.Update(user, userSettings)
+	err = s.repository.Update(context.Background(), user, userSettings)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, s.bdError)
@@ -140,11 +143,11 @@ func (s *Suite) TestUpdate() {
 	s.getMockSelectAll(user, hash)
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, hash, userSettings.Name, userSettings.Email, user.Sex, user.Image, id).
+	s.mock.ExpectExec("UPDATE").WithArgs(user.Login, hash, userSettings.Name, userSettings.Email, user.Sex, user.Image, user.Role, nil, id).
 		WillReturnError(s.bdError)
 	s.mock.ExpectRollback()
 
-	err = s.repository.Update(user, userSettings)
+	err = s.repository.Update(context.Background(), user, userSettings)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, s.bdError)
@@ -156,29 +159,29 @@ func (s *Suite) TestCreate() {
 	//require.NoError(s.T(), err)
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectQuery("INSERT INTO").WithArgs(user.Login, sqlmock.AnyArg(), user.Name, user.Email, user.Sex, user.Image).
+	s.mock.ExpectQuery("INSERT INTO").WithArgs(user.Login, sqlmock.AnyArg(), user.Name, user.Email, user.Sex, user.Image, user.Role, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(user.Id))
 	s.mock.ExpectCommit()
 
-	err := s.repository.Create(user)
+	err := s.repository.Create(context.Background(), user)
 	require.NoError(s.T(), err)
 
 	//test on empty input
 	user.Email = ""
 
-	err = s.repository.Create(user)
+	err = s.repository.Create(context.Background(), user)
 
-	require.Equal(s.T(), err, errors.New("some input fields are empty"))
+	require.Equal(s.T(), err, apperrors.New(apperrors.Validation, "some input fields are empty"))
 
 	//test on bd error
 	user.Email = "mail@mai.ru"
 
 	s.mock.ExpectBegin()
-	s.mock.ExpectQuery("INSERT INTO").WithArgs(user.Login, sqlmock.AnyArg(), user.Name, user.Email, user.Sex, user.Image).
+	s.mock.ExpectQuery("INSERT INTO").WithArgs(user.Login, sqlmock.AnyArg(), user.Name, user.Email, user.Sex, user.Image, user.Role, nil).
 		WillReturnError(s.bdError)
 	s.mock.ExpectRollback()
 
-	err = s.repository.Create(user)
+	err = s.repository.Create(context.Background(), user)
 	require.Equal(s.T(), err, s.bdError)
 }
 func (s *Suite) TestCheckIfExists() {
@@ -189,7 +192,7 @@ func (s *Suite) TestCheckIfExists() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "email"}).
 			AddRow(user.Id, user.Login, "email").AddRow(user.Id, "login", user.Email))
 
-	loginExists, emailExists, err := s.repository.CheckIfExists(user.Login, user.Email)
+	loginExists, emailExists, err := s.repository.CheckIfExists(context.Background(), user.Login, user.Email)
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), loginExists, true)
 	require.Equal(s.T(), emailExists, true)
@@ -199,7 +202,7 @@ func (s *Suite) TestCheckIfExists() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "email"}).
 			AddRow(user.Id, user.Login, "otherEmail"))
 
-	loginExists, emailExists, err = s.repository.CheckIfExists(user.Login, user.Email)
+	loginExists, emailExists, err = s.repository.CheckIfExists(context.Background(), user.Login, user.Email)
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), loginExists, true)
 	require.Equal(s.T(), emailExists, false)
@@ -209,7 +212,7 @@ func (s *Suite) TestCheckIfExists() {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "email"}).
 			AddRow(user.Id, "otherName", user.Email))
 
-	loginExists, emailExists, err = s.repository.CheckIfExists(user.Login, user.Email)
+	loginExists, emailExists, err = s.repository.CheckIfExists(context.Background(), user.Login, user.Email)
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), loginExists, false)
 	require.Equal(s.T(), emailExists, true)
@@ -217,7 +220,7 @@ func (s *Suite) TestCheckIfExists() {
 	//test on not exists
 	s.mock.ExpectQuery("SELECT").WithArgs(user.Login, user.Email).WillReturnError(gorm.ErrRecordNotFound)
 
-	loginExists, emailExists, err = s.repository.CheckIfExists(user.Login, user.Email)
+	loginExists, emailExists, err = s.repository.CheckIfExists(context.Background(), user.Login, user.Email)
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), loginExists, false)
 	require.Equal(s.T(), emailExists, false)
@@ -225,7 +228,7 @@ func (s *Suite) TestCheckIfExists() {
 	//test on other error
 	s.mock.ExpectQuery("SELECT").WithArgs(user.Login, user.Email).WillReturnError(s.bdError)
 
-	loginExists, emailExists, err = s.repository.CheckIfExists(user.Login, user.Email)
+	loginExists, emailExists, err = s.repository.CheckIfExists(context.Background(), user.Login, user.Email)
 	require.Error(s.T(), err)
 	require.Equal(s.T(), loginExists, true)
 	require.Equal(s.T(), emailExists, true)
@@ -290,3 +293,298 @@ func (s *Suite) TestCheckUserPassword() {
 	err = s.repository.CheckUserPassword(string(hash), passTwo)
 	require.Error(s.T(), err)
 }
+
+func (s *Suite) TestGetTwoFactor() {
+	user := s.user
+	secret := "JBSWY3DPEHPK3PXP"
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_two_factor" WHERE (user_id = $1)`)).
+		WithArgs(user.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "secret", "enabled"}).
+			AddRow(user.Id, secret, true))
+
+	resSecret, enabled, err := s.repository.GetTwoFactor(context.Background(), user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), secret, resSecret)
+	require.True(s.T(), enabled)
+
+	//test on not configured
+	s.mock.ExpectQuery("SELECT").WithArgs(user.Id).WillReturnError(gorm.ErrRecordNotFound)
+
+	resSecret, enabled, err = s.repository.GetTwoFactor(context.Background(), user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "", resSecret)
+	require.False(s.T(), enabled)
+
+	//test on bd error
+	s.mock.ExpectQuery("SELECT").WithArgs(user.Id).WillReturnError(s.bdError)
+
+	_, _, err = s.repository.GetTwoFactor(context.Background(), user.Id)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestSetRole() {
+	user := s.user
+	artistID := "42"
+
+	s.mock.ExpectExec("update users set role").WithArgs(models.RoleArtist, artistID, user.Id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.SetRole(context.Background(), user.Id, models.RoleArtist, artistID))
+
+	//test on artist link removal
+	s.mock.ExpectExec("update users set role").WithArgs(models.RoleModerator, nil, user.Id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.SetRole(context.Background(), user.Id, models.RoleModerator, ""))
+
+	//test on unknown user
+	s.mock.ExpectExec("update users set role").WithArgs(models.RoleAdmin, nil, user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.SetRole(context.Background(), user.Id, models.RoleAdmin, ""))
+
+	//test on bd error
+	s.mock.ExpectExec("update users set role").WithArgs(models.RoleAdmin, nil, user.Id).
+		WillReturnError(s.bdError)
+
+	require.Error(s.T(), s.repository.SetRole(context.Background(), user.Id, models.RoleAdmin, ""))
+}
+
+func (s *Suite) TestSetTwoFactorSecret() {
+	user := s.user
+	secret := "JBSWY3DPEHPK3PXP"
+
+	s.mock.ExpectExec("insert into user_two_factor").WithArgs(user.Id, secret).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(s.T(), s.repository.SetTwoFactorSecret(context.Background(), user.Id, secret))
+
+	//test on already enabled
+	s.mock.ExpectExec("insert into user_two_factor").WithArgs(user.Id, secret).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.Error(s.T(), s.repository.SetTwoFactorSecret(context.Background(), user.Id, secret))
+
+	//test on bd error
+	s.mock.ExpectExec("insert into user_two_factor").WithArgs(user.Id, secret).
+		WillReturnError(s.bdError)
+
+	require.Error(s.T(), s.repository.SetTwoFactorSecret(context.Background(), user.Id, secret))
+}
+
+func (s *Suite) TestEnableTwoFactor() {
+	user := s.user
+	var id uint64 = 1
+	codes := []string{"12345-abcde", "67890-fghij"}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update user_two_factor set enabled = true").WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from user_recovery_codes").WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for range codes {
+		s.mock.ExpectQuery("INSERT INTO \"user_recovery_codes\"").WithArgs(id, sqlmock.AnyArg(), false).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	}
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.EnableTwoFactor(context.Background(), user.Id, codes))
+
+	//test on secret not set
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("update user_two_factor set enabled = true").WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.EnableTwoFactor(context.Background(), user.Id, codes))
+
+	//test on wrong id
+	require.Error(s.T(), s.repository.EnableTwoFactor(context.Background(), "not id", codes))
+}
+
+func (s *Suite) TestDisableTwoFactor() {
+	user := s.user
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from user_recovery_codes").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	s.mock.ExpectExec("delete from user_two_factor").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.DisableTwoFactor(context.Background(), user.Id))
+
+	//test on bd error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from user_recovery_codes").WithArgs(user.Id).
+		WillReturnError(s.bdError)
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.DisableTwoFactor(context.Background(), user.Id))
+}
+
+func (s *Suite) TestUseRecoveryCode() {
+	user := s.user
+	code := "12345-abcde"
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.MinCost)
+	require.NoError(s.T(), err)
+	otherHash, err := bcrypt.GenerateFromPassword([]byte("67890-fghij"), bcrypt.MinCost)
+	require.NoError(s.T(), err)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_recovery_codes" WHERE (user_id = $1 and used = false)`)).
+		WithArgs(user.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "code", "used"}).
+			AddRow(1, user.Id, otherHash, false).
+			AddRow(2, user.Id, hash, false))
+	s.mock.ExpectExec("update user_recovery_codes set used = true").WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ok, err := s.repository.UseRecoveryCode(context.Background(), user.Id, code)
+	require.NoError(s.T(), err)
+	require.True(s.T(), ok)
+
+	//test on unknown code
+	s.mock.ExpectQuery("SELECT").WithArgs(user.Id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "code", "used"}).
+			AddRow(1, user.Id, otherHash, false))
+
+	ok, err = s.repository.UseRecoveryCode(context.Background(), user.Id, code)
+	require.NoError(s.T(), err)
+	require.False(s.T(), ok)
+
+	//test on bd error
+	s.mock.ExpectQuery("SELECT").WithArgs(user.Id).WillReturnError(s.bdError)
+
+	_, err = s.repository.UseRecoveryCode(context.Background(), user.Id, code)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestUseTotpCounter() {
+	user := s.user
+
+	s.mock.ExpectExec("update user_two_factor set last_counter").
+		WithArgs(42, user.Id, 42).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ok, err := s.repository.UseTotpCounter(context.Background(), user.Id, 42)
+	require.NoError(s.T(), err)
+	require.True(s.T(), ok)
+
+	//test on already used counter
+	s.mock.ExpectExec("update user_two_factor set last_counter").
+		WithArgs(42, user.Id, 42).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ok, err = s.repository.UseTotpCounter(context.Background(), user.Id, 42)
+	require.NoError(s.T(), err)
+	require.False(s.T(), ok)
+
+	//test on bd error
+	s.mock.ExpectExec("update user_two_factor set last_counter").
+		WithArgs(42, user.Id, 42).
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.UseTotpCounter(context.Background(), user.Id, 42)
+	require.Error(s.T(), err)
+}
+
+func (s *Suite) TestDelete() {
+	user := s.user
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from user_stat").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec("delete from users").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	require.NoError(s.T(), s.repository.Delete(context.Background(), user.Id))
+
+	//test on not existing user
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from user_stat").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("delete from users").WithArgs(user.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.Delete(context.Background(), user.Id))
+
+	//test on bd error
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("delete from user_stat").WithArgs(user.Id).
+		WillReturnError(s.bdError)
+	s.mock.ExpectRollback()
+
+	require.Error(s.T(), s.repository.Delete(context.Background(), user.Id))
+}
+
+func (s *Suite) TestFollow() {
+	s.mock.ExpectExec("insert into user_follows").WithArgs("1", "2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.Follow(context.Background(), "1", "2"))
+
+	//test on bd error
+	s.mock.ExpectExec("insert into user_follows").WithArgs("1", "2").
+		WillReturnError(s.bdError)
+
+	require.Error(s.T(), s.repository.Follow(context.Background(), "1", "2"))
+}
+
+func (s *Suite) TestUnfollow() {
+	s.mock.ExpectExec("delete from user_follows").WithArgs("1", "2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(s.T(), s.repository.Unfollow(context.Background(), "1", "2"))
+
+	//test on bd error
+	s.mock.ExpectExec("delete from user_follows").WithArgs("1", "2").
+		WillReturnError(s.bdError)
+
+	require.Error(s.T(), s.repository.Unfollow(context.Background(), "1", "2"))
+}
+
+func (s *Suite) TestIsFollowing() {
+	query := regexp.QuoteMeta(`SELECT count(*) FROM "user_follows" WHERE (follower_id = $1 and followed_id = $2)`)
+
+	s.mock.ExpectQuery(query).WithArgs("1", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	require.True(s.T(), s.repository.IsFollowing(context.Background(), "1", "2"))
+
+	s.mock.ExpectQuery(query).WithArgs("1", "3").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	require.False(s.T(), s.repository.IsFollowing(context.Background(), "1", "3"))
+
+	//test on bd error
+	s.mock.ExpectQuery(query).WithArgs("1", "2").
+		WillReturnError(s.bdError)
+	require.False(s.T(), s.repository.IsFollowing(context.Background(), "1", "2"))
+}
+
+func (s *Suite) TestGetFollowers() {
+	users := []models.UserPreview{
+		{Id: "2", Login: "follower", Name: "Follower", Image: "/img/2.png"},
+		{Id: "3", Login: "other", Name: "Other", Image: "/img/3.png"},
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_followers" WHERE (user_id = $1) ORDER BY created_at desc LIMIT 2 OFFSET 0`)).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "login", "name", "image"}).
+			AddRow("1", users[0].Id, users[0].Login, users[0].Name, users[0].Image).
+			AddRow("1", users[1].Id, users[1].Login, users[1].Name, users[1].Image))
+
+	result, err := s.repository.GetFollowers(context.Background(), "1", 0, 2)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), deep.Equal(users, result))
+
+	//test on bd error
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_following" WHERE (user_id = $1)`)).
+		WithArgs("1").
+		WillReturnError(s.bdError)
+
+	_, err = s.repository.GetFollowing(context.Background(), "1", 0, 2)
+	require.Error(s.T(), err)
+}
@@ -5,6 +5,7 @@
 package user
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,67 +35,67 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockRepository) Create(user models.User) error {
+func (m *MockRepository) Create(ctx context.Context, user models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", user)
+	ret := m.ctrl.Call(m, "Create", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create
-func (mr *MockRepositoryMockRecorder) Create(user interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Create(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, user)
 }
 
 // Update mocks base method
-func (m *MockRepository) Update(user models.User, input models.UserSettings) error {
+func (m *MockRepository) Update(ctx context.Context, user models.User, input models.UserSettings) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", user, input)
+	ret := m.ctrl.Call(m, "Update", ctx, user, input)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update
-func (mr *MockRepositoryMockRecorder) Update(user, input interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Update(ctx, user, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), user, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, user, input)
 }
 
 // UpdateAvatar mocks base method
-func (m *MockRepository) UpdateAvatar(user models.User, avatarDir, fileType string) (string, error) {
+func (m *MockRepository) UpdateAvatar(ctx context.Context, user models.User, avatarDir, fileType string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAvatar", user, avatarDir, fileType)
+	ret := m.ctrl.Call(m, "UpdateAvatar", ctx, user, avatarDir, fileType)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAvatar indicates an expected call of UpdateAvatar
-func (mr *MockRepositoryMockRecorder) UpdateAvatar(user, avatarDir, fileType interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UpdateAvatar(ctx, user, avatarDir, fileType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockRepository)(nil).UpdateAvatar), user, avatarDir, fileType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockRepository)(nil).UpdateAvatar), ctx, user, avatarDir, fileType)
 }
 
 // GetUserByLogin mocks base method
-func (m *MockRepository) GetUserByLogin(login string) (models.User, error) {
+func (m *MockRepository) GetUserByLogin(ctx context.Context, login string) (models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByLogin", login)
+	ret := m.ctrl.Call(m, "GetUserByLogin", ctx, login)
 	ret0, _ := ret[0].(models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByLogin indicates an expected call of GetUserByLogin
-func (mr *MockRepositoryMockRecorder) GetUserByLogin(login interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetUserByLogin(ctx, login interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockRepository)(nil).GetUserByLogin), login)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockRepository)(nil).GetUserByLogin), ctx, login)
 }
 
 // CheckIfExists mocks base method
-func (m *MockRepository) CheckIfExists(login, email string) (bool, bool, error) {
+func (m *MockRepository) CheckIfExists(ctx context.Context, login, email string) (bool, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckIfExists", login, email)
+	ret := m.ctrl.Call(m, "CheckIfExists", ctx, login, email)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
@@ -102,9 +103,9 @@ func (m *MockRepository) CheckIfExists(login, email string) (bool, bool, error)
 }
 
 // CheckIfExists indicates an expected call of CheckIfExists
-func (mr *MockRepositoryMockRecorder) CheckIfExists(login, email interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CheckIfExists(ctx, login, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfExists", reflect.TypeOf((*MockRepository)(nil).CheckIfExists), login, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfExists", reflect.TypeOf((*MockRepository)(nil).CheckIfExists), ctx, login, email)
 }
 
 // CheckUserPassword mocks base method
@@ -122,16 +123,204 @@ func (mr *MockRepositoryMockRecorder) CheckUserPassword(userPassword, inputPassw
 }
 
 // GetUserStat mocks base method
-func (m *MockRepository) GetUserStat(id string) (models.UserStat, error) {
+func (m *MockRepository) GetUserStat(ctx context.Context, id string) (models.UserStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserStat", id)
+	ret := m.ctrl.Call(m, "GetUserStat", ctx, id)
 	ret0, _ := ret[0].(models.UserStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserStat indicates an expected call of GetUserStat
-func (mr *MockRepositoryMockRecorder) GetUserStat(id interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetUserStat(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockRepository)(nil).GetUserStat), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockRepository)(nil).GetUserStat), ctx, id)
+}
+
+// SetRole mocks base method
+func (m *MockRepository) SetRole(ctx context.Context, uID, role, artistID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, uID, role, artistID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole
+func (mr *MockRepositoryMockRecorder) SetRole(ctx, uID, role, artistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockRepository)(nil).SetRole), ctx, uID, role, artistID)
+}
+
+// Delete mocks base method
+func (m *MockRepository) Delete(ctx context.Context, uID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRepositoryMockRecorder) Delete(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, uID)
+}
+
+// Follow mocks base method
+func (m *MockRepository) Follow(ctx context.Context, followerID, followedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, followerID, followedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow
+func (mr *MockRepositoryMockRecorder) Follow(ctx, followerID, followedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockRepository)(nil).Follow), ctx, followerID, followedID)
+}
+
+// Unfollow mocks base method
+func (m *MockRepository) Unfollow(ctx context.Context, followerID, followedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, followerID, followedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow
+func (mr *MockRepositoryMockRecorder) Unfollow(ctx, followerID, followedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockRepository)(nil).Unfollow), ctx, followerID, followedID)
+}
+
+// IsFollowing mocks base method
+func (m *MockRepository) IsFollowing(ctx context.Context, followerID, followedID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowing", ctx, followerID, followedID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsFollowing indicates an expected call of IsFollowing
+func (mr *MockRepositoryMockRecorder) IsFollowing(ctx, followerID, followedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowing", reflect.TypeOf((*MockRepository)(nil).IsFollowing), ctx, followerID, followedID)
+}
+
+// GetFollowers mocks base method
+func (m *MockRepository) GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, uID, start, end)
+	ret0, _ := ret[0].([]models.UserPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers
+func (mr *MockRepositoryMockRecorder) GetFollowers(ctx, uID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockRepository)(nil).GetFollowers), ctx, uID, start, end)
+}
+
+// GetFollowing mocks base method
+func (m *MockRepository) GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, uID, start, end)
+	ret0, _ := ret[0].([]models.UserPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing
+func (mr *MockRepositoryMockRecorder) GetFollowing(ctx, uID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockRepository)(nil).GetFollowing), ctx, uID, start, end)
+}
+
+// GetTwoFactor mocks base method
+func (m *MockRepository) GetTwoFactor(ctx context.Context, uID string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactor", ctx, uID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTwoFactor indicates an expected call of GetTwoFactor
+func (mr *MockRepositoryMockRecorder) GetTwoFactor(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactor", reflect.TypeOf((*MockRepository)(nil).GetTwoFactor), ctx, uID)
+}
+
+// SetTwoFactorSecret mocks base method
+func (m *MockRepository) SetTwoFactorSecret(ctx context.Context, uID, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTwoFactorSecret", ctx, uID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTwoFactorSecret indicates an expected call of SetTwoFactorSecret
+func (mr *MockRepositoryMockRecorder) SetTwoFactorSecret(ctx, uID, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTwoFactorSecret", reflect.TypeOf((*MockRepository)(nil).SetTwoFactorSecret), ctx, uID, secret)
+}
+
+// EnableTwoFactor mocks base method
+func (m *MockRepository) EnableTwoFactor(ctx context.Context, uID string, recoveryCodes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, uID, recoveryCodes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor
+func (mr *MockRepositoryMockRecorder) EnableTwoFactor(ctx, uID, recoveryCodes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockRepository)(nil).EnableTwoFactor), ctx, uID, recoveryCodes)
+}
+
+// DisableTwoFactor mocks base method
+func (m *MockRepository) DisableTwoFactor(ctx context.Context, uID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, uID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor
+func (mr *MockRepositoryMockRecorder) DisableTwoFactor(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockRepository)(nil).DisableTwoFactor), ctx, uID)
+}
+
+// UseRecoveryCode mocks base method
+func (m *MockRepository) UseRecoveryCode(ctx context.Context, uID, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, uID, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode
+func (mr *MockRepositoryMockRecorder) UseRecoveryCode(ctx, uID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockRepository)(nil).UseRecoveryCode), ctx, uID, code)
+}
+
+// UseTotpCounter mocks base method
+func (m *MockRepository) UseTotpCounter(ctx context.Context, uID string, counter uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTotpCounter", ctx, uID, counter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTotpCounter indicates an expected call of UseTotpCounter
+func (mr *MockRepositoryMockRecorder) UseTotpCounter(ctx, uID, counter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTotpCounter", reflect.TypeOf((*MockRepository)(nil).UseTotpCounter), ctx, uID, counter)
 }
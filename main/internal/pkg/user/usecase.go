@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	"io"
 )
@@ -15,13 +16,26 @@ const (
 )
 
 type UseCase interface {
-	Create(user models.User) (SameUserExists, error)
-	Update(user models.User, input models.UserSettings) (SameUserExists, error)
-	Login(input models.UserSignIn) (models.User, error)
-	UpdateAvatar(user models.User, file io.Reader, fileType string) (string, error)
-	GetUserByLogin(login string) (models.User, error)
-	GetProfileByLogin(login string) (models.User, error)
+	Create(ctx context.Context, user models.User) (SameUserExists, error)
+	Update(ctx context.Context, user models.User, input models.UserSettings) (SameUserExists, error)
+	Login(ctx context.Context, input models.UserSignIn) (models.User, error)
+	UpdateAvatar(ctx context.Context, user models.User, file io.Reader, fileType string) (string, error)
+	GetUserByLogin(ctx context.Context, login string) (models.User, error)
+	GetProfileByLogin(ctx context.Context, login string) (models.User, error)
 	GetOutputUserData(user models.User) models.User
 	CheckUserPassword(userPassword string, InputPassword string) error
-	GetUserStat(id string) (models.UserStat, error)
+	GetUserStat(ctx context.Context, id string) (models.UserStat, error)
+	SetRole(ctx context.Context, uID string, input models.UserRole) error
+	ExportData(ctx context.Context, user models.User) ([]byte, error)
+	DeleteAccount(ctx context.Context, user models.User) error
+	Follow(ctx context.Context, user models.User, followedID string) error
+	Unfollow(ctx context.Context, user models.User, followedID string) error
+	GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error)
+	GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error)
+	GetFullProfile(ctx context.Context, login string, viewer models.User) (models.UserProfile, error)
+	SetupTwoFactor(ctx context.Context, user models.User) (models.TwoFactorSetup, error)
+	EnableTwoFactor(ctx context.Context, user models.User, code string) (models.RecoveryCodes, error)
+	DisableTwoFactor(ctx context.Context, user models.User, password string) error
+	IsTwoFactorEnabled(ctx context.Context, uID string) (bool, error)
+	CheckSecondFactor(ctx context.Context, uID string, code string) (bool, error)
 }
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type archiveFile struct {
+	Name string
+	Data interface{}
+}
+
+func writeArchive(files []archiveFile) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	archive := zip.NewWriter(buf)
+
+	for _, elem := range files {
+		file, err := archive.Create(elem.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s in archive: %w", elem.Name, err)
+		}
+		if err := json.NewEncoder(file).Encode(elem.Data); err != nil {
+			return nil, fmt.Errorf("failed to write %s to archive: %w", elem.Name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
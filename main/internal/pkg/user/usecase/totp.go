@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 parameters, the defaults every authenticator app understands
+const (
+	totpPeriod     = 30
+	totpDigits     = 6
+	totpSkew       = 1
+	totpSecretSize = 20
+
+	recoveryCodesNum  = 10
+	recoveryCodeBytes = 5
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func generateTotpSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func totpURI(issuer string, login string, secret string) string {
+	label := url.PathEscape(issuer + ":" + login)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func totpCode(secret string, counter uint64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret: %w", err)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod), nil
+}
+
+// validateTotp returns the time step the code belongs to, the caller has to make sure
+// a step is not accepted twice
+func validateTotp(secret string, code string, now time.Time) (uint64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	counter := uint64(now.Unix()) / totpPeriod
+
+	for i := counter - totpSkew; i <= counter+totpSkew; i++ {
+		expected, err := totpCode(secret, i)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodesNum)
+	buf := make([]byte, recoveryCodeBytes)
+
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := hex.EncodeToString(buf)
+		codes[i] = code[:recoveryCodeBytes] + "-" + code[recoveryCodeBytes:]
+	}
+	return codes, nil
+}
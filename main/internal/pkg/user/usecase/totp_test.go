@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// secret from RFC 6238 test vectors, "12345678901234567890" encoded in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTotpCode(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, expected := range vectors {
+		code, err := totpCode(rfcSecret, uint64(unix)/totpPeriod)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	}
+
+	_, err := totpCode("not base32!", 1)
+	assert.Error(t, err)
+}
+
+func TestValidateTotp(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	counter := uint64(1111111111) / totpPeriod
+
+	step, ok := validateTotp(rfcSecret, "050471", now)
+	assert.True(t, ok)
+	assert.Equal(t, counter, step)
+	//previous and next periods are accepted, the step is the one the code was made for
+	step, ok = validateTotp(rfcSecret, "050471", now.Add(-totpPeriod*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, counter, step)
+	step, ok = validateTotp(rfcSecret, "050471", now.Add(totpPeriod*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, counter, step)
+
+	_, ok = validateTotp(rfcSecret, "050471", now.Add(3*totpPeriod*time.Second))
+	assert.False(t, ok)
+	_, ok = validateTotp(rfcSecret, "000000", now)
+	assert.False(t, ok)
+	_, ok = validateTotp(rfcSecret, "50471", now)
+	assert.False(t, ok)
+}
+
+func TestTotpSecretAndURI(t *testing.T) {
+	secret, err := generateTotpSecret()
+	assert.NoError(t, err)
+
+	code, err := totpCode(secret, 1)
+	assert.NoError(t, err)
+	assert.Len(t, code, totpDigits)
+
+	uri, err := url.Parse(totpURI("VirusMusic", "nnnagibator", secret))
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/VirusMusic:nnnagibator", uri.Path)
+	assert.Equal(t, secret, uri.Query().Get("secret"))
+	assert.Equal(t, "VirusMusic", uri.Query().Get("issuer"))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := generateRecoveryCodes()
+	assert.NoError(t, err)
+	assert.Len(t, codes, recoveryCodesNum)
+
+	unique := make(map[string]bool, len(codes))
+	for _, elem := range codes {
+		assert.Len(t, elem, 2*recoveryCodeBytes+1)
+		assert.Equal(t, 1, strings.Count(elem, "-"))
+		unique[elem] = true
+	}
+	assert.Len(t, unique, recoveryCodesNum)
+}
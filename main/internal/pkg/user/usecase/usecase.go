@@ -3,25 +3,47 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
 	"github.com/2020_1_no_homomorphism/no_homo_main/proto/filetransfer"
 	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc/metadata"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 	users "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user"
 )
 
+// playlist_tracks.index is SMALLSERIAL, so no playlist holds more tracks
+const exportPlaylistTracks = math.MaxInt16
+
+// how many recently liked tracks the full profile shows
+const profileLikedTracks = 10
+
 type UserUseCase struct {
-	Repository  users.Repository
-	FileService filetransfer.UploadServiceClient
-	AvatarDir   string
+	Repository         users.Repository
+	TrackRepository    track.Repository
+	AlbumRepository    album.Repository
+	ArtistRepository   artist.Repository
+	PlaylistRepository playlist.Repository
+	FileService        filetransfer.UploadServiceClient
+	AvatarDir          string
+	AvatarDefault      string
+	TotpIssuer         string
+	Log                *logger.MainLogger
 }
 
-func (uc *UserUseCase) Create(user models.User) (users.SameUserExists, error) {
-	loginExists, emailExists, err := uc.Repository.CheckIfExists(user.Login, user.Email)
+func (uc *UserUseCase) Create(ctx context.Context, user models.User) (users.SameUserExists, error) {
+	loginExists, emailExists, err := uc.Repository.CheckIfExists(ctx, user.Login, user.Email)
 	if err != nil {
 		return users.FULL, err
 	}
@@ -34,32 +56,32 @@ func (uc *UserUseCase) Create(user models.User) (users.SameUserExists, error) {
 	if emailExists {
 		return users.EMAIL, nil
 	}
-	return users.NO, uc.Repository.Create(user)
+	return users.NO, uc.Repository.Create(ctx, user)
 }
 
-func (uc *UserUseCase) Update(user models.User, input models.UserSettings) (users.SameUserExists, error) {
+func (uc *UserUseCase) Update(ctx context.Context, user models.User, input models.UserSettings) (users.SameUserExists, error) {
 	if user.Email != input.Email {
-		_, emailExists, err := uc.Repository.CheckIfExists("", input.Email)
+		_, emailExists, err := uc.Repository.CheckIfExists(ctx, "", input.Email)
 		if err != nil {
-			return users.FULL, fmt.Errorf("failed to check email existing: %v", err)
+			return users.FULL, fmt.Errorf("failed to check email existing: %w", err)
 		}
 		if emailExists {
 			return users.EMAIL, nil
 		}
 	}
-	return users.NO, uc.Repository.Update(user, input)
+	return users.NO, uc.Repository.Update(ctx, user, input)
 }
 
-func (uc *UserUseCase) UpdateAvatar(user models.User, file io.Reader, fileType string) (string, error) {
+func (uc *UserUseCase) UpdateAvatar(ctx context.Context, user models.User, file io.Reader, fileType string) (string, error) {
 	fileName := uuid.NewV4().String()
 	fullFileName := fileName + "." + fileType
 
 	md := metadata.New(map[string]string{"fileName": filepath.Join(os.Getenv("FILE_ROOT")+uc.AvatarDir, fullFileName)})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	stream, err := uc.FileService.Upload(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to upload file: %v", err)
+		return "", fmt.Errorf("failed to upload file: %w", err)
 	}
 
 	write := true
@@ -72,11 +94,11 @@ func (uc *UserUseCase) UpdateAvatar(user models.User, file io.Reader, fileType s
 				write = false
 				continue
 			}
-			return "", fmt.Errorf("failed to read file: %v", err)
+			return "", fmt.Errorf("failed to read file: %w", err)
 		}
 		err = stream.Send(&filetransfer.Chunk{Content: chunk[:size]})
 		if err != nil {
-			return "", fmt.Errorf("failed to send file to service: %v", err)
+			return "", fmt.Errorf("failed to send file to service: %w", err)
 		}
 	}
 
@@ -85,48 +107,276 @@ func (uc *UserUseCase) UpdateAvatar(user models.User, file io.Reader, fileType s
 		return "", fmt.Errorf("error occured in filetransfer service: %v, status: %v", err, status)
 	}
 
-	return uc.Repository.UpdateAvatar(user, uc.AvatarDir, fullFileName)
+	return uc.Repository.UpdateAvatar(ctx, user, uc.AvatarDir, fullFileName)
 }
 
-func (uc *UserUseCase) GetUserByLogin(user string) (models.User, error) {
-	return uc.Repository.GetUserByLogin(user)
+func (uc *UserUseCase) GetUserByLogin(ctx context.Context, user string) (models.User, error) {
+	return uc.Repository.GetUserByLogin(ctx, user)
 }
 
-func (uc *UserUseCase) GetProfileByLogin(login string) (models.User, error) {
-	user, err := uc.Repository.GetUserByLogin(login)
+func (uc *UserUseCase) GetProfileByLogin(ctx context.Context, login string) (models.User, error) {
+	user, err := uc.Repository.GetUserByLogin(ctx, login)
 	if err != nil {
 		return models.User{}, err
 	}
 	return uc.GetOutputUserData(user), nil
 }
 
-func (uc *UserUseCase) Login(input models.UserSignIn) (models.User, error) {
-	user, err := uc.GetUserByLogin(input.Login)
+func (uc *UserUseCase) Login(ctx context.Context, input models.UserSignIn) (models.User, error) {
+	user, err := uc.GetUserByLogin(ctx, input.Login)
 	if err != nil {
-		return models.User{}, fmt.Errorf("failed to get user: %v", err)
+		return models.User{}, fmt.Errorf("failed to get user: %w", err)
 	}
 	err = uc.CheckUserPassword(user.Password, input.Password)
 	if err != nil {
-		return models.User{}, fmt.Errorf("wrong password: %v", err)
+		return models.User{}, fmt.Errorf("wrong password: %w", err)
 	}
 	return user, nil
 }
 
 func (uc *UserUseCase) GetOutputUserData(user models.User) models.User {
 	return models.User{
-		Id:    user.Id,
-		Name:  user.Name,
-		Login: user.Login,
-		Sex:   user.Sex,
-		Image: user.Image,
-		Email: user.Email,
+		Id:       user.Id,
+		Name:     user.Name,
+		Login:    user.Login,
+		Sex:      user.Sex,
+		Image:    user.Image,
+		Email:    user.Email,
+		Role:     user.Role,
+		ArtistId: user.ArtistId,
+	}
+}
+
+func (uc *UserUseCase) GetUserStat(ctx context.Context, id string) (models.UserStat, error) {
+	return uc.Repository.GetUserStat(ctx, id)
+}
+
+func (uc *UserUseCase) SetRole(ctx context.Context, uID string, input models.UserRole) error {
+	switch input.Role {
+	case models.RoleArtist:
+		if input.ArtistId == "" {
+			return apperrors.New(apperrors.Validation, "artist role requires artist id")
+		}
+		return uc.Repository.SetRole(ctx, uID, input.Role, input.ArtistId)
+	case models.RoleListener, models.RoleModerator, models.RoleAdmin:
+		return uc.Repository.SetRole(ctx, uID, input.Role, "")
+	default:
+		return apperrors.Errorf(apperrors.Validation, "unknown role: %s", input.Role)
+	}
+}
+
+func (uc *UserUseCase) ExportData(ctx context.Context, user models.User) ([]byte, error) {
+	tracks, err := uc.TrackRepository.GetUserTracks(ctx, user.Id)
+	if err != nil {
+		return nil, err
 	}
+	albums, err := uc.AlbumRepository.GetUserAlbums(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	artists, err := uc.ArtistRepository.SubscriptionsList(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	playlists, err := uc.PlaylistRepository.GetUserPlaylists(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	exportPlaylists := make([]models.PlaylistExport, len(playlists))
+	for i, elem := range playlists {
+		plTracks, err := uc.TrackRepository.GetBoundedTracksByPlaylistId(ctx, elem.Id, 0, exportPlaylistTracks)
+		if err != nil {
+			return nil, err
+		}
+		exportPlaylists[i] = models.PlaylistExport{
+			Playlist: elem,
+			Tracks:   plTracks,
+		}
+	}
+
+	return writeArchive([]archiveFile{
+		{Name: "profile.json", Data: uc.GetOutputUserData(user)},
+		{Name: "liked_tracks.json", Data: tracks},
+		{Name: "liked_albums.json", Data: albums},
+		{Name: "subscriptions.json", Data: artists},
+		{Name: "playlists.json", Data: exportPlaylists},
+	})
 }
 
-func (uc *UserUseCase) GetUserStat(id string) (models.UserStat, error) {
-	return uc.Repository.GetUserStat(id)
+// DeleteAccount removes the user, the avatar goes only after the rows, so a failed delete keeps
+// the account whole. A leftover avatar is just a file without an owner, so its failure is only logged
+func (uc *UserUseCase) DeleteAccount(ctx context.Context, user models.User) error {
+	if err := uc.Repository.Delete(ctx, user.Id); err != nil {
+		return err
+	}
+	if err := uc.deleteAvatar(ctx, user.Image); err != nil {
+		uc.Log.LogWarning(ctx, "user usecase", "DeleteAccount", err.Error())
+	}
+	return nil
+}
+
+// deleteAvatar removes the file uploaded by UpdateAvatar, the default avatar is shared and kept
+func (uc *UserUseCase) deleteAvatar(ctx context.Context, image string) error {
+	fileServer := os.Getenv("FILE_SERVER")
+	if image == uc.AvatarDefault || !strings.HasPrefix(image, fileServer+uc.AvatarDir+"/") {
+		return nil
+	}
+
+	fileName := os.Getenv("FILE_ROOT") + strings.TrimPrefix(image, fileServer)
+	status, err := uc.FileService.Delete(ctx, &filetransfer.FileName{Name: fileName})
+	if err != nil {
+		return fmt.Errorf("failed to delete avatar: %v, status: %v", err, status)
+	}
+	return nil
+}
+
+func (uc *UserUseCase) Follow(ctx context.Context, user models.User, followedID string) error {
+	if user.Id == followedID {
+		return apperrors.New(apperrors.Validation, "can't follow yourself")
+	}
+	return uc.Repository.Follow(ctx, user.Id, followedID)
+}
+
+func (uc *UserUseCase) Unfollow(ctx context.Context, user models.User, followedID string) error {
+	return uc.Repository.Unfollow(ctx, user.Id, followedID)
+}
+
+func (uc *UserUseCase) GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	return uc.Repository.GetFollowers(ctx, uID, start, end)
+}
+
+func (uc *UserUseCase) GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	return uc.Repository.GetFollowing(ctx, uID, start, end)
+}
+
+// GetFullProfile shows public playlists and recently liked tracks only to followers,
+// viewer is empty for unauthorized requests
+func (uc *UserUseCase) GetFullProfile(ctx context.Context, login string, viewer models.User) (models.UserProfile, error) {
+	user, err := uc.Repository.GetUserByLogin(ctx, login)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+	stat, err := uc.Repository.GetUserStat(ctx, user.Id)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+
+	isSelf := viewer.Id == user.Id
+	profile := models.UserProfile{
+		User: uc.GetOutputUserData(user),
+		Stat: stat,
+	}
+	if !isSelf {
+		profile.Email = ""
+	}
+	if viewer.Id != "" && !isSelf {
+		profile.IsFollowed = uc.Repository.IsFollowing(ctx, viewer.Id, user.Id)
+	}
+	if !isSelf && !profile.IsFollowed {
+		return profile, nil
+	}
+
+	playlists, err := uc.PlaylistRepository.GetUserPlaylists(ctx, user.Id)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+	for _, elem := range playlists {
+		if isSelf || !elem.Private {
+			profile.Playlists = append(profile.Playlists, elem)
+		}
+	}
+
+	tracks, err := uc.TrackRepository.GetUserTracks(ctx, user.Id)
+	if err != nil {
+		return models.UserProfile{}, err
+	}
+	if len(tracks) > profileLikedTracks {
+		tracks = tracks[:profileLikedTracks]
+	}
+	if !isSelf {
+		for i := range tracks {
+			tracks[i].IsLiked = false
+		}
+	}
+	profile.LikedTracks = tracks
+
+	return profile, nil
 }
 
 func (uc *UserUseCase) CheckUserPassword(userPassword string, inputPassword string) error {
 	return uc.Repository.CheckUserPassword(userPassword, inputPassword)
 }
+
+func (uc *UserUseCase) SetupTwoFactor(ctx context.Context, user models.User) (models.TwoFactorSetup, error) {
+	secret, err := generateTotpSecret()
+	if err != nil {
+		return models.TwoFactorSetup{}, err
+	}
+	if err := uc.Repository.SetTwoFactorSecret(ctx, user.Id, secret); err != nil {
+		return models.TwoFactorSetup{}, err
+	}
+	return models.TwoFactorSetup{
+		Secret: secret,
+		URI:    totpURI(uc.TotpIssuer, user.Login, secret),
+	}, nil
+}
+
+func (uc *UserUseCase) EnableTwoFactor(ctx context.Context, user models.User, code string) (models.RecoveryCodes, error) {
+	secret, enabled, err := uc.Repository.GetTwoFactor(ctx, user.Id)
+	if err != nil {
+		return models.RecoveryCodes{}, err
+	}
+	if enabled {
+		return models.RecoveryCodes{}, apperrors.New(apperrors.Conflict, "two factor authentication is already enabled")
+	}
+	if secret == "" {
+		return models.RecoveryCodes{}, apperrors.New(apperrors.Conflict, "two factor secret is not set")
+	}
+	counter, ok := validateTotp(secret, code, time.Now())
+	if !ok {
+		return models.RecoveryCodes{}, apperrors.New(apperrors.Forbidden, "wrong two factor code")
+	}
+	used, err := uc.Repository.UseTotpCounter(ctx, user.Id, counter)
+	if err != nil {
+		return models.RecoveryCodes{}, err
+	}
+	if !used {
+		return models.RecoveryCodes{}, apperrors.New(apperrors.Forbidden, "two factor code is already used")
+	}
+
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return models.RecoveryCodes{}, err
+	}
+	if err := uc.Repository.EnableTwoFactor(ctx, user.Id, codes); err != nil {
+		return models.RecoveryCodes{}, err
+	}
+	return models.RecoveryCodes{Codes: codes}, nil
+}
+
+func (uc *UserUseCase) DisableTwoFactor(ctx context.Context, user models.User, password string) error {
+	if err := uc.CheckUserPassword(user.Password, password); err != nil {
+		return fmt.Errorf("wrong password: %w", err)
+	}
+	return uc.Repository.DisableTwoFactor(ctx, user.Id)
+}
+
+func (uc *UserUseCase) IsTwoFactorEnabled(ctx context.Context, uID string) (bool, error) {
+	_, enabled, err := uc.Repository.GetTwoFactor(ctx, uID)
+	return enabled, err
+}
+
+func (uc *UserUseCase) CheckSecondFactor(ctx context.Context, uID string, code string) (bool, error) {
+	secret, enabled, err := uc.Repository.GetTwoFactor(ctx, uID)
+	if err != nil {
+		return false, err
+	}
+	if !enabled {
+		return true, nil
+	}
+	if counter, ok := validateTotp(secret, code, time.Now()); ok {
+		return uc.Repository.UseTotpCounter(ctx, uID, counter)
+	}
+	return uc.Repository.UseRecoveryCode(ctx, uID, code)
+}
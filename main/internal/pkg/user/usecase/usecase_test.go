@@ -1,13 +1,26 @@
 package usecase
 
 import (
+	"context"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/album"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/artist"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/playlist"
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/track"
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/user"
+	"github.com/2020_1_no_homomorphism/no_homo_main/logger"
+	"github.com/2020_1_no_homomorphism/no_homo_main/proto/filetransfer"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 )
 
 var testUser = models.User{
@@ -31,18 +44,18 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(false, false, nil)
 		m.
 			EXPECT().
-			Create(gomock.Eq(testUser)).
+			Create(gomock.Any(), gomock.Eq(testUser)).
 			Return(nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		exists, err := useCase.Create(testUser)
+		exists, err := useCase.Create(context.Background(), testUser)
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.NO)
 	})
@@ -54,13 +67,13 @@ func TestCreate(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(true, false, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
-		exists, err := useCase.Create(testUser)
+		exists, err := useCase.Create(context.Background(), testUser)
 
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.LOGIN)
@@ -73,13 +86,13 @@ func TestCreate(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(false, true, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
-		exists, err := useCase.Create(testUser)
+		exists, err := useCase.Create(context.Background(), testUser)
 
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.EMAIL)
@@ -92,13 +105,13 @@ func TestCreate(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(true, true, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
-		exists, err := useCase.Create(testUser)
+		exists, err := useCase.Create(context.Background(), testUser)
 
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.FULL)
@@ -112,13 +125,13 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(true, true, testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
-		_, err := useCase.Create(testUser)
+		_, err := useCase.Create(context.Background(), testUser)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, testError)
@@ -132,17 +145,17 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(testUser.Login), gomock.Eq(testUser.Email)).
 			Return(false, false, nil)
 		m.
 			EXPECT().
-			Create(gomock.Eq(testUser)).
+			Create(gomock.Any(), gomock.Eq(testUser)).
 			Return(testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
-		_, err := useCase.Create(testUser)
+		_, err := useCase.Create(context.Background(), testUser)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, testError)
@@ -165,14 +178,14 @@ func TestUpdate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Update(testUser, testInput).
+			Update(gomock.Any(), testUser, testInput).
 			Return(nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		exists, err := useCase.Update(testUser, testInput)
+		exists, err := useCase.Update(context.Background(), testUser, testInput)
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.NO)
 	})
@@ -188,14 +201,14 @@ func TestUpdate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Update(testUser, testInput2).
+			Update(gomock.Any(), testUser, testInput2).
 			Return(nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		exists, err := useCase.Update(testUser, testInput2)
+		exists, err := useCase.Update(context.Background(), testUser, testInput2)
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.NO)
 	})
@@ -208,14 +221,14 @@ func TestUpdate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Update(testUser, testInput).
+			Update(gomock.Any(), testUser, testInput).
 			Return(testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.Update(testUser, testInput)
+		_, err := useCase.Update(context.Background(), testUser, testInput)
 		assert.Error(t, err)
 		assert.Equal(t, err, testError)
 	})
@@ -230,14 +243,14 @@ func TestUpdate(t *testing.T) {
 
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(""), gomock.Eq(testInput.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(""), gomock.Eq(testInput.Email)).
 			Return(false, true, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		exists, err := useCase.Update(testUser, testInput)
+		exists, err := useCase.Update(context.Background(), testUser, testInput)
 		assert.NoError(t, err)
 		assert.Equal(t, exists, user.EMAIL)
 	})
@@ -249,16 +262,16 @@ func TestUpdate(t *testing.T) {
 
 		m.
 			EXPECT().
-			CheckIfExists(gomock.Eq(""), gomock.Eq(testInput.Email)).
+			CheckIfExists(gomock.Any(), gomock.Eq(""), gomock.Eq(testInput.Email)).
 			Return(false, true, testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.Update(testUser, testInput)
+		_, err := useCase.Update(context.Background(), testUser, testInput)
 		assert.Error(t, err)
-		assert.Equal(t, err, fmt.Errorf("failed to check email existing: %v", testError))
+		assert.Equal(t, err, fmt.Errorf("failed to check email existing: %w", testError))
 	})
 }
 
@@ -279,14 +292,14 @@ func TestGetProfileByLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testUser.Login).
+			GetUserByLogin(gomock.Any(), testUser.Login).
 			Return(testUser, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		userData, err := useCase.GetProfileByLogin(testUser.Login)
+		userData, err := useCase.GetProfileByLogin(context.Background(), testUser.Login)
 		assert.NoError(t, err)
 		assert.Equal(t, userData, testProfile)
 	})
@@ -299,14 +312,14 @@ func TestGetProfileByLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testUser.Login).
+			GetUserByLogin(gomock.Any(), testUser.Login).
 			Return(testUser, testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.GetProfileByLogin(testUser.Login)
+		_, err := useCase.GetProfileByLogin(context.Background(), testUser.Login)
 		assert.Error(t, err)
 		assert.Equal(t, err, testError)
 	})
@@ -320,14 +333,14 @@ func TestGetUserByLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testUser.Login).
+			GetUserByLogin(gomock.Any(), testUser.Login).
 			Return(testUser, nil)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		userData, err := useCase.GetUserByLogin(testUser.Login)
+		userData, err := useCase.GetUserByLogin(context.Background(), testUser.Login)
 		assert.NoError(t, err)
 		assert.Equal(t, userData, testUser)
 	})
@@ -340,14 +353,14 @@ func TestGetUserByLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testUser.Login).
+			GetUserByLogin(gomock.Any(), testUser.Login).
 			Return(testUser, testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.GetUserByLogin(testUser.Login)
+		_, err := useCase.GetUserByLogin(context.Background(), testUser.Login)
 		assert.Error(t, err)
 		assert.Equal(t, err, testError)
 	})
@@ -368,7 +381,7 @@ func TestLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testInput.Login).
+			GetUserByLogin(gomock.Any(), testInput.Login).
 			Return(testUser, nil)
 		m.
 			EXPECT().
@@ -379,7 +392,7 @@ func TestLogin(t *testing.T) {
 			Repository: m,
 		}
 
-		userData, err := useCase.Login(testInput)
+		userData, err := useCase.Login(context.Background(), testInput)
 		assert.NoError(t, err)
 		assert.Equal(t, userData, testUser)
 	})
@@ -391,14 +404,14 @@ func TestLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testInput.Login).
+			GetUserByLogin(gomock.Any(), testInput.Login).
 			Return(models.User{}, testError)
 
 		useCase := UserUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.Login(testInput)
+		_, err := useCase.Login(context.Background(), testInput)
 		assert.Error(t, err)
 	})
 
@@ -409,7 +422,7 @@ func TestLogin(t *testing.T) {
 		m := user.NewMockRepository(ctrl)
 		m.
 			EXPECT().
-			GetUserByLogin(testInput.Login).
+			GetUserByLogin(gomock.Any(), testInput.Login).
 			Return(testUser, nil)
 		m.
 			EXPECT().
@@ -420,7 +433,686 @@ func TestLogin(t *testing.T) {
 			Repository: m,
 		}
 
-		_, err := useCase.Login(testInput)
+		_, err := useCase.Login(context.Background(), testInput)
+		assert.Error(t, err)
+	})
+}
+
+func TestTwoFactor(t *testing.T) {
+	testError := errors.New("some test error")
+
+	t.Run("SetupTwoFactor-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			SetTwoFactorSecret(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+			TotpIssuer: "VirusMusic",
+		}
+
+		setup, err := useCase.SetupTwoFactor(context.Background(), testUser)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, setup.Secret)
+		assert.Equal(t, totpURI("VirusMusic", testUser.Login, setup.Secret), setup.URI)
+	})
+
+	t.Run("SetupTwoFactor-AlreadyEnabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			SetTwoFactorSecret(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(testError)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		_, err := useCase.SetupTwoFactor(context.Background(), testUser)
+		assert.Error(t, err)
+	})
+
+	t.Run("EnableTwoFactor-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		secret, err := generateTotpSecret()
+		assert.NoError(t, err)
+		code, err := totpCode(secret, uint64(time.Now().Unix())/totpPeriod)
+		assert.NoError(t, err)
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(secret, false, nil)
+		m.
+			EXPECT().
+			UseTotpCounter(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(true, nil)
+		m.
+			EXPECT().
+			EnableTwoFactor(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		codes, err := useCase.EnableTwoFactor(context.Background(), testUser, code)
+		assert.NoError(t, err)
+		assert.Len(t, codes.Codes, recoveryCodesNum)
+	})
+
+	t.Run("EnableTwoFactor-Replay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		code, err := totpCode(rfcSecret, uint64(time.Now().Unix())/totpPeriod)
+		assert.NoError(t, err)
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, false, nil)
+		m.
+			EXPECT().
+			UseTotpCounter(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(false, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		_, err = useCase.EnableTwoFactor(context.Background(), testUser, code)
+		assert.Error(t, err)
+	})
+
+	t.Run("EnableTwoFactor-WrongCode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, false, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		_, err := useCase.EnableTwoFactor(context.Background(), testUser, "12345")
+		assert.Error(t, err)
+	})
+
+	t.Run("EnableTwoFactor-AlreadyEnabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, true, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		_, err := useCase.EnableTwoFactor(context.Background(), testUser, "123456")
+		assert.Error(t, err)
+	})
+
+	t.Run("DisableTwoFactor-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			CheckUserPassword(testUser.Password, "input").
+			Return(nil)
+		m.
+			EXPECT().
+			DisableTwoFactor(gomock.Any(), testUser.Id).
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		assert.NoError(t, useCase.DisableTwoFactor(context.Background(), testUser, "input"))
+	})
+
+	t.Run("DisableTwoFactor-WrongPassword", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			CheckUserPassword(testUser.Password, "input").
+			Return(testError)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		assert.Error(t, useCase.DisableTwoFactor(context.Background(), testUser, "input"))
+	})
+
+	t.Run("CheckSecondFactor-Totp", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		code, err := totpCode(rfcSecret, uint64(time.Now().Unix())/totpPeriod)
+		assert.NoError(t, err)
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, true, nil)
+		m.
+			EXPECT().
+			UseTotpCounter(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(true, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		ok, err := useCase.CheckSecondFactor(context.Background(), testUser.Id, code)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("CheckSecondFactor-Replay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		code, err := totpCode(rfcSecret, uint64(time.Now().Unix())/totpPeriod)
+		assert.NoError(t, err)
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, true, nil)
+		m.
+			EXPECT().
+			UseTotpCounter(gomock.Any(), testUser.Id, gomock.Any()).
+			Return(false, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		//the code is valid, but its time step is already used
+		ok, err := useCase.CheckSecondFactor(context.Background(), testUser.Id, code)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("CheckSecondFactor-RecoveryCode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return(rfcSecret, true, nil)
+		m.
+			EXPECT().
+			UseRecoveryCode(gomock.Any(), testUser.Id, "12345-abcde").
+			Return(true, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		ok, err := useCase.CheckSecondFactor(context.Background(), testUser.Id, "12345-abcde")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("CheckSecondFactor-Disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			GetTwoFactor(gomock.Any(), testUser.Id).
+			Return("", false, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		ok, err := useCase.CheckSecondFactor(context.Background(), testUser.Id, "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestSetRole(t *testing.T) {
+	t.Run("SetRole-Artist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			SetRole(gomock.Any(), testUser.Id, models.RoleArtist, "42").
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		err := useCase.SetRole(context.Background(), testUser.Id, models.UserRole{Role: models.RoleArtist, ArtistId: "42"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("SetRole-DropsArtistLink", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			SetRole(gomock.Any(), testUser.Id, models.RoleModerator, "").
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		err := useCase.SetRole(context.Background(), testUser.Id, models.UserRole{Role: models.RoleModerator, ArtistId: "42"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("SetRole-ArtistWithoutId", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := UserUseCase{
+			Repository: user.NewMockRepository(ctrl),
+		}
+
+		err := useCase.SetRole(context.Background(), testUser.Id, models.UserRole{Role: models.RoleArtist})
+		assert.Error(t, err)
+	})
+
+	t.Run("SetRole-UnknownRole", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		useCase := UserUseCase{
+			Repository: user.NewMockRepository(ctrl),
+		}
+
+		err := useCase.SetRole(context.Background(), testUser.Id, models.UserRole{Role: "superuser"})
+		assert.Error(t, err)
+	})
+}
+
+func TestExportData(t *testing.T) {
+	testError := errors.New("something go wrong")
+
+	tracks := []models.Track{{Id: "1", Name: "track"}}
+	albums := []models.Album{{Id: "2", Name: "album"}}
+	artists := []models.ArtistSearch{{ArtistID: "3", Name: "artist"}}
+	playlists := []models.Playlist{{Id: "4", Name: "playlist", UserId: testUser.Id}}
+
+	t.Run("ExportData-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mTrack := track.NewMockRepository(ctrl)
+		mAlbum := album.NewMockRepository(ctrl)
+		mArtist := artist.NewMockRepository(ctrl)
+		mPlaylist := playlist.NewMockRepository(ctrl)
+
+		mTrack.EXPECT().GetUserTracks(gomock.Any(), testUser.Id).Return(tracks, nil)
+		mAlbum.EXPECT().GetUserAlbums(gomock.Any(), testUser.Id).Return(albums, nil)
+		mArtist.EXPECT().SubscriptionsList(gomock.Any(), testUser.Id).Return(artists, nil)
+		mPlaylist.EXPECT().GetUserPlaylists(gomock.Any(), testUser.Id).Return(playlists, nil)
+		mTrack.EXPECT().GetBoundedTracksByPlaylistId(gomock.Any(), "4", uint64(0), uint64(exportPlaylistTracks)).Return(tracks, nil)
+
+		useCase := UserUseCase{
+			TrackRepository:    mTrack,
+			AlbumRepository:    mAlbum,
+			ArtistRepository:   mArtist,
+			PlaylistRepository: mPlaylist,
+		}
+
+		data, err := useCase.ExportData(context.Background(), testUser)
+		assert.NoError(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		assert.NoError(t, err)
+
+		files := make(map[string][]byte)
+		for _, elem := range archive.File {
+			file, err := elem.Open()
+			assert.NoError(t, err)
+			files[elem.Name], err = ioutil.ReadAll(file)
+			assert.NoError(t, err)
+			file.Close()
+		}
+		assert.Len(t, files, 5)
+
+		var profile models.User
+		assert.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+		assert.Equal(t, useCase.GetOutputUserData(testUser), profile)
+		assert.Empty(t, profile.Password)
+
+		var exported []models.PlaylistExport
+		assert.NoError(t, json.Unmarshal(files["playlists.json"], &exported))
+		assert.Equal(t, []models.PlaylistExport{{Playlist: playlists[0], Tracks: tracks}}, exported)
+
+		assert.Contains(t, string(files["liked_tracks.json"]), `"name":"track"`)
+		assert.Contains(t, string(files["liked_albums.json"]), `"name":"album"`)
+		assert.Contains(t, string(files["subscriptions.json"]), `"name":"artist"`)
+	})
+
+	t.Run("ExportData-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mTrack := track.NewMockRepository(ctrl)
+		mAlbum := album.NewMockRepository(ctrl)
+
+		mTrack.EXPECT().GetUserTracks(gomock.Any(), testUser.Id).Return(tracks, nil)
+		mAlbum.EXPECT().GetUserAlbums(gomock.Any(), testUser.Id).Return(nil, testError)
+
+		useCase := UserUseCase{
+			TrackRepository: mTrack,
+			AlbumRepository: mAlbum,
+		}
+
+		_, err := useCase.ExportData(context.Background(), testUser)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteAccount(t *testing.T) {
+	testError := errors.New("something go wrong")
+
+	avatarUser := testUser
+	avatarUser.Image = "/avatar/image.png"
+
+	t.Run("DeleteAccount-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mFile := filetransfer.NewMockUploadServiceClient(ctrl)
+
+		//the avatar goes only after the rows
+		gomock.InOrder(
+			m.
+				EXPECT().
+				Delete(gomock.Any(), avatarUser.Id).
+				Return(nil),
+			mFile.
+				EXPECT().
+				Delete(gomock.Any(), &filetransfer.FileName{Name: os.Getenv("FILE_ROOT") + avatarUser.Image}).
+				Return(&filetransfer.UploadStatus{Code: filetransfer.UploadStatusCode_Ok}, nil),
+		)
+
+		useCase := UserUseCase{
+			Repository:  m,
+			FileService: mFile,
+			AvatarDir:   "/avatar",
+		}
+
+		assert.NoError(t, useCase.DeleteAccount(context.Background(), avatarUser))
+	})
+
+	t.Run("DeleteAccount-DefaultAvatar", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mFile := filetransfer.NewMockUploadServiceClient(ctrl)
+
+		m.
+			EXPECT().
+			Delete(gomock.Any(), avatarUser.Id).
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository:    m,
+			FileService:   mFile,
+			AvatarDir:     "/avatar",
+			AvatarDefault: avatarUser.Image,
+		}
+
+		assert.NoError(t, useCase.DeleteAccount(context.Background(), avatarUser))
+	})
+
+	t.Run("DeleteAccount-RepositoryError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mFile := filetransfer.NewMockUploadServiceClient(ctrl)
+
+		m.
+			EXPECT().
+			Delete(gomock.Any(), avatarUser.Id).
+			Return(testError)
+
+		useCase := UserUseCase{
+			Repository:  m,
+			FileService: mFile,
+			AvatarDir:   "/avatar",
+		}
+
+		assert.Error(t, useCase.DeleteAccount(context.Background(), avatarUser))
+	})
+
+	t.Run("DeleteAccount-FileServiceError", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mFile := filetransfer.NewMockUploadServiceClient(ctrl)
+
+		m.
+			EXPECT().
+			Delete(gomock.Any(), avatarUser.Id).
+			Return(nil)
+		mFile.
+			EXPECT().
+			Delete(gomock.Any(), gomock.Any()).
+			Return(nil, testError)
+
+		useCase := UserUseCase{
+			Repository:  m,
+			FileService: mFile,
+			AvatarDir:   "/avatar",
+			Log:         logger.NewLogger(os.Stdout),
+		}
+
+		assert.NoError(t, useCase.DeleteAccount(context.Background(), avatarUser))
+	})
+}
+
+func TestFollow(t *testing.T) {
+	t.Run("Follow-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		m.
+			EXPECT().
+			Follow(gomock.Any(), testUser.Id, "42").
+			Return(nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		assert.NoError(t, useCase.Follow(context.Background(), testUser, "42"))
+	})
+
+	t.Run("Follow-Himself", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		assert.Error(t, useCase.Follow(context.Background(), testUser, testUser.Id))
+	})
+}
+
+func TestGetFullProfile(t *testing.T) {
+	testError := errors.New("something go wrong")
+
+	viewer := models.User{Id: "42", Login: "viewer"}
+	stat := models.UserStat{UserId: testUser.Id, Followers: 1}
+	playlists := []models.Playlist{
+		{Id: "1", Name: "public", UserId: testUser.Id, Private: false},
+		{Id: "2", Name: "private", UserId: testUser.Id, Private: true},
+	}
+
+	likedTracks := func(count int) []models.Track {
+		tracks := make([]models.Track, count)
+		for i := range tracks {
+			tracks[i] = models.Track{Id: fmt.Sprint(i), IsLiked: true}
+		}
+		return tracks
+	}
+
+	t.Run("GetFullProfile-Follower", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mTrack := track.NewMockRepository(ctrl)
+		mPlaylist := playlist.NewMockRepository(ctrl)
+
+		m.EXPECT().GetUserByLogin(gomock.Any(), testUser.Login).Return(testUser, nil)
+		m.EXPECT().GetUserStat(gomock.Any(), testUser.Id).Return(stat, nil)
+		m.EXPECT().IsFollowing(gomock.Any(), viewer.Id, testUser.Id).Return(true)
+		mPlaylist.EXPECT().GetUserPlaylists(gomock.Any(), testUser.Id).Return(playlists, nil)
+		mTrack.EXPECT().GetUserTracks(gomock.Any(), testUser.Id).Return(likedTracks(profileLikedTracks+5), nil)
+
+		useCase := UserUseCase{
+			Repository:         m,
+			TrackRepository:    mTrack,
+			PlaylistRepository: mPlaylist,
+		}
+
+		profile, err := useCase.GetFullProfile(context.Background(), testUser.Login, viewer)
+		assert.NoError(t, err)
+		assert.True(t, profile.IsFollowed)
+		assert.Empty(t, profile.Email)
+		assert.Empty(t, profile.Password)
+		assert.Equal(t, stat, profile.Stat)
+		assert.Equal(t, playlists[:1], profile.Playlists)
+		assert.Len(t, profile.LikedTracks, profileLikedTracks)
+		assert.False(t, profile.LikedTracks[0].IsLiked)
+	})
+
+	t.Run("GetFullProfile-NotFollower", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+
+		m.EXPECT().GetUserByLogin(gomock.Any(), testUser.Login).Return(testUser, nil)
+		m.EXPECT().GetUserStat(gomock.Any(), testUser.Id).Return(stat, nil)
+		m.EXPECT().IsFollowing(gomock.Any(), viewer.Id, testUser.Id).Return(false)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		profile, err := useCase.GetFullProfile(context.Background(), testUser.Login, viewer)
+		assert.NoError(t, err)
+		assert.False(t, profile.IsFollowed)
+		assert.Nil(t, profile.Playlists)
+		assert.Nil(t, profile.LikedTracks)
+	})
+
+	t.Run("GetFullProfile-Anonymous", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+
+		m.EXPECT().GetUserByLogin(gomock.Any(), testUser.Login).Return(testUser, nil)
+		m.EXPECT().GetUserStat(gomock.Any(), testUser.Id).Return(stat, nil)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		profile, err := useCase.GetFullProfile(context.Background(), testUser.Login, models.User{})
+		assert.NoError(t, err)
+		assert.False(t, profile.IsFollowed)
+		assert.Nil(t, profile.Playlists)
+	})
+
+	t.Run("GetFullProfile-Self", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+		mTrack := track.NewMockRepository(ctrl)
+		mPlaylist := playlist.NewMockRepository(ctrl)
+
+		m.EXPECT().GetUserByLogin(gomock.Any(), testUser.Login).Return(testUser, nil)
+		m.EXPECT().GetUserStat(gomock.Any(), testUser.Id).Return(stat, nil)
+		mPlaylist.EXPECT().GetUserPlaylists(gomock.Any(), testUser.Id).Return(playlists, nil)
+		mTrack.EXPECT().GetUserTracks(gomock.Any(), testUser.Id).Return(likedTracks(2), nil)
+
+		useCase := UserUseCase{
+			Repository:         m,
+			TrackRepository:    mTrack,
+			PlaylistRepository: mPlaylist,
+		}
+
+		profile, err := useCase.GetFullProfile(context.Background(), testUser.Login, testUser)
+		assert.NoError(t, err)
+		assert.Equal(t, testUser.Email, profile.Email)
+		assert.Equal(t, playlists, profile.Playlists)
+		assert.True(t, profile.LikedTracks[0].IsLiked)
+	})
+
+	t.Run("GetFullProfile-NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := user.NewMockRepository(ctrl)
+
+		m.EXPECT().GetUserByLogin(gomock.Any(), testUser.Login).Return(models.User{}, testError)
+
+		useCase := UserUseCase{
+			Repository: m,
+		}
+
+		_, err := useCase.GetFullProfile(context.Background(), testUser.Login, viewer)
 		assert.Error(t, err)
 	})
 }
@@ -5,6 +5,7 @@
 package user
 
 import (
+	context "context"
 	models "github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 	io "io"
@@ -35,93 +36,93 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockUseCase) Create(user models.User) (SameUserExists, error) {
+func (m *MockUseCase) Create(ctx context.Context, user models.User) (SameUserExists, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", user)
+	ret := m.ctrl.Call(m, "Create", ctx, user)
 	ret0, _ := ret[0].(SameUserExists)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockUseCaseMockRecorder) Create(user interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Create(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, user)
 }
 
 // Update mocks base method
-func (m *MockUseCase) Update(user models.User, input models.UserSettings) (SameUserExists, error) {
+func (m *MockUseCase) Update(ctx context.Context, user models.User, input models.UserSettings) (SameUserExists, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", user, input)
+	ret := m.ctrl.Call(m, "Update", ctx, user, input)
 	ret0, _ := ret[0].(SameUserExists)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockUseCaseMockRecorder) Update(user, input interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Update(ctx, user, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUseCase)(nil).Update), user, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUseCase)(nil).Update), ctx, user, input)
 }
 
 // Login mocks base method
-func (m *MockUseCase) Login(input models.UserSignIn) (models.User, error) {
+func (m *MockUseCase) Login(ctx context.Context, input models.UserSignIn) (models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", input)
+	ret := m.ctrl.Call(m, "Login", ctx, input)
 	ret0, _ := ret[0].(models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login
-func (mr *MockUseCaseMockRecorder) Login(input interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Login(ctx, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUseCase)(nil).Login), input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUseCase)(nil).Login), ctx, input)
 }
 
 // UpdateAvatar mocks base method
-func (m *MockUseCase) UpdateAvatar(user models.User, file io.Reader, fileType string) (string, error) {
+func (m *MockUseCase) UpdateAvatar(ctx context.Context, user models.User, file io.Reader, fileType string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAvatar", user, file, fileType)
+	ret := m.ctrl.Call(m, "UpdateAvatar", ctx, user, file, fileType)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAvatar indicates an expected call of UpdateAvatar
-func (mr *MockUseCaseMockRecorder) UpdateAvatar(user, file, fileType interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) UpdateAvatar(ctx, user, file, fileType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUseCase)(nil).UpdateAvatar), user, file, fileType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUseCase)(nil).UpdateAvatar), ctx, user, file, fileType)
 }
 
 // GetUserByLogin mocks base method
-func (m *MockUseCase) GetUserByLogin(login string) (models.User, error) {
+func (m *MockUseCase) GetUserByLogin(ctx context.Context, login string) (models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByLogin", login)
+	ret := m.ctrl.Call(m, "GetUserByLogin", ctx, login)
 	ret0, _ := ret[0].(models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByLogin indicates an expected call of GetUserByLogin
-func (mr *MockUseCaseMockRecorder) GetUserByLogin(login interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserByLogin(ctx, login interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockUseCase)(nil).GetUserByLogin), login)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockUseCase)(nil).GetUserByLogin), ctx, login)
 }
 
 // GetProfileByLogin mocks base method
-func (m *MockUseCase) GetProfileByLogin(login string) (models.User, error) {
+func (m *MockUseCase) GetProfileByLogin(ctx context.Context, login string) (models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProfileByLogin", login)
+	ret := m.ctrl.Call(m, "GetProfileByLogin", ctx, login)
 	ret0, _ := ret[0].(models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProfileByLogin indicates an expected call of GetProfileByLogin
-func (mr *MockUseCaseMockRecorder) GetProfileByLogin(login interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetProfileByLogin(ctx, login interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileByLogin", reflect.TypeOf((*MockUseCase)(nil).GetProfileByLogin), login)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileByLogin", reflect.TypeOf((*MockUseCase)(nil).GetProfileByLogin), ctx, login)
 }
 
 // GetOutputUserData mocks base method
@@ -153,16 +154,206 @@ func (mr *MockUseCaseMockRecorder) CheckUserPassword(userPassword, InputPassword
 }
 
 // GetUserStat mocks base method
-func (m *MockUseCase) GetUserStat(id string) (models.UserStat, error) {
+func (m *MockUseCase) GetUserStat(ctx context.Context, id string) (models.UserStat, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserStat", id)
+	ret := m.ctrl.Call(m, "GetUserStat", ctx, id)
 	ret0, _ := ret[0].(models.UserStat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserStat indicates an expected call of GetUserStat
-func (mr *MockUseCaseMockRecorder) GetUserStat(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetUserStat(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockUseCase)(nil).GetUserStat), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStat", reflect.TypeOf((*MockUseCase)(nil).GetUserStat), ctx, id)
+}
+
+// SetRole mocks base method
+func (m *MockUseCase) SetRole(ctx context.Context, uID string, input models.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, uID, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole
+func (mr *MockUseCaseMockRecorder) SetRole(ctx, uID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockUseCase)(nil).SetRole), ctx, uID, input)
+}
+
+// ExportData mocks base method
+func (m *MockUseCase) ExportData(ctx context.Context, user models.User) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportData", ctx, user)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportData indicates an expected call of ExportData
+func (mr *MockUseCaseMockRecorder) ExportData(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportData", reflect.TypeOf((*MockUseCase)(nil).ExportData), ctx, user)
+}
+
+// DeleteAccount mocks base method
+func (m *MockUseCase) DeleteAccount(ctx context.Context, user models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount
+func (mr *MockUseCaseMockRecorder) DeleteAccount(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUseCase)(nil).DeleteAccount), ctx, user)
+}
+
+// Follow mocks base method
+func (m *MockUseCase) Follow(ctx context.Context, user models.User, followedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, user, followedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow
+func (mr *MockUseCaseMockRecorder) Follow(ctx, user, followedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUseCase)(nil).Follow), ctx, user, followedID)
+}
+
+// Unfollow mocks base method
+func (m *MockUseCase) Unfollow(ctx context.Context, user models.User, followedID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, user, followedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow
+func (mr *MockUseCaseMockRecorder) Unfollow(ctx, user, followedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockUseCase)(nil).Unfollow), ctx, user, followedID)
+}
+
+// GetFollowers mocks base method
+func (m *MockUseCase) GetFollowers(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, uID, start, end)
+	ret0, _ := ret[0].([]models.UserPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers
+func (mr *MockUseCaseMockRecorder) GetFollowers(ctx, uID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUseCase)(nil).GetFollowers), ctx, uID, start, end)
+}
+
+// GetFollowing mocks base method
+func (m *MockUseCase) GetFollowing(ctx context.Context, uID string, start, end uint64) ([]models.UserPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, uID, start, end)
+	ret0, _ := ret[0].([]models.UserPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing
+func (mr *MockUseCaseMockRecorder) GetFollowing(ctx, uID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUseCase)(nil).GetFollowing), ctx, uID, start, end)
+}
+
+// GetFullProfile mocks base method
+func (m *MockUseCase) GetFullProfile(ctx context.Context, login string, viewer models.User) (models.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFullProfile", ctx, login, viewer)
+	ret0, _ := ret[0].(models.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFullProfile indicates an expected call of GetFullProfile
+func (mr *MockUseCaseMockRecorder) GetFullProfile(ctx, login, viewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFullProfile", reflect.TypeOf((*MockUseCase)(nil).GetFullProfile), ctx, login, viewer)
+}
+
+// SetupTwoFactor mocks base method
+func (m *MockUseCase) SetupTwoFactor(ctx context.Context, user models.User) (models.TwoFactorSetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetupTwoFactor", ctx, user)
+	ret0, _ := ret[0].(models.TwoFactorSetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetupTwoFactor indicates an expected call of SetupTwoFactor
+func (mr *MockUseCaseMockRecorder) SetupTwoFactor(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupTwoFactor", reflect.TypeOf((*MockUseCase)(nil).SetupTwoFactor), ctx, user)
+}
+
+// EnableTwoFactor mocks base method
+func (m *MockUseCase) EnableTwoFactor(ctx context.Context, user models.User, code string) (models.RecoveryCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, user, code)
+	ret0, _ := ret[0].(models.RecoveryCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor
+func (mr *MockUseCaseMockRecorder) EnableTwoFactor(ctx, user, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockUseCase)(nil).EnableTwoFactor), ctx, user, code)
+}
+
+// DisableTwoFactor mocks base method
+func (m *MockUseCase) DisableTwoFactor(ctx context.Context, user models.User, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, user, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor
+func (mr *MockUseCaseMockRecorder) DisableTwoFactor(ctx, user, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUseCase)(nil).DisableTwoFactor), ctx, user, password)
+}
+
+// IsTwoFactorEnabled mocks base method
+func (m *MockUseCase) IsTwoFactorEnabled(ctx context.Context, uID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTwoFactorEnabled", ctx, uID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTwoFactorEnabled indicates an expected call of IsTwoFactorEnabled
+func (mr *MockUseCaseMockRecorder) IsTwoFactorEnabled(ctx, uID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTwoFactorEnabled", reflect.TypeOf((*MockUseCase)(nil).IsTwoFactorEnabled), ctx, uID)
+}
+
+// CheckSecondFactor mocks base method
+func (m *MockUseCase) CheckSecondFactor(ctx context.Context, uID, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSecondFactor", ctx, uID, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSecondFactor indicates an expected call of CheckSecondFactor
+func (mr *MockUseCaseMockRecorder) CheckSecondFactor(ctx, uID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSecondFactor", reflect.TypeOf((*MockUseCase)(nil).CheckSecondFactor), ctx, uID, code)
 }
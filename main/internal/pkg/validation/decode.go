@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+)
+
+// MaxBodySize limits JSON request bodies, it is set from the config on start
+var MaxBodySize int64 = 1 << 20
+
+// Validator is implemented by inputs that check their own fields after decoding
+type Validator interface {
+	Validate() error
+}
+
+// DecodeJSON reads at most MaxBodySize bytes of the request body into v and validates it if v is a Validator.
+// Returned errors are typed, so handlers can pass them to apperrors.WriteHTTP as is
+func DecodeJSON(r *http.Request, v interface{}) error {
+	if r.ContentLength > MaxBodySize {
+		return apperrors.Errorf(apperrors.TooLarge, "request body must be at most %d bytes", MaxBodySize)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		return apperrors.Errorf(apperrors.Validation, "failed to read request body: %w", err)
+	}
+	if int64(len(data)) > MaxBodySize {
+		return apperrors.Errorf(apperrors.TooLarge, "request body must be at most %d bytes", MaxBodySize)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return &apperrors.Error{
+				Kind:   apperrors.Validation,
+				Err:    err,
+				Fields: []apperrors.FieldError{{Field: typeErr.Field, Message: "must be " + typeErr.Type.String()}},
+			}
+		}
+		return apperrors.Errorf(apperrors.Validation, "malformed JSON: %w", err)
+	}
+
+	if validator, ok := v.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
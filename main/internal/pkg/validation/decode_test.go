@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testInput struct {
+	Name  string `json:"name"`
+	Count uint   `json:"count"`
+}
+
+func (i testInput) Validate() error {
+	return Validate(Field("name", i.Name, Required))
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("DecodeJSON-OK", func(t *testing.T) {
+		input := testInput{}
+		err := DecodeJSON(httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"test","count":2}`)), &input)
+		assert.NoError(t, err)
+		assert.Equal(t, testInput{Name: "test", Count: 2}, input)
+	})
+
+	t.Run("DecodeJSON-Malformed", func(t *testing.T) {
+		err := DecodeJSON(httptest.NewRequest("POST", "/", strings.NewReader(`{"name":`)), &testInput{})
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+		assert.Empty(t, apperrors.Fields(err))
+	})
+
+	t.Run("DecodeJSON-WrongType", func(t *testing.T) {
+		err := DecodeJSON(httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"test","count":-1}`)), &testInput{})
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+		assert.Equal(t, []apperrors.FieldError{{Field: "count", Message: "must be uint"}}, apperrors.Fields(err))
+	})
+
+	t.Run("DecodeJSON-Invalid", func(t *testing.T) {
+		err := DecodeJSON(httptest.NewRequest("POST", "/", strings.NewReader(`{"count":1}`)), &testInput{})
+		assert.Equal(t, []apperrors.FieldError{{Field: "name", Message: "is required"}}, apperrors.Fields(err))
+	})
+
+	t.Run("DecodeJSON-TooLarge", func(t *testing.T) {
+		defer func(size int64) { MaxBodySize = size }(MaxBodySize)
+		MaxBodySize = 16
+
+		body := `{"name":"` + strings.Repeat("a", 32) + `"}`
+		err := DecodeJSON(httptest.NewRequest("POST", "/", strings.NewReader(body)), &testInput{})
+		assert.True(t, apperrors.Is(err, apperrors.TooLarge))
+
+		// chunked bodies have no content length and are cut while reading
+		req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		req.ContentLength = -1
+		err = DecodeJSON(req, &testInput{})
+		assert.True(t, apperrors.Is(err, apperrors.TooLarge))
+	})
+
+	t.Run("DecodeJSON-ReadError", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", errReader{})
+		err := DecodeJSON(req, &testInput{})
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+	})
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+)
+
+// Rule checks a single value and returns a message for the client, or an empty string if the value is fine.
+// Every rule except Required accepts an empty value, so optional fields only need to omit Required
+type Rule func(value string) string
+
+// FieldRules binds rules to the value of a named field
+type FieldRules struct {
+	name  string
+	value string
+	rules []Rule
+}
+
+// Field declares the rules for one input field, name is the json name shown to the client
+func Field(name string, value string, rules ...Rule) FieldRules {
+	return FieldRules{name: name, value: value, rules: rules}
+}
+
+// Validate runs the rules of every field and reports all failed fields at once,
+// only the first failed rule of a field is reported
+func Validate(fields ...FieldRules) error {
+	var failed []apperrors.FieldError
+	for _, f := range fields {
+		for _, rule := range f.rules {
+			if msg := rule(f.value); msg != "" {
+				failed = append(failed, apperrors.FieldError{Field: f.name, Message: msg})
+				break
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(failed))
+	for i, f := range failed {
+		names[i] = f.Field
+	}
+	return &apperrors.Error{
+		Kind:   apperrors.Validation,
+		Err:    errors.New("invalid fields: " + strings.Join(names, ", ")),
+		Fields: failed,
+	}
+}
+
+func Required(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "is required"
+	}
+	return ""
+}
+
+// MaxLen limits the length in characters, limits follow the column sizes in the migrations
+func MaxLen(max int) Rule {
+	return func(value string) string {
+		if utf8.RuneCountInString(value) > max {
+			return fmt.Sprintf("must be at most %d characters", max)
+		}
+		return ""
+	}
+}
+
+func MinLen(min int) Rule {
+	return func(value string) string {
+		if value != "" && utf8.RuneCountInString(value) < min {
+			return fmt.Sprintf("must be at least %d characters", min)
+		}
+		return ""
+	}
+}
+
+func OneOf(values ...string) Rule {
+	return func(value string) string {
+		if value == "" {
+			return ""
+		}
+		for _, v := range values {
+			if value == v {
+				return ""
+			}
+		}
+		return "must be one of: " + strings.Join(values, ", ")
+	}
+}
+
+func Email(value string) string {
+	if value == "" {
+		return ""
+	}
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value || !strings.Contains(value[strings.LastIndex(value, "@"):], ".") {
+		return "must be a valid email address"
+	}
+	return ""
+}
+
+var loginPattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// Login allows latin letters, digits, dots, dashes and underscores, logins are a part of profile urls
+func Login(value string) string {
+	if value != "" && !loginPattern.MatchString(value) {
+		return "may only contain latin letters, digits, '.', '-' and '_'"
+	}
+	return ""
+}
+
+const (
+	passwordMinLen = 8
+	// bcrypt ignores everything after 72 bytes
+	passwordMaxBytes = 72
+)
+
+// Password requires at least 8 characters with a letter and a digit
+func Password(value string) string {
+	if value == "" {
+		return ""
+	}
+	if utf8.RuneCountInString(value) < passwordMinLen {
+		return fmt.Sprintf("must be at least %d characters", passwordMinLen)
+	}
+	if len(value) > passwordMaxBytes {
+		return fmt.Sprintf("must be at most %d bytes", passwordMaxBytes)
+	}
+	var letter, digit bool
+	for _, r := range value {
+		switch {
+		case unicode.IsLetter(r):
+			letter = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	if !letter || !digit {
+		return "must contain a letter and a digit"
+	}
+	return ""
+}
+
+// ID accepts positive database ids
+func ID(value string) string {
+	if value == "" {
+		return ""
+	}
+	if id, err := strconv.ParseUint(value, 10, 64); err != nil || id == 0 {
+		return "must be a valid id"
+	}
+	return ""
+}
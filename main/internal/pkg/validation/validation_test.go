@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/2020_1_no_homomorphism/no_homo_main/internal/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		value string
+		ok    bool
+	}{
+		{"Required-Empty", Required, "", false},
+		{"Required-Spaces", Required, "   ", false},
+		{"Required-OK", Required, "a", true},
+		{"MaxLen-Runes", MaxLen(3), "ёжи", true},
+		{"MaxLen-Long", MaxLen(3), "ёжик", false},
+		{"MinLen-Empty", MinLen(3), "", true},
+		{"MinLen-Short", MinLen(3), "ab", false},
+		{"OneOf-OK", OneOf("male", "female"), "female", true},
+		{"OneOf-Unknown", OneOf("male", "female"), "Man", false},
+		{"Email-OK", Email, "user@mail.ru", true},
+		{"Email-NoDomain", Email, "user@localhost", false},
+		{"Email-Name", Email, "User <user@mail.ru>", false},
+		{"Email-NoAt", Email, "mail.ru", false},
+		{"Login-OK", Login, "nnnagibator_2.0", true},
+		{"Login-Space", Login, "nn nagibator", false},
+		{"Login-Slash", Login, "../admin", false},
+		{"Password-OK", Password, "76453647fvd", true},
+		{"Password-Short", Password, "a1b2c3", false},
+		{"Password-NoDigit", Password, "password", false},
+		{"Password-NoLetter", Password, "1234567890", false},
+		{"Password-TooLong", Password, "a1" + strings.Repeat("x", 71), false},
+		{"ID-OK", ID, "42", true},
+		{"ID-Zero", ID, "0", false},
+		{"ID-NotNumber", ID, "4a", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.ok, test.rule(test.value) == "")
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Validate-OK", func(t *testing.T) {
+		err := Validate(
+			Field("login", "nnnagibator", Required, Login),
+			Field("image", "", MaxLen(10)),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Validate-Fields", func(t *testing.T) {
+		err := Validate(
+			Field("login", "", Required, Login),
+			Field("email", "mail", Required, Email),
+			Field("name", "TestName", Required),
+		)
+		assert.True(t, apperrors.Is(err, apperrors.Validation))
+		assert.Equal(t, "invalid fields: login, email", err.Error())
+		assert.Equal(t, []apperrors.FieldError{
+			{Field: "login", Message: "is required"},
+			{Field: "email", Message: "must be a valid email address"},
+		}, apperrors.Fields(err))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/2020_1_no_homomorphism/no_homo_main/config"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"io"
 	"net/http"
 	"time"
@@ -23,8 +24,16 @@ func NewLogger(writer io.Writer) *MainLogger {
 	return standardLogger
 }
 
+// withTrace adds the trace id of ctx to the fields so log records can be matched with spans
+func (l *MainLogger) withTrace(ctx context.Context, fields logrus.Fields) *logrus.Entry {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		fields["trace_id"] = sc.TraceID().String()
+	}
+	return l.WithFields(fields)
+}
+
 func (l *MainLogger) LogError(ctx context.Context, pkg string, funcName string, err error) {
-	l.WithFields(logrus.Fields{
+	l.withTrace(ctx, logrus.Fields{
 		"id":       l.GetIdFromContext(ctx),
 		"package":  pkg,
 		"function": funcName,
@@ -61,15 +70,22 @@ func (l *MainLogger) EndReq(start time.Time, ctx context.Context) {
 	}).Info("request ended")
 }
 
+// TraceReq links the request id with the trace id of the request span
+func (l *MainLogger) TraceReq(ctx context.Context) {
+	l.withTrace(ctx, logrus.Fields{
+		"id": l.GetIdFromContext(ctx),
+	}).Info("request traced")
+}
+
 func (l *MainLogger) HttpInfo(ctx context.Context, msg string, status int) {
-	l.WithFields(logrus.Fields{
+	l.withTrace(ctx, logrus.Fields{
 		"id":     l.GetIdFromContext(ctx),
 		"status": status,
 	}).Info(msg)
 }
 
 func (l *MainLogger) LogWarning(ctx context.Context, pkg string, funcName string, msg string) {
-	l.WithFields(logrus.Fields{
+	l.withTrace(ctx, logrus.Fields{
 		"id":       l.GetIdFromContext(ctx),
 		"package":  pkg,
 		"function": funcName,
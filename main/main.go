@@ -1,9 +1,18 @@
 package main
 
 import (
+	"log"
+	"os"
+
 	"github.com/2020_1_no_homomorphism/no_homo_main/internal/app/server"
 )
 
 func main() {
-	server.StartNew()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		server.Migrate(os.Args[2:])
+		return
+	}
+	if err := server.StartNew(); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,213 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: filetransfer.pb.go
+
+// Package filetransfer is a generated GoMock package.
+package filetransfer
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+	reflect "reflect"
+)
+
+// MockUploadServiceClient is a mock of UploadServiceClient interface
+type MockUploadServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadServiceClientMockRecorder
+}
+
+// MockUploadServiceClientMockRecorder is the mock recorder for MockUploadServiceClient
+type MockUploadServiceClientMockRecorder struct {
+	mock *MockUploadServiceClient
+}
+
+// NewMockUploadServiceClient creates a new mock instance
+func NewMockUploadServiceClient(ctrl *gomock.Controller) *MockUploadServiceClient {
+	mock := &MockUploadServiceClient{ctrl: ctrl}
+	mock.recorder = &MockUploadServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUploadServiceClient) EXPECT() *MockUploadServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method
+func (m *MockUploadServiceClient) Delete(arg0 context.Context, arg1 *FileName, arg2 ...grpc.CallOption) (*UploadStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(*UploadStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockUploadServiceClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUploadServiceClient)(nil).Delete), varargs...)
+}
+
+// Upload mocks base method
+func (m *MockUploadServiceClient) Upload(arg0 context.Context, arg1 ...grpc.CallOption) (UploadService_UploadClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Upload", varargs...)
+	ret0, _ := ret[0].(UploadService_UploadClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload
+func (mr *MockUploadServiceClientMockRecorder) Upload(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUploadServiceClient)(nil).Upload), varargs...)
+}
+
+// MockUploadService_UploadClient is a mock of UploadService_UploadClient interface
+type MockUploadService_UploadClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadService_UploadClientMockRecorder
+}
+
+// MockUploadService_UploadClientMockRecorder is the mock recorder for MockUploadService_UploadClient
+type MockUploadService_UploadClientMockRecorder struct {
+	mock *MockUploadService_UploadClient
+}
+
+// NewMockUploadService_UploadClient creates a new mock instance
+func NewMockUploadService_UploadClient(ctrl *gomock.Controller) *MockUploadService_UploadClient {
+	mock := &MockUploadService_UploadClient{ctrl: ctrl}
+	mock.recorder = &MockUploadService_UploadClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUploadService_UploadClient) EXPECT() *MockUploadService_UploadClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method
+func (m *MockUploadService_UploadClient) CloseAndRecv() (*UploadStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*UploadStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv
+func (mr *MockUploadService_UploadClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockUploadService_UploadClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method
+func (m *MockUploadService_UploadClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockUploadService_UploadClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockUploadService_UploadClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockUploadService_UploadClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockUploadService_UploadClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockUploadService_UploadClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockUploadService_UploadClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockUploadService_UploadClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockUploadService_UploadClient)(nil).Header))
+}
+
+// RecvMsg mocks base method
+func (m *MockUploadService_UploadClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockUploadService_UploadClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockUploadService_UploadClient)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockUploadService_UploadClient) Send(arg0 *Chunk) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockUploadService_UploadClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockUploadService_UploadClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockUploadService_UploadClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockUploadService_UploadClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockUploadService_UploadClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockUploadService_UploadClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockUploadService_UploadClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockUploadService_UploadClient)(nil).Trailer))
+}
@@ -94,6 +94,26 @@ func (mr *MockAuthCheckerClientMockRecorder) Delete(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthCheckerClient)(nil).Delete), varargs...)
 }
 
+// DeleteAll mocks base method
+func (m *MockAuthCheckerClient) DeleteAll(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Nothing, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAll", varargs...)
+	ret0, _ := ret[0].(*Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAll indicates an expected call of DeleteAll
+func (mr *MockAuthCheckerClientMockRecorder) DeleteAll(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockAuthCheckerClient)(nil).DeleteAll), varargs...)
+}
+
 // MockAuthCheckerServer is a mock of AuthCheckerServer interface
 type MockAuthCheckerServer struct {
 	ctrl     *gomock.Controller
@@ -161,3 +181,18 @@ func (mr *MockAuthCheckerServerMockRecorder) Delete(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuthCheckerServer)(nil).Delete), arg0, arg1)
 }
+
+// DeleteAll mocks base method
+func (m *MockAuthCheckerServer) DeleteAll(arg0 context.Context, arg1 *Session) (*Nothing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", arg0, arg1)
+	ret0, _ := ret[0].(*Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAll indicates an expected call of DeleteAll
+func (mr *MockAuthCheckerServerMockRecorder) DeleteAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockAuthCheckerServer)(nil).DeleteAll), arg0, arg1)
+}
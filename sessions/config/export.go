@@ -10,10 +10,20 @@ var ConfigFields = struct {
 	RedisAddr string
 	TcpPort string
 	ExpireTime string
+	TracingService string
+	TracingEndpoint string
+	MetricsAddr string
+	HealthInterval string
+	ShutdownTimeout string
 } {
 	RedisAddr : "redis.addr",
 	TcpPort: "tcp.port",
 	ExpireTime: "cookie.expired",
+	TracingService: "tracing.service",
+	TracingEndpoint: "tracing.endpoint",
+	MetricsAddr: "metrics.addr",
+	HealthInterval: "health.interval",
+	ShutdownTimeout: "shutdown.timeout",
 }
 
 func ExportConfig() error {
@@ -5,12 +5,19 @@ go 1.14
 require (
 	github.com/alicebob/miniredis/v2 v2.11.4
 	github.com/golang/mock v1.4.3
-	github.com/golang/protobuf v1.4.0
+	github.com/golang/protobuf v1.5.2
 	github.com/gomodule/redigo v1.7.1-0.20190322064113-39e2c31b7ca3
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/joho/godotenv v1.3.0
+	github.com/prometheus/client_golang v1.5.1
 	github.com/satori/go.uuid v1.2.0
 	github.com/spf13/viper v1.6.3
-	github.com/stretchr/testify v1.5.1
-	golang.org/x/net v0.0.0-20200425230154-ff2c4b7c35a0
-	google.golang.org/grpc v1.29.1
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.24.0
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	go.opentelemetry.io/otel/trace v1.0.0
+	golang.org/x/net v0.0.0-20200822124328-c89045814202
+	google.golang.org/grpc v1.40.0
 )
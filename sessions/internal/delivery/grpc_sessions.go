@@ -1,6 +1,9 @@
 package delivery
 
 import (
+	"errors"
+	"net"
+
 	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	uuid "github.com/satori/go.uuid"
 	"golang.org/x/net/context"
@@ -21,9 +24,9 @@ func NewSessionDelivery(useCase session.UseCase, expire uint64) *SessionDelivery
 }
 
 func (uc *SessionDelivery) Create(ctx context.Context, in *session.Session) (*session.SessionID, error) {
-	sid, err := uc.UseCase.Create(in.Login, uc.ExpireTime)
+	sid, err := uc.UseCase.Create(ctx, in.Login, uc.ExpireTime)
 	if err != nil {
-		return nil, status.Error(codes.Aborted, err.Error())
+		return nil, statusError(err)
 	}
 	return &session.SessionID{ID: sid.String()}, nil
 }
@@ -33,8 +36,8 @@ func (uc *SessionDelivery) Delete(ctx context.Context, in *session.SessionID) (*
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "can't parse uuid from string")
 	}
-	if err := uc.UseCase.Delete(sid); err != nil {
-		return nil, status.Error(codes.Aborted, err.Error())
+	if err := uc.UseCase.Delete(ctx, sid); err != nil {
+		return nil, statusError(err)
 	}
 	return &session.Nothing{Dummy: true}, nil
 }
@@ -44,9 +47,35 @@ func (uc *SessionDelivery) Check(ctx context.Context, in *session.SessionID) (*s
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "can't parse uuid from string")
 	}
-	login, err := uc.UseCase.Check(sid)
+	login, err := uc.UseCase.Check(ctx, sid)
 	if err != nil {
-		return nil, status.Error(codes.Aborted, err.Error())
+		return nil, statusError(err)
 	}
 	return &session.Session{Login: login}, nil
 }
+
+func (uc *SessionDelivery) DeleteAll(ctx context.Context, in *session.Session) (*session.Nothing, error) {
+	if in.Login == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty login")
+	}
+	if err := uc.UseCase.DeleteAll(ctx, in.Login); err != nil {
+		return nil, statusError(err)
+	}
+	return &session.Nothing{Dummy: true}, nil
+}
+
+// statusError maps use case errors to grpc codes, so clients can tell a missing session from a broken storage
+func statusError(err error) error {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, session.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, session.ErrUnavailable), errors.As(err, &netErr):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
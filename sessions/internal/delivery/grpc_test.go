@@ -3,10 +3,13 @@ package delivery
 import (
 	"context"
 	"errors"
+	"fmt"
 	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	"github.com/golang/mock/gomock"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"testing"
 )
 
@@ -27,7 +30,7 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Create(in.Login, delivery.ExpireTime).
+			Create(gomock.Any(), in.Login, delivery.ExpireTime).
 			Return(uID, nil)
 
 		sessID := &session.SessionID{ID: uID.String()}
@@ -48,7 +51,7 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Create(in.Login, delivery.ExpireTime).
+			Create(gomock.Any(), in.Login, delivery.ExpireTime).
 			Return(uuid.UUID{}, testError)
 
 		_, err := delivery.Create(context.TODO(), in)
@@ -72,7 +75,7 @@ func TestDelete(t *testing.T) {
 
 		m.
 			EXPECT().
-			Delete(uuid.FromStringOrNil(sessID.ID)).
+			Delete(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
 			Return(nil)
 
 		_, err := delivery.Delete(context.TODO(), sessID)
@@ -91,7 +94,7 @@ func TestDelete(t *testing.T) {
 
 		m.
 			EXPECT().
-			Delete(uuid.FromStringOrNil(sessID.ID)).
+			Delete(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
 			Return(testError)
 
 		_, err := delivery.Delete(context.TODO(), sessID)
@@ -116,7 +119,7 @@ func TestCheck(t *testing.T) {
 
 		m.
 			EXPECT().
-			Check(uuid.FromStringOrNil(sessID.ID)).
+			Check(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
 			Return(out.Login, nil)
 
 		res, err := delivery.Check(context.TODO(), sessID)
@@ -135,10 +138,107 @@ func TestCheck(t *testing.T) {
 
 		m.
 			EXPECT().
-			Check(uuid.FromStringOrNil(sessID.ID)).
+			Check(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
 			Return("", testError)
 
 		_, err := delivery.Check(context.TODO(), sessID)
 		assert.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("Check-NotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockUseCase(ctrl)
+
+		delivery := NewSessionDelivery(m, 23525)
+		sessID := &session.SessionID{ID: uuid.NewV4().String()}
+
+		m.
+			EXPECT().
+			Check(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
+			Return("", session.ErrNotFound)
+
+		_, err := delivery.Check(context.TODO(), sessID)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Check-Unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockUseCase(ctrl)
+
+		delivery := NewSessionDelivery(m, 23525)
+		sessID := &session.SessionID{ID: uuid.NewV4().String()}
+
+		m.
+			EXPECT().
+			Check(gomock.Any(), uuid.FromStringOrNil(sessID.ID)).
+			Return("", fmt.Errorf("failed to get redis connection: %w", session.ErrUnavailable))
+
+		_, err := delivery.Check(context.TODO(), sessID)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("Check-WrongID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		delivery := NewSessionDelivery(session.NewMockUseCase(ctrl), 23525)
+
+		_, err := delivery.Check(context.TODO(), &session.SessionID{ID: "not uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDeleteAll(t *testing.T) {
+	testError := errors.New("something go wrong")
+
+	t.Run("DeleteAll-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockUseCase(ctrl)
+
+		in := &session.Session{Login: "testLogin"}
+
+		delivery := NewSessionDelivery(m, 23525)
+
+		m.
+			EXPECT().
+			DeleteAll(gomock.Any(), in.Login).
+			Return(nil)
+
+		result, err := delivery.DeleteAll(context.TODO(), in)
+		assert.NoError(t, err)
+		assert.Equal(t, &session.Nothing{Dummy: true}, result)
+	})
+	t.Run("DeleteAll-EmptyLogin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockUseCase(ctrl)
+
+		delivery := NewSessionDelivery(m, 23525)
+
+		_, err := delivery.DeleteAll(context.TODO(), &session.Session{})
+		assert.Error(t, err)
+	})
+	t.Run("DeleteAll-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockUseCase(ctrl)
+
+		in := &session.Session{Login: "testLogin"}
+
+		delivery := NewSessionDelivery(m, 23525)
+
+		m.
+			EXPECT().
+			DeleteAll(gomock.Any(), in.Login).
+			Return(testError)
+
+		_, err := delivery.DeleteAll(context.TODO(), in)
+		assert.Error(t, err)
 	})
 }
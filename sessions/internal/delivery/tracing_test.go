@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
+	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/tracing"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestTracePropagation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exporter := tracetest.NewInMemoryExporter()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tracing.NewProvider("test", sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	m := session.NewMockUseCase(ctrl)
+	var serverTraceID string
+	m.
+		EXPECT().
+		Check(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ uuid.UUID) (string, error) {
+			serverTraceID = tracing.TraceID(ctx)
+			return "testLogin", nil
+		})
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()))
+	session.RegisterAuthCheckerServer(server, NewSessionDelivery(m, 23525))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, root := tracing.Tracer().Start(context.Background(), "root")
+	_, err = session.NewAuthCheckerClient(conn).Check(ctx, &session.SessionID{ID: uuid.NewV4().String()})
+	root.End()
+
+	assert.NoError(t, err)
+	assert.Equal(t, root.SpanContext().TraceID().String(), serverTraceID)
+	assert.Len(t, exporter.GetSpans(), 3)
+}
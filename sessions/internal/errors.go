@@ -0,0 +1,8 @@
+package session
+
+import "errors"
+
+var (
+	ErrNotFound    = errors.New("session not found")
+	ErrUnavailable = errors.New("session storage is unavailable")
+)
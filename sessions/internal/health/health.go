@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe reports whether a dependency is able to serve requests
+type Probe func(ctx context.Context) error
+
+func Redis(pool *redis.Pool) Probe {
+	return func(ctx context.Context) error {
+		conn, err := pool.GetContext(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		_, err = conn.Do("PING")
+		return err
+	}
+}
+
+func probeAll(ctx context.Context, timeout time.Duration, probes []Probe) healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, probe := range probes {
+		if err := probe(ctx); err != nil {
+			log.Printf("health probe failed: %v", err)
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// Watch probes the dependencies every interval until ctx is done and publishes SERVING
+// for the whole server only while all of them answer
+func Watch(ctx context.Context, srv *health.Server, interval time.Duration, probes ...Probe) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		srv.SetServingStatus("", probeAll(ctx, interval, probes))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeStatus(w http.ResponseWriter, code int, status string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(struct {
+		Status string `json:"status"`
+	}{status})
+}
+
+func Liveness(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, http.StatusOK, "ok")
+}
+
+// Readiness serves the status published by Watch to the probes which don't speak grpc
+func Readiness(srv healthpb.HealthServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := srv.Check(r.Context(), &healthpb.HealthCheckRequest{})
+		if err != nil {
+			writeStatus(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			writeStatus(w, http.StatusServiceUnavailable, resp.Status.String())
+			return
+		}
+		writeStatus(w, http.StatusOK, "ok")
+	}
+}
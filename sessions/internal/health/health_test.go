@@ -0,0 +1,88 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func status(t *testing.T, srv *health.Server) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Status
+}
+
+func TestWatch(t *testing.T) {
+	srv := health.NewServer()
+	var failing int32
+	probe := func(context.Context) error {
+		if atomic.LoadInt32(&failing) == 1 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Watch(ctx, srv, 5*time.Millisecond, probe)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return status(t, srv) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, time.Millisecond)
+
+	atomic.StoreInt32(&failing, 1)
+	assert.Eventually(t, func() bool {
+		return status(t, srv) == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestReadiness(t *testing.T) {
+	srv := health.NewServer()
+
+	rec := httptest.NewRecorder()
+	Readiness(srv)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+
+	srv.Shutdown()
+	rec = httptest.NewRecorder()
+	Readiness(srv)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"status":"NOT_SERVING"}`, rec.Body.String())
+}
+
+func TestRedis(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := mr.Addr()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+
+	assert.NoError(t, Redis(pool)(context.Background()))
+
+	mr.Close()
+	assert.Error(t, Redis(pool)(context.Background()))
+}
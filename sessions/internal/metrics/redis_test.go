@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisPoolCollector(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	pool := &redis.Pool{
+		MaxIdle: 1,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", mr.Addr())
+		},
+	}
+
+	conn := pool.Get()
+	_, err = conn.Do("PING")
+	assert.NoError(t, err)
+
+	expected := `
+		# HELP redis_pool_active_connections Connections of the pool, both in use and idle
+		# TYPE redis_pool_active_connections gauge
+		redis_pool_active_connections 1
+		# HELP redis_pool_idle_connections Idle connections of the pool
+		# TYPE redis_pool_idle_connections gauge
+		redis_pool_idle_connections 0
+	`
+	assert.NoError(t, testutil.CollectAndCompare(NewRedisPoolCollector(pool), strings.NewReader(expected)))
+
+	assert.NoError(t, conn.Close())
+	expected = `
+		# HELP redis_pool_idle_connections Idle connections of the pool
+		# TYPE redis_pool_idle_connections gauge
+		redis_pool_idle_connections 1
+	`
+	assert.NoError(t, testutil.CollectAndCompare(NewRedisPoolCollector(pool), strings.NewReader(expected), "redis_pool_idle_connections"))
+}
+
+func TestObserveRedisCommand(t *testing.T) {
+	ObserveRedisCommand("GET", time.Now(), nil)
+	ObserveRedisCommand("GET", time.Now(), errors.New("connection refused"))
+	ObserveRedisCommand("SET", time.Now(), nil)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(RedisCommands))
+}
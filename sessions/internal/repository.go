@@ -1,7 +1,10 @@
 package session
 
+import "context"
+
 type Repository interface {
-	Create(sId string, value string, expire uint64) error
-	Delete(sId string) error
-	GetLoginBySessionID(sId string) (string, error)
+	Create(ctx context.Context, sId string, value string, expire uint64) error
+	Delete(ctx context.Context, sId string) error
+	GetLoginBySessionID(ctx context.Context, sId string) (string, error)
+	DeleteByLogin(ctx context.Context, login string) error
 }
@@ -1,7 +1,12 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"fmt"
+
+	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
+	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/tracing"
 	"github.com/gomodule/redigo/redis"
 )
 
@@ -15,32 +20,80 @@ func NewRedisSessionManager(conn *redis.Pool) *SessionManager {
 	}
 }
 
-func (sr *SessionManager) Create(sID string, login string, expire uint64) error {
-	conn := sr.redisPool.Get()
+// every login keeps a set of its session ids, so all of them can be revoked at once
+func loginKey(login string) string {
+	return "logins:" + login
+}
+
+func (sr *SessionManager) Create(ctx context.Context, sID string, login string, expire uint64) error {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %v: %w", err, session.ErrUnavailable)
+	}
+	defer conn.Close()
+
 	result, err := redis.String(conn.Do("SET", sID, login, "EX", expire))
 	if err != nil {
-		return errors.New("failed to write key: " + err.Error())
+		return fmt.Errorf("failed to write key: %w", err)
 	}
 	if result != "OK" {
 		return errors.New("result not OK")
 	}
+	if _, err := conn.Do("SADD", loginKey(login), sID); err != nil {
+		return fmt.Errorf("failed to index session: %w", err)
+	}
+	if _, err := conn.Do("EXPIRE", loginKey(login), expire); err != nil {
+		return fmt.Errorf("failed to set index expire: %w", err)
+	}
 	return nil
 }
 
-func (sr *SessionManager) Delete(sID string) error {
-	conn := sr.redisPool.Get()
-	_, err := redis.Int(conn.Do("DEL", sID))
+func (sr *SessionManager) Delete(ctx context.Context, sID string) error {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %v: %w", err, session.ErrUnavailable)
+	}
+	defer conn.Close()
+
+	_, err = redis.Int(conn.Do("DEL", sID))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (sr *SessionManager) GetLoginBySessionID(sID string) (string, error) {
-	conn := sr.redisPool.Get()
+func (sr *SessionManager) GetLoginBySessionID(ctx context.Context, sID string) (string, error) {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return "", fmt.Errorf("failed to get redis connection: %v: %w", err, session.ErrUnavailable)
+	}
+	defer conn.Close()
+
 	data, err := redis.String(conn.Do("GET", sID))
+	if err == redis.ErrNil {
+		return "", session.ErrNotFound
+	}
 	if err != nil {
-		return "", errors.New("cant get data: " + err.Error())
+		return "", fmt.Errorf("cant get data: %w", err)
 	}
 	return data, nil
 }
+
+func (sr *SessionManager) DeleteByLogin(ctx context.Context, login string) error {
+	conn, err := tracing.RedisConn(ctx, sr.redisPool)
+	if err != nil {
+		return fmt.Errorf("failed to get redis connection: %v: %w", err, session.ErrUnavailable)
+	}
+	defer conn.Close()
+
+	sIDs, err := redis.Strings(conn.Do("SMEMBERS", loginKey(login)))
+	if err != nil {
+		return fmt.Errorf("cant get sessions: %w", err)
+	}
+
+	keys := redis.Args{}.Add(loginKey(login)).AddFlat(sIDs)
+	if _, err := conn.Do("DEL", keys...); err != nil {
+		return fmt.Errorf("cant delete sessions: %w", err)
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	"github.com/alicebob/miniredis/v2"
 	"github.com/gomodule/redigo/redis"
 	uuid "github.com/satori/go.uuid"
@@ -50,7 +52,7 @@ func (s *Suite) TestCreate() {
 	expire := time.Hour * 8
 	sID := uuid.NewV4()
 
-	err := s.session.Create(sID.String(), login, uint64(expire.Seconds()))
+	err := s.session.Create(context.Background(), sID.String(), login, uint64(expire.Seconds()))
 	require.NoError(s.T(), err)
 
 	value, err := s.redisServer.Get(sID.String())
@@ -66,7 +68,7 @@ func (s *Suite) TestCreate() {
 	//test on closed connection
 	s.redisServer.Close()
 
-	err = s.session.Create(sID.String(), login, uint64(expire))
+	err = s.session.Create(context.Background(), sID.String(), login, uint64(expire))
 	require.Error(s.T(), err)
 }
 
@@ -79,7 +81,7 @@ func (s *Suite) TestDelete() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), value, testValue)
 
-	require.NoError(s.T(), s.session.Delete(sID.String()))
+	require.NoError(s.T(), s.session.Delete(context.Background(), sID.String()))
 
 	_, err = s.redisServer.Get(sID.String())
 	require.Equal(s.T(), err, errors.New("ERR no such key"))
@@ -88,7 +90,7 @@ func (s *Suite) TestDelete() {
 	id := uuid.NewV4()
 
 	s.redisServer.Close()
-	require.Error(s.T(), s.session.Delete(id.String()))
+	require.Error(s.T(), s.session.Delete(context.Background(), id.String()))
 }
 
 func (s *Suite) TestGetLoginBySessionID() {
@@ -96,13 +98,46 @@ func (s *Suite) TestGetLoginBySessionID() {
 	testValue := "test_value"
 	require.NoError(s.T(), s.redisServer.Set(sID.String(), testValue))
 
-	val, err := s.session.GetLoginBySessionID(sID.String())
+	val, err := s.session.GetLoginBySessionID(context.Background(), sID.String())
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), testValue, val)
 
+	//test on missing session
+	_, err = s.session.GetLoginBySessionID(context.Background(), uuid.NewV4().String())
+	require.Equal(s.T(), session.ErrNotFound, err)
+
+	//test on closed connection
+	s.redisServer.Close()
+
+	_, err = s.session.GetLoginBySessionID(context.Background(), sID.String())
+	require.True(s.T(), errors.Is(err, session.ErrUnavailable))
+}
+
+func (s *Suite) TestDeleteByLogin() {
+	login := "test_login"
+	expire := time.Hour * 8
+	first := uuid.NewV4().String()
+	second := uuid.NewV4().String()
+
+	require.NoError(s.T(), s.session.Create(context.Background(), first, login, uint64(expire.Seconds())))
+	require.NoError(s.T(), s.session.Create(context.Background(), second, login, uint64(expire.Seconds())))
+	require.NoError(s.T(), s.session.Create(context.Background(), "other", "other_login", uint64(expire.Seconds())))
+
+	err := s.session.DeleteByLogin(context.Background(), login)
+	require.NoError(s.T(), err)
+
+	require.False(s.T(), s.redisServer.Exists(first))
+	require.False(s.T(), s.redisServer.Exists(second))
+	require.False(s.T(), s.redisServer.Exists("logins:"+login))
+	require.True(s.T(), s.redisServer.Exists("other"))
+
+	//no sessions
+	err = s.session.DeleteByLogin(context.Background(), "unknown")
+	require.NoError(s.T(), err)
+
 	//test on closed connection
 	s.redisServer.Close()
 
-	_, err = s.session.GetLoginBySessionID(sID.String())
+	err = s.session.DeleteByLogin(context.Background(), login)
 	require.Error(s.T(), err)
 }
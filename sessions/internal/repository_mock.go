@@ -5,6 +5,7 @@
 package session
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -33,44 +34,58 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockRepository) Create(sId, value string, expire uint64) error {
+func (m *MockRepository) Create(ctx context.Context, sId, value string, expire uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", sId, value, expire)
+	ret := m.ctrl.Call(m, "Create", ctx, sId, value, expire)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create
-func (mr *MockRepositoryMockRecorder) Create(sId, value, expire interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Create(ctx, sId, value, expire interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), sId, value, expire)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, sId, value, expire)
 }
 
 // Delete mocks base method
-func (m *MockRepository) Delete(sId string) error {
+func (m *MockRepository) Delete(ctx context.Context, sId string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", sId)
+	ret := m.ctrl.Call(m, "Delete", ctx, sId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockRepositoryMockRecorder) Delete(sId interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, sId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), sId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, sId)
 }
 
 // GetLoginBySessionID mocks base method
-func (m *MockRepository) GetLoginBySessionID(sId string) (string, error) {
+func (m *MockRepository) GetLoginBySessionID(ctx context.Context, sId string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLoginBySessionID", sId)
+	ret := m.ctrl.Call(m, "GetLoginBySessionID", ctx, sId)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetLoginBySessionID indicates an expected call of GetLoginBySessionID
-func (mr *MockRepositoryMockRecorder) GetLoginBySessionID(sId interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetLoginBySessionID(ctx, sId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBySessionID", reflect.TypeOf((*MockRepository)(nil).GetLoginBySessionID), sId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginBySessionID", reflect.TypeOf((*MockRepository)(nil).GetLoginBySessionID), ctx, sId)
+}
+
+// DeleteByLogin mocks base method
+func (m *MockRepository) DeleteByLogin(ctx context.Context, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByLogin", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByLogin indicates an expected call of DeleteByLogin
+func (mr *MockRepositoryMockRecorder) DeleteByLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByLogin", reflect.TypeOf((*MockRepository)(nil).DeleteByLogin), ctx, login)
 }
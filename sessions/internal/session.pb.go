@@ -152,19 +152,20 @@ func init() {
 }
 
 var fileDescriptor_3a6be1b361fa6f14 = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x4e, 0x2d, 0x2e,
+	// 194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x4e, 0x2d, 0x2e,
 	0xce, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0x95, 0xa4, 0xb9,
 	0x38, 0x83, 0x21, 0x4c, 0x4f, 0x17, 0x21, 0x3e, 0x2e, 0x26, 0x4f, 0x17, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0xce, 0x20, 0x20, 0x4b, 0x49, 0x9e, 0x8b, 0x1d, 0x2a, 0x29, 0x24, 0xc2, 0xc5, 0x9a, 0x93,
-	0x9f, 0x9e, 0x99, 0x07, 0x95, 0x85, 0x70, 0x40, 0x0a, 0xfc, 0xf2, 0x4b, 0x32, 0x32, 0xf3, 0xd2,
-	0x41, 0x0a, 0x52, 0x4a, 0x73, 0x73, 0x2b, 0xc1, 0x0a, 0x38, 0x82, 0x20, 0x1c, 0xa3, 0x45, 0x8c,
-	0x5c, 0xdc, 0x8e, 0xa5, 0x25, 0x19, 0xce, 0x19, 0xa9, 0xc9, 0xd9, 0xa9, 0x45, 0x42, 0x06, 0x5c,
-	0x6c, 0xce, 0x45, 0xa9, 0x89, 0x25, 0xa9, 0x42, 0x02, 0x7a, 0x30, 0x17, 0x41, 0xad, 0x90, 0x12,
-	0x42, 0x17, 0x01, 0xba, 0x80, 0x41, 0x48, 0x9f, 0x8b, 0x15, 0xac, 0x59, 0x08, 0x8b, 0xb4, 0x14,
-	0x86, 0x21, 0x40, 0x0d, 0x40, 0x2b, 0x5c, 0x52, 0x73, 0x52, 0x81, 0x56, 0xe0, 0xd7, 0x01, 0x75,
-	0xb8, 0x12, 0x43, 0x12, 0x1b, 0x38, 0x4c, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10, 0x08,
-	0xe7, 0x53, 0x24, 0x01, 0x00, 0x00,
+	0x0d, 0xce, 0x20, 0x26, 0x4f, 0x17, 0x25, 0x79, 0x2e, 0x76, 0xa8, 0xa4, 0x90, 0x08, 0x17, 0x6b,
+	0x4e, 0x7e, 0x7a, 0x66, 0x1e, 0x54, 0x16, 0xc2, 0x01, 0x29, 0xf0, 0xcb, 0x2f, 0xc9, 0xc8, 0xcc,
+	0x4b, 0x07, 0x29, 0x48, 0x29, 0xcd, 0xcd, 0xad, 0x04, 0x2b, 0xe0, 0x08, 0x82, 0x70, 0x8c, 0xae,
+	0x32, 0x72, 0x71, 0x3b, 0x96, 0x96, 0x64, 0x38, 0x67, 0xa4, 0x26, 0x67, 0xa7, 0x16, 0x09, 0x19,
+	0x70, 0xb1, 0x39, 0x17, 0xa5, 0x26, 0x96, 0xa4, 0x0a, 0x09, 0xe8, 0xc1, 0x5c, 0x04, 0xb5, 0x42,
+	0x4a, 0x08, 0x5d, 0xc4, 0xd3, 0x45, 0x89, 0x41, 0x48, 0x9f, 0x8b, 0x15, 0xac, 0x59, 0x08, 0x8b,
+	0xb4, 0x14, 0x86, 0x21, 0x4a, 0x0c, 0x20, 0x2b, 0x5c, 0x52, 0x73, 0x52, 0x4b, 0x52, 0x09, 0xe8,
+	0x80, 0x3a, 0x5c, 0x89, 0x41, 0xc8, 0x90, 0x8b, 0x13, 0xa2, 0xc3, 0x31, 0x27, 0x07, 0x8b, 0xbb,
+	0xb0, 0x68, 0x49, 0x62, 0x03, 0x07, 0xa3, 0x31, 0x60, 0x00, 0x59, 0x13, 0xb1, 0x24, 0x57, 0x01,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -182,6 +183,7 @@ type AuthCheckerClient interface {
 	Create(ctx context.Context, in *Session, opts ...grpc.CallOption) (*SessionID, error)
 	Check(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*Session, error)
 	Delete(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*Nothing, error)
+	DeleteAll(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Nothing, error)
 }
 
 type authCheckerClient struct {
@@ -219,11 +221,21 @@ func (c *authCheckerClient) Delete(ctx context.Context, in *SessionID, opts ...g
 	return out, nil
 }
 
+func (c *authCheckerClient) DeleteAll(ctx context.Context, in *Session, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/session.AuthChecker/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthCheckerServer is the server API for AuthChecker service.
 type AuthCheckerServer interface {
 	Create(context.Context, *Session) (*SessionID, error)
 	Check(context.Context, *SessionID) (*Session, error)
 	Delete(context.Context, *SessionID) (*Nothing, error)
+	DeleteAll(context.Context, *Session) (*Nothing, error)
 }
 
 // UnimplementedAuthCheckerServer can be embedded to have forward compatible implementations.
@@ -239,6 +251,9 @@ func (*UnimplementedAuthCheckerServer) Check(ctx context.Context, req *SessionID
 func (*UnimplementedAuthCheckerServer) Delete(ctx context.Context, req *SessionID) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedAuthCheckerServer) DeleteAll(ctx context.Context, req *Session) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
 
 func RegisterAuthCheckerServer(s *grpc.Server, srv AuthCheckerServer) {
 	s.RegisterService(&_AuthChecker_serviceDesc, srv)
@@ -298,6 +313,24 @@ func _AuthChecker_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthChecker_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Session)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthCheckerServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.AuthChecker/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthCheckerServer).DeleteAll(ctx, req.(*Session))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AuthChecker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.AuthChecker",
 	HandlerType: (*AuthCheckerServer)(nil),
@@ -314,6 +347,10 @@ var _AuthChecker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _AuthChecker_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _AuthChecker_DeleteAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "session.proto",
@@ -1,11 +1,14 @@
 package session
 
 import (
+	"context"
+
 	uuid "github.com/satori/go.uuid"
 )
 
 type UseCase interface {
-	Create(login string, expires uint64) (uuid.UUID, error)
-	Delete(sessionID uuid.UUID) error
-	Check(sessionID uuid.UUID) (string, error)
+	Create(ctx context.Context, login string, expires uint64) (uuid.UUID, error)
+	Delete(ctx context.Context, sessionID uuid.UUID) error
+	Check(ctx context.Context, sessionID uuid.UUID) (string, error)
+	DeleteAll(ctx context.Context, login string) error
 }
@@ -1,7 +1,8 @@
 package usecase
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	uuid "github.com/satori/go.uuid"
 )
@@ -14,29 +15,36 @@ func addPrefix(id uuid.UUID) string {
 	return "sessions:" + id.String()
 }
 
-func (uc *SessionUseCase) Create(login string, expires uint64) (uuid.UUID, error) {
+func (uc *SessionUseCase) Create(ctx context.Context, login string, expires uint64) (uuid.UUID, error) {
 	id := uuid.NewV4()
 	sId := addPrefix(id)
-	err := uc.Repository.Create(sId, login, expires)
+	err := uc.Repository.Create(ctx, sId, login, expires)
 	if err != nil {
 		return uuid.UUID{}, err
 	}
 	return id, nil
 }
 
-func (uc *SessionUseCase) Delete(sessionID uuid.UUID) error {
+func (uc *SessionUseCase) Delete(ctx context.Context, sessionID uuid.UUID) error {
 	sId := addPrefix(sessionID)
-	_, err := uc.Repository.GetLoginBySessionID(sId)
+	_, err := uc.Repository.GetLoginBySessionID(ctx, sId)
 	if err != nil {
-		return errors.New("can't find session: " + sessionID.String() + " error:" + err.Error())
+		return fmt.Errorf("can't find session: %s error: %w", sessionID, err)
 	}
-	err = uc.Repository.Delete(sId)
+	err = uc.Repository.Delete(ctx, sId)
 	if err != nil {
-		return errors.New("can't delete session: " + sessionID.String() + " error:" + err.Error())
+		return fmt.Errorf("can't delete session: %s error: %w", sessionID, err)
 	}
 	return nil
 }
 
-func (uc *SessionUseCase) Check(sessionID uuid.UUID) (string, error) {
-	return uc.Repository.GetLoginBySessionID(addPrefix(sessionID))
+func (uc *SessionUseCase) Check(ctx context.Context, sessionID uuid.UUID) (string, error) {
+	return uc.Repository.GetLoginBySessionID(ctx, addPrefix(sessionID))
+}
+
+func (uc *SessionUseCase) DeleteAll(ctx context.Context, login string) error {
+	if err := uc.Repository.DeleteByLogin(ctx, login); err != nil {
+		return fmt.Errorf("can't delete sessions of %s error: %w", login, err)
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	"github.com/golang/mock/gomock"
@@ -23,14 +24,14 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Create(gomock.Any(), testLogin, expires).
+			Create(gomock.Any(), gomock.Any(), testLogin, expires).
 			Return(nil)
 
 		useCase := SessionUseCase{
 			Repository: m,
 		}
 
-		uuid, err := useCase.Create(testLogin, expires)
+		uuid, err := useCase.Create(context.Background(), testLogin, expires)
 		assert.NoError(t, err)
 		assert.NotNil(t, uuid)
 	})
@@ -46,14 +47,14 @@ func TestCreate(t *testing.T) {
 
 		m.
 			EXPECT().
-			Create(gomock.Any(), testLogin, expires).
+			Create(gomock.Any(), gomock.Any(), testLogin, expires).
 			Return(testError)
 
 		useCase := SessionUseCase{
 			Repository: m,
 		}
 
-		_, err := useCase.Create(testLogin, expires)
+		_, err := useCase.Create(context.Background(), testLogin, expires)
 		assert.Error(t, err)
 	})
 }
@@ -71,18 +72,18 @@ func TestDelete(t *testing.T) {
 
 		m.
 			EXPECT().
-			GetLoginBySessionID("sessions:" + id.String()).
+			GetLoginBySessionID(gomock.Any(), "sessions:" + id.String()).
 			Return("", nil)
 		m.
 			EXPECT().
-			Delete("sessions:" + id.String()).
+			Delete(gomock.Any(), "sessions:" + id.String()).
 			Return(nil)
 
 		useCase := SessionUseCase{
 			Repository: m,
 		}
 
-		err := useCase.Delete(id)
+		err := useCase.Delete(context.Background(), id)
 		assert.NoError(t, err)
 	})
 
@@ -96,14 +97,14 @@ func TestDelete(t *testing.T) {
 
 		m.
 			EXPECT().
-			GetLoginBySessionID("sessions:" + id.String()).
+			GetLoginBySessionID(gomock.Any(), "sessions:" + id.String()).
 			Return("", testError)
 
 		useCase := SessionUseCase{
 			Repository: m,
 		}
 
-		err := useCase.Delete(id)
+		err := useCase.Delete(context.Background(), id)
 		assert.Error(t, err)
 	})
 	t.Run("Delete-DeleteError", func(t *testing.T) {
@@ -116,18 +117,60 @@ func TestDelete(t *testing.T) {
 
 		m.
 			EXPECT().
-			GetLoginBySessionID("sessions:" + id.String()).
+			GetLoginBySessionID(gomock.Any(), "sessions:" + id.String()).
 			Return("", nil)
 		m.
 			EXPECT().
-			Delete("sessions:" + id.String()).
+			Delete(gomock.Any(), "sessions:" + id.String()).
 			Return(testError)
 
 		useCase := SessionUseCase{
 			Repository: m,
 		}
 
-		err := useCase.Delete(id)
+		err := useCase.Delete(context.Background(), id)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteAll(t *testing.T) {
+	testError := errors.New("something go wrong")
+	testLogin := "testLogin"
+
+	t.Run("DeleteAll-OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockRepository(ctrl)
+
+		m.
+			EXPECT().
+			DeleteByLogin(gomock.Any(), testLogin).
+			Return(nil)
+
+		useCase := SessionUseCase{
+			Repository: m,
+		}
+
+		err := useCase.DeleteAll(context.Background(), testLogin)
+		assert.NoError(t, err)
+	})
+	t.Run("DeleteAll-Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := session.NewMockRepository(ctrl)
+
+		m.
+			EXPECT().
+			DeleteByLogin(gomock.Any(), testLogin).
+			Return(testError)
+
+		useCase := SessionUseCase{
+			Repository: m,
+		}
+
+		err := useCase.DeleteAll(context.Background(), testLogin)
 		assert.Error(t, err)
 	})
 }
@@ -5,6 +5,7 @@
 package session
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/satori/go.uuid"
 	reflect "reflect"
@@ -34,45 +35,59 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockUseCase) Create(login string, expires uint64) (uuid.UUID, error) {
+func (m *MockUseCase) Create(ctx context.Context, login string, expires uint64) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", login, expires)
+	ret := m.ctrl.Call(m, "Create", ctx, login, expires)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockUseCaseMockRecorder) Create(login, expires interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Create(ctx, login, expires interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), login, expires)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, login, expires)
 }
 
 // Delete mocks base method
-func (m *MockUseCase) Delete(sessionID uuid.UUID) error {
+func (m *MockUseCase) Delete(ctx context.Context, sessionID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", sessionID)
+	ret := m.ctrl.Call(m, "Delete", ctx, sessionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockUseCaseMockRecorder) Delete(sessionID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Delete(ctx, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, sessionID)
 }
 
 // Check mocks base method
-func (m *MockUseCase) Check(sessionID uuid.UUID) (string, error) {
+func (m *MockUseCase) Check(ctx context.Context, sessionID uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Check", sessionID)
+	ret := m.ctrl.Call(m, "Check", ctx, sessionID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Check indicates an expected call of Check
-func (mr *MockUseCaseMockRecorder) Check(sessionID interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Check(ctx, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockUseCase)(nil).Check), sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockUseCase)(nil).Check), ctx, sessionID)
+}
+
+// DeleteAll mocks base method
+func (m *MockUseCase) DeleteAll(ctx context.Context, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll
+func (mr *MockUseCaseMockRecorder) DeleteAll(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockUseCase)(nil).DeleteAll), ctx, login)
 }
@@ -1,19 +1,34 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"github.com/2020_1_no_homomorphism/no_homo_sessions/config"
 	session "github.com/2020_1_no_homomorphism/no_homo_sessions/internal"
 	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/delivery"
+	sessionsHealth "github.com/2020_1_no_homomorphism/no_homo_sessions/internal/health"
+	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/metrics"
 	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/repository"
+	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/tracing"
 	"github.com/2020_1_no_homomorphism/no_homo_sessions/internal/usecase"
 	"github.com/gomodule/redigo/redis"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -25,12 +40,24 @@ func main() {
 		log.Fatalf("can't export config %v", err)
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background(),
+		viper.GetString(config.ConfigFields.TracingService),
+		viper.GetString(config.ConfigFields.TracingEndpoint),
+	)
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	tcpPort := viper.GetString(config.ConfigFields.TcpPort)
 	lis, err := net.Listen("tcp", tcpPort)
 	if err != nil {
 		log.Fatalln("cant listen port", err)
 	}
-	server := grpc.NewServer()
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		grpc_prometheus.UnaryServerInterceptor,
+	))
 
 	redisAddr := flag.String("addr", viper.GetString(config.ConfigFields.RedisAddr), "redis addr")
 
@@ -39,11 +66,7 @@ func main() {
 		MaxActive: 12000,
 		Wait:      true,
 		Dial: func() (redis.Conn, error) {
-			conn, err := redis.DialURL(*redisAddr)
-			if err != nil {
-				log.Fatalf("failed to init redis pool: %v", err)
-			}
-			return conn, err
+			return redis.DialURL(*redisAddr)
 		},
 	}
 	defer redisConn.Close()
@@ -54,9 +77,69 @@ func main() {
 
 	session.RegisterAuthCheckerServer(server, delivery.NewSessionDelivery(&sessionUseCase, viper.GetUint64(config.ConfigFields.ExpireTime)))
 
-	fmt.Printf("starting server at %s\n", tcpPort)
-	err = server.Serve(lis)
-	if err != nil {
-		log.Fatal(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go sessionsHealth.Watch(ctx, healthServer,
+		time.Duration(viper.GetInt64(config.ConfigFields.HealthInterval))*time.Second,
+		sessionsHealth.Redis(redisConn),
+	)
+
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	grpc_prometheus.Register(server)
+	prometheus.MustRegister(metrics.NewRedisPoolCollector(redisConn), metrics.RedisCommands)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", sessionsHealth.Liveness)
+	mux.Handle("/readyz", sessionsHealth.Readiness(healthServer))
+	httpServer := &http.Server{
+		Addr:    viper.GetString(config.ConfigFields.MetricsAddr),
+		Handler: mux,
+	}
+	go func() {
+		fmt.Printf("starting metrics at %s\n", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("metrics server stopped: %v", err)
+		}
+	}()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		fmt.Printf("starting server at %s\n", tcpPort)
+		serveErr <- server.Serve(lis)
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		log.Printf("server stopped: %v", err)
+		return
+	case sig := <-stop:
+		log.Printf("got %v, shutting down", sig)
+	}
+
+	// NOT_SERVING is sent to the watching clients, then in-flight calls are drained
+	cancel()
+	healthServer.Shutdown()
+	timeout := time.Duration(viper.GetInt64(config.ConfigFields.ShutdownTimeout)) * time.Second
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Println("calls didn't drain in time")
+		server.Stop()
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), timeout)
+	defer cancelShutdown()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to stop metrics server: %v", err)
 	}
 }